@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"google.golang.org/grpc"
+
+	"github.com/s7techlab/cckit/gateway"
+)
+
+type (
+	// Chaincodes access to chaincodes: gateway chaincode service over mocked peer of dev environment
+	// or remote gateway
+	Chaincodes interface {
+		Query(ctx context.Context, req *gateway.ChaincodeQueryRequest) (*peer.Response, error)
+		Invoke(ctx context.Context, req *gateway.ChaincodeInvokeRequest) (*peer.Response, error)
+		Events(ctx context.Context, req *gateway.ChaincodeEventsRequest) (*gateway.ChaincodeEvents, error)
+		EventsChan(ctx context.Context, req *gateway.ChaincodeEventsStreamRequest) (
+			_ chan *gateway.ChaincodeEvent, closer func() error, _ error)
+	}
+
+	// GatewayClient chaincodes of remote gateway, accessed with gateway chaincode service gRPC client
+	GatewayClient struct {
+		Client gateway.ChaincodeServiceClient
+	}
+)
+
+var (
+	_ Chaincodes = &gateway.ChaincodeService{}
+	_ Chaincodes = &GatewayClient{}
+)
+
+func NewGatewayClient(conn *grpc.ClientConn) *GatewayClient {
+	return &GatewayClient{
+		Client: gateway.NewChaincodeServiceClient(conn),
+	}
+}
+
+func (gc *GatewayClient) Query(ctx context.Context, req *gateway.ChaincodeQueryRequest) (*peer.Response, error) {
+	return gc.Client.Query(ctx, req)
+}
+
+func (gc *GatewayClient) Invoke(ctx context.Context, req *gateway.ChaincodeInvokeRequest) (*peer.Response, error) {
+	return gc.Client.Invoke(ctx, req)
+}
+
+func (gc *GatewayClient) Events(ctx context.Context, req *gateway.ChaincodeEventsRequest) (*gateway.ChaincodeEvents, error) {
+	return gc.Client.Events(ctx, req)
+}
+
+// EventsChan receives events from gateway events stream until context done or closer called
+func (gc *GatewayClient) EventsChan(ctx context.Context, req *gateway.ChaincodeEventsStreamRequest) (
+	_ chan *gateway.ChaincodeEvent, closer func() error, _ error) {
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := gc.Client.EventsStream(ctx, req)
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+
+	events := make(chan *gateway.ChaincodeEvent)
+	go func() {
+		defer close(events)
+		for {
+			e, err := stream.Recv()
+			if err != nil {
+				return
+			}
+
+			select {
+			case events <- e:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, func() error {
+		cancel()
+		return nil
+	}, nil
+}
@@ -0,0 +1,295 @@
+// Package cli implements cckit command-line client: queries and invokes chaincode methods with JSON args,
+// tails decoded chaincode events and dumps chaincode state via debug extension
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/s7techlab/cckit/extensions/catalog"
+	"github.com/s7techlab/cckit/gateway"
+	"github.com/s7techlab/cckit/gateway/dynamic"
+	"github.com/s7techlab/cckit/state/schema"
+	testcc "github.com/s7techlab/cckit/testing"
+)
+
+type (
+	CLI struct {
+		Stdout io.Writer
+		Stderr io.Writer
+
+		chaincodes map[string]shim.Chaincode
+	}
+
+	Opt func(*CLI)
+
+	command struct {
+		usage string
+		run   func(ctx context.Context, c *CLI, args []string) error
+	}
+
+	// connection flags, common for commands
+	connection struct {
+		env          string
+		gateway      string
+		channel      string
+		chaincode    string
+		identity     string
+		descriptors  string
+		catalog      bool
+		methodPrefix bool
+	}
+
+	// session with chaincode of dev environment or remote gateway
+	session struct {
+		Chaincodes  Chaincodes
+		Locator     *gateway.ChaincodeLocator
+		Descriptors *Descriptors
+		// Peer mocked peer of dev environment, nil for remote gateway
+		Peer *testcc.MockedPeer
+		// Service gateway chaincode service of dev environment, nil for remote gateway
+		Service *gateway.ChaincodeService
+
+		closer func() error
+	}
+)
+
+var commands = map[string]command{
+	`query`:  {usage: `query chaincode method: query [flags] <method> [json | args...]`, run: runQuery},
+	`invoke`: {usage: `invoke chaincode method: invoke [flags] <method> [json | args...]`, run: runInvoke},
+	`events`: {usage: `list or tail decoded chaincode events: events [flags]`, run: runEvents},
+	`state`:  {usage: `dump chaincode state via debug extension: state [flags]`, run: runState},
+	`serve`:  {usage: `serve dev environment with gateway server: serve [flags]`, run: runServe},
+}
+
+// WithChaincode registers chaincode implementation, dev environment refers to chaincodes by name
+func WithChaincode(name string, cc shim.Chaincode) Opt {
+	return func(c *CLI) {
+		c.chaincodes[name] = cc
+	}
+}
+
+func WithOutput(stdout, stderr io.Writer) Opt {
+	return func(c *CLI) {
+		c.Stdout = stdout
+		c.Stderr = stderr
+	}
+}
+
+func New(opts ...Opt) *CLI {
+	c := &CLI{
+		Stdout:     os.Stdout,
+		Stderr:     os.Stderr,
+		chaincodes: make(map[string]shim.Chaincode),
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Run runs command, args are command name with command flags and args, i.e. `query -env dev.yaml <method> <json>`
+func (c *CLI) Run(ctx context.Context, args []string) error {
+	if len(args) == 0 || args[0] == `help` || args[0] == `-h` || args[0] == `--help` {
+		c.usage()
+		return nil
+	}
+
+	cmd, ok := commands[args[0]]
+	if !ok {
+		c.usage()
+		return fmt.Errorf(`%w: %s`, ErrUnknownCommand, args[0])
+	}
+
+	if err := cmd.run(ctx, c, args[1:]); !errors.Is(err, flag.ErrHelp) {
+		return err
+	}
+	return nil
+}
+
+func (c *CLI) usage() {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	_, _ = fmt.Fprintln(c.Stderr, `usage: cckit <command> [flags] [args]`)
+	_, _ = fmt.Fprintln(c.Stderr, `commands:`)
+	for _, name := range names {
+		_, _ = fmt.Fprintf(c.Stderr, "  %-8s %s\n", name, commands[name].usage)
+	}
+	_, _ = fmt.Fprintln(c.Stderr, `run 'cckit <command> -h' for command flags`)
+}
+
+func (c *CLI) flagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(c.Stderr)
+	return fs
+}
+
+func (cn *connection) register(fs *flag.FlagSet) {
+	fs.StringVar(&cn.env, `env`, ``, `dev environment YAML file, chaincodes are run on mocked peer`)
+	fs.StringVar(&cn.gateway, `gateway`, ``, `cckit gateway gRPC address (insecure), i.e. localhost:8080`)
+	fs.StringVar(&cn.channel, `channel`, ``, `channel name, can be omitted if dev environment has one channel`)
+	fs.StringVar(&cn.chaincode, `chaincode`, ``, `chaincode name, can be omitted if dev environment channel has one chaincode`)
+	fs.StringVar(&cn.identity, `identity`, ``, `dev environment identity name, first identity by default`)
+	fs.StringVar(&cn.descriptors, `descriptors`, ``, `file descriptor set, i.e. created with buf build -o`)
+	fs.BoolVar(&cn.catalog, `catalog`, false, `load proto descriptors from chaincode catalog extension`)
+	fs.BoolVar(&cn.methodPrefix, `method-prefix`, false, `chaincode method names are prefixed with service name`)
+}
+
+// connect creates session with dev environment (setup transactions are applied) or remote gateway
+func (c *CLI) connect(ctx context.Context, cn *connection) (*session, error) {
+	s := &session{
+		Descriptors: NewDescriptors(cn.methodPrefix),
+		closer:      func() error { return nil },
+	}
+
+	if cn.descriptors != `` {
+		fileSet, err := dynamic.LoadFileDescriptorSet(cn.descriptors)
+		if err != nil {
+			return nil, err
+		}
+		if err = s.Descriptors.AddFiles(fileSet); err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		env *Env
+		err error
+	)
+	switch {
+	case cn.env != ``:
+		if env, err = LoadEnv(cn.env); err != nil {
+			return nil, err
+		}
+		if err = c.connectEnv(s, env, cn); err != nil {
+			return nil, err
+		}
+
+	case cn.gateway != ``:
+		conn, err := grpc.DialContext(ctx, cn.gateway, grpc.WithInsecure())
+		if err != nil {
+			return nil, err
+		}
+		s.Chaincodes = NewGatewayClient(conn)
+		s.Locator = &gateway.ChaincodeLocator{Channel: cn.channel, Chaincode: cn.chaincode}
+		s.closer = conn.Close
+
+	default:
+		return nil, ErrConnectionRequired
+	}
+
+	if cn.catalog {
+		if err = s.loadCatalog(ctx); err != nil {
+			_ = s.closer()
+			return nil, err
+		}
+	}
+
+	if env != nil {
+		for _, tx := range env.Setup {
+			if err = s.setup(ctx, env, tx); err != nil {
+				return nil, fmt.Errorf(`setup %s: %w`, tx.Method, err)
+			}
+		}
+	}
+
+	return s, nil
+}
+
+func (c *CLI) connectEnv(s *session, env *Env, cn *connection) error {
+	var err error
+	if s.Locator, err = env.Locator(cn.channel, cn.chaincode); err != nil {
+		return err
+	}
+
+	signer, err := env.Identity(cn.identity)
+	if err != nil {
+		return err
+	}
+
+	if s.Peer, err = env.Peer(c.chaincodes); err != nil {
+		return err
+	}
+
+	s.Service = gateway.NewChaincodeService(s.Peer, gateway.WithDefaultSigner(signer))
+	s.Chaincodes = s.Service
+	return nil
+}
+
+func (s *session) loadCatalog(ctx context.Context) error {
+	c := &schema.Catalog{}
+	if err := s.query(ctx, catalog.CatalogServiceChaincode_GetCatalog, &emptypb.Empty{}, c); err != nil {
+		return fmt.Errorf(`get catalog: %w`, err)
+	}
+	return s.Descriptors.AddCatalog(c)
+}
+
+// setup applies setup transaction of dev environment
+func (s *session) setup(ctx context.Context, env *Env, tx *EnvTx) error {
+	locator, err := env.Locator(tx.Channel, tx.Chaincode)
+	if err != nil {
+		return err
+	}
+
+	signer, err := env.Identity(tx.Identity)
+	if err != nil {
+		return err
+	}
+	ctx = gateway.ContextWithSigner(ctx, signer)
+
+	input, err := s.input(tx.Method, tx.Args)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.Chaincodes.Invoke(ctx, &gateway.ChaincodeInvokeRequest{Locator: locator, Input: input})
+	return err
+}
+
+// input returns chaincode input: proto encoded JSON for method from descriptors
+// or raw string args for chaincode function
+func (s *session) input(method string, args []string) (*gateway.ChaincodeInput, error) {
+	md, err := s.Descriptors.Method(method)
+	if err != nil {
+		input := &gateway.ChaincodeInput{Args: [][]byte{[]byte(method)}}
+		for _, arg := range args {
+			input.Args = append(input.Args, []byte(arg))
+		}
+		return input, nil
+	}
+
+	if len(args) > 1 {
+		return nil, fmt.Errorf(`%w: method %s has one JSON arg`, ErrArgsInvalid, method)
+	}
+
+	bb, err := s.Descriptors.Input(md, strings.Join(args, ``))
+	if err != nil {
+		return nil, err
+	}
+	return &gateway.ChaincodeInput{Args: [][]byte{[]byte(s.Descriptors.ChaincodeMethod(md)), bb}}, nil
+}
+
+// output returns JSON of response payload: decoded method output or raw payload for chaincode function
+func (s *session) output(method string, payload []byte) (json.RawMessage, error) {
+	md, err := s.Descriptors.Method(method)
+	if err != nil {
+		return raw(payload), nil
+	}
+	return s.Descriptors.Output(md, payload)
+}
@@ -0,0 +1,177 @@
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+
+	"github.com/s7techlab/cckit/cmd/cckit/cli"
+	cpservice "github.com/s7techlab/cckit/examples/cpaper_asservice"
+	"github.com/s7techlab/cckit/extensions/catalog"
+	"github.com/s7techlab/cckit/extensions/debug"
+	"github.com/s7techlab/cckit/gateway"
+	"github.com/s7techlab/cckit/router"
+)
+
+func TestCLI(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CLI suite")
+}
+
+const (
+	Env        = `../testdata/cpaper.yaml`
+	CPaperGet  = `examples.cpaper_asservice.CPaperService.Get`
+	CPaperBuy  = `examples.cpaper_asservice.CPaperService.Buy`
+	CPaperId1  = `{"issuer": "SomeIssuer", "paper_number": "0001"}`
+	CPaperBuy1 = `{"issuer": "SomeIssuer", "paper_number": "0001", "current_owner": "SomeIssuer",
+		"new_owner": "SomeBuyer", "price": 95000, "purchase_date": "2021-11-01T00:00:00Z"}`
+)
+
+var _ = Describe(`CLI`, func() {
+
+	var (
+		ctx = context.Background()
+		cc  *router.Chaincode
+
+		run = func(ctx context.Context, args ...string) (string, error) {
+			stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+			err := cli.New(cli.WithChaincode(`cpaper_asservice`, cc), cli.WithOutput(stdout, stderr)).Run(ctx, args)
+			return stdout.String(), err
+		}
+
+		decode = func(out string) map[string]interface{} {
+			res := make(map[string]interface{})
+			Expect(json.Unmarshal([]byte(out), &res)).NotTo(HaveOccurred())
+			return res
+		}
+
+		lines = func(out string) []map[string]interface{} {
+			var res []map[string]interface{}
+			for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+				res = append(res, decode(line))
+			}
+			return res
+		}
+	)
+
+	It("Init", func() {
+		r, err := cpservice.CCRouter(`CommercialPaper`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(catalog.RegisterCatalogServiceChaincode(r,
+			catalog.NewService(cpservice.StateMappings, cpservice.EventMappings))).NotTo(HaveOccurred())
+		Expect(debug.RegisterDebugStateServiceChaincode(r, debug.NewStateService())).NotTo(HaveOccurred())
+		cc = router.NewChaincode(r)
+	})
+
+	It("Disallow to run unknown command or command without connection", func() {
+		_, err := run(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = run(ctx, `unknown`)
+		Expect(errors.Is(err, cli.ErrUnknownCommand)).To(BeTrue())
+
+		_, err = run(ctx, `query`, CPaperGet, CPaperId1)
+		Expect(errors.Is(err, cli.ErrConnectionRequired)).To(BeTrue())
+
+		_, err = run(ctx, `query`, `-env`, Env)
+		Expect(errors.Is(err, cli.ErrArgsInvalid)).To(BeTrue())
+
+		err = cli.New(cli.WithOutput(&bytes.Buffer{}, &bytes.Buffer{})).Run(ctx, []string{`query`, `-env`, Env, CPaperGet})
+		Expect(errors.Is(err, cli.ErrChaincodeNotRegistered)).To(BeTrue())
+	})
+
+	It("Allow to query method of dev environment with JSON args", func() {
+		out, err := run(ctx, `query`, `-env`, Env, CPaperGet, CPaperId1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(decode(out)).To(HaveKeyWithValue(`owner`, `SomeIssuer`))
+
+		_, err = run(ctx, `query`, `-env`, Env, CPaperGet, `{"unknown_field": 1}`)
+		Expect(errors.Is(err, cli.ErrInputInvalid)).To(BeTrue())
+	})
+
+	It("Allow to invoke method of dev environment with descriptors from chaincode catalog", func() {
+		out, err := run(ctx, `invoke`, `-env`, Env, `-catalog`, `-identity`, `buyer`, CPaperBuy, CPaperBuy1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(decode(out)).To(HaveKeyWithValue(`owner`, `SomeBuyer`))
+	})
+
+	It("Allow to list decoded events", func() {
+		out, err := run(ctx, `events`, `-env`, Env)
+		Expect(err).NotTo(HaveOccurred())
+
+		events := lines(out)
+		Expect(events).To(HaveLen(1))
+		Expect(events[0]).To(HaveKeyWithValue(`event_name`, `IssueCommercialPaper`))
+		Expect(events[0][`payload`]).To(HaveKeyWithValue(`externalId`, `EXT0001`))
+	})
+
+	It("Allow to dump decoded state via debug extension", func() {
+		out, err := run(ctx, `state`, `-env`, Env, `-catalog`, `-prefix`, `CommercialPaper`)
+		Expect(err).NotTo(HaveOccurred())
+
+		entries := lines(out)
+		Expect(entries).NotTo(BeEmpty())
+		Expect(entries[0][`key`]).To(Equal([]interface{}{`CommercialPaper`, `SomeIssuer`, `0001`}))
+		Expect(entries[0][`value`]).To(HaveKeyWithValue(`owner`, `SomeIssuer`))
+
+		out, err = run(ctx, `state`, `-env`, Env, `-keys`)
+		Expect(err).NotTo(HaveOccurred())
+		for _, entry := range lines(out) {
+			Expect(entry).NotTo(HaveKey(`value`))
+		}
+	})
+
+	It("Allow to call chaincode via remote gateway", func() {
+		env, err := cli.LoadEnv(Env)
+		Expect(err).NotTo(HaveOccurred())
+		signer, err := env.Identity(``)
+		Expect(err).NotTo(HaveOccurred())
+		peer, err := env.Peer(map[string]shim.Chaincode{`cpaper_asservice`: cc})
+		Expect(err).NotTo(HaveOccurred())
+
+		ccService := gateway.NewChaincodeService(peer, gateway.WithDefaultSigner(signer))
+		server, err := gateway.NewServer(gateway.WithServices(ccService.ServiceDef(), ccService.EventService.ServiceDef()))
+		Expect(err).NotTo(HaveOccurred())
+
+		grpcListener, err := net.Listen(`tcp`, `127.0.0.1:0`)
+		Expect(err).NotTo(HaveOccurred())
+		httpListener, err := net.Listen(`tcp`, `127.0.0.1:0`)
+		Expect(err).NotTo(HaveOccurred())
+
+		serveCtx, cancel := context.WithCancel(ctx)
+		served := make(chan error, 1)
+		go func() {
+			served <- server.Serve(serveCtx, grpcListener, httpListener)
+		}()
+		defer func() {
+			cancel()
+			Eventually(served).Should(Receive(BeNil()))
+		}()
+
+		remote := []string{`-gateway`, grpcListener.Addr().String(), `-channel`, `my_channel`, `-chaincode`, `cpaper`}
+
+		out, err := run(ctx, append(append([]string{`invoke`}, remote...),
+			`examples.cpaper_asservice.CPaperService.Issue`,
+			`{"issuer": "SomeIssuer", "paper_number": "0002", "issue_date": "2021-10-01T00:00:00Z",
+			"maturity_date": "2022-10-01T00:00:00Z", "face_value": 100, "external_id": "EXT0002"}`)...)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(decode(out)).To(HaveKeyWithValue(`paperNumber`, `0002`))
+
+		tailCtx, stop := context.WithTimeout(ctx, 300*time.Millisecond)
+		defer stop()
+		out, err = run(tailCtx, append(append([]string{`events`}, remote...), `-follow`)...)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(lines(out)).To(HaveLen(1))
+		Expect(lines(out)[0][`payload`]).To(HaveKeyWithValue(`paperNumber`, `0002`))
+	})
+})
@@ -0,0 +1,302 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric-protos-go/peer"
+
+	"github.com/s7techlab/cckit/extensions/debug"
+	"github.com/s7techlab/cckit/gateway"
+	"github.com/s7techlab/cckit/gateway/dynamic"
+)
+
+type (
+	// eventLine output of events command, one line per event
+	eventLine struct {
+		Block     uint64          `json:"block"`
+		TxID      string          `json:"tx_id"`
+		EventName string          `json:"event_name"`
+		Payload   json.RawMessage `json:"payload"`
+	}
+
+	// stateLine output of state command, one line per state entry
+	stateLine struct {
+		Key   []string        `json:"key"`
+		Value json.RawMessage `json:"value,omitempty"`
+	}
+)
+
+func runQuery(ctx context.Context, c *CLI, args []string) error {
+	return c.call(ctx, `query`, args)
+}
+
+func runInvoke(ctx context.Context, c *CLI, args []string) error {
+	return c.call(ctx, `invoke`, args)
+}
+
+// call queries or invokes chaincode method, decoded response is printed as indented JSON
+func (c *CLI) call(ctx context.Context, name string, args []string) error {
+	fs := c.flagSet(name)
+	cn := &connection{}
+	cn.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		return fmt.Errorf(`%w: method required`, ErrArgsInvalid)
+	}
+
+	s, err := c.connect(ctx, cn)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = s.closer() }()
+
+	method := fs.Arg(0)
+	input, err := s.input(method, fs.Args()[1:])
+	if err != nil {
+		return err
+	}
+
+	var res *peer.Response
+	if name == `query` {
+		res, err = s.Chaincodes.Query(ctx, &gateway.ChaincodeQueryRequest{Locator: s.Locator, Input: input})
+	} else {
+		res, err = s.Chaincodes.Invoke(ctx, &gateway.ChaincodeInvokeRequest{Locator: s.Locator, Input: input})
+	}
+	if err != nil {
+		return err
+	}
+
+	out, err := s.output(method, res.Payload)
+	if err != nil {
+		return err
+	}
+	return writeJSON(c.Stdout, out, true)
+}
+
+// runEvents lists chaincode events up to channel height or tails events until interrupted
+func runEvents(ctx context.Context, c *CLI, args []string) error {
+	fs := c.flagSet(`events`)
+	cn := &connection{}
+	cn.register(fs)
+	from := fs.Uint64(`from`, 0, `from block number`)
+	names := fs.String(`name`, ``, `comma separated event names`)
+	filter := fs.String(`filter`, ``, `payload filter expression, i.e. owner == "X"`)
+	follow := fs.Bool(`follow`, false, `tail events until interrupted`)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	s, err := c.connect(ctx, cn)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = s.closer() }()
+
+	var eventNames []string
+	if *names != `` {
+		eventNames = strings.Split(*names, `,`)
+	}
+
+	if !*follow {
+		events, err := s.Chaincodes.Events(ctx, &gateway.ChaincodeEventsRequest{
+			Locator:       s.Locator,
+			FromBlock:     &gateway.BlockLimit{Num: int64(*from)},
+			EventName:     eventNames,
+			PayloadFilter: *filter,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, e := range events.Items {
+			if err = c.writeEvent(s, e); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	events, closer, err := s.Chaincodes.EventsChan(ctx, &gateway.ChaincodeEventsStreamRequest{
+		Locator:       s.Locator,
+		FromBlock:     &gateway.BlockLimit{Num: int64(*from)},
+		EventName:     eventNames,
+		PayloadFilter: *filter,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = closer() }()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e, ok := <-events:
+			if !ok {
+				return nil
+			}
+			if err = c.writeEvent(s, e); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (c *CLI) writeEvent(s *session, e *gateway.ChaincodeEvent) error {
+	bb, err := json.Marshal(&eventLine{
+		Block:     e.Block,
+		TxID:      e.Event.GetTxId(),
+		EventName: e.Event.GetEventName(),
+		Payload:   s.Descriptors.Event(e.Event.GetEventName(), e.Event.GetPayload()),
+	})
+	if err != nil {
+		return err
+	}
+	return writeJSON(c.Stdout, bb, false)
+}
+
+// runState dumps state entries with key prefix, chaincode must serve debug state service
+func runState(ctx context.Context, c *CLI, args []string) error {
+	fs := c.flagSet(`state`)
+	cn := &connection{}
+	cn.register(fs)
+	prefix := fs.String(`prefix`, ``, `comma separated key prefix parts, i.e. CommercialPaper,SomeIssuer`)
+	keysOnly := fs.Bool(`keys`, false, `dump keys without values`)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	s, err := c.connect(ctx, cn)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = s.closer() }()
+
+	keyPrefix := &debug.Prefix{}
+	if *prefix != `` {
+		keyPrefix.Key = strings.Split(*prefix, `,`)
+	}
+
+	keys := &debug.CompositeKeys{}
+	if err = s.query(ctx, debug.DebugStateServiceChaincode_ListKeys, keyPrefix, keys); err != nil {
+		return fmt.Errorf(`list keys: %w`, err)
+	}
+
+	for _, key := range keys.Keys {
+		line := &stateLine{Key: key.Key}
+		if !*keysOnly {
+			value := &debug.Value{}
+			if err = s.query(ctx, debug.DebugStateServiceChaincode_GetState, key, value); err != nil {
+				return fmt.Errorf(`get state %s: %w`, strings.Join(key.Key, ` | `), err)
+			}
+			line.Value = s.Descriptors.State(key.Key, value.Value)
+		}
+
+		bb, err := json.Marshal(line)
+		if err != nil {
+			return err
+		}
+		if err = writeJSON(c.Stdout, bb, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runServe serves chaincodes of dev environment with gateway server until interrupted.
+// With catalog flag chaincode services from catalog are served with dynamic gateway
+func runServe(ctx context.Context, c *CLI, args []string) error {
+	fs := c.flagSet(`serve`)
+	cn := &connection{}
+	cn.register(fs)
+	grpcAddr := fs.String(`grpc`, `localhost:8080`, `gRPC listen address`)
+	httpAddr := fs.String(`http`, `localhost:8081`, `HTTP listen address`)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if cn.env == `` {
+		return ErrEnvRequired
+	}
+
+	s, err := c.connect(ctx, cn)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = s.closer() }()
+
+	services := []gateway.Service{s.Service.ServiceDef(), s.Service.EventService.ServiceDef()}
+	if cn.catalog {
+		var opts []dynamic.Opt
+		if cn.methodPrefix {
+			opts = append(opts, dynamic.WithServiceNameMethodPrefix())
+		}
+		dyn, err := dynamic.FromCatalog(ctx, s.Service.InstanceService(s.Locator), opts...)
+		if err != nil {
+			return err
+		}
+		services = append(services, dyn.ServiceDefs()...)
+	}
+
+	server, err := gateway.NewServer(gateway.WithServices(services...))
+	if err != nil {
+		return err
+	}
+
+	grpcListener, err := net.Listen(`tcp`, *grpcAddr)
+	if err != nil {
+		return err
+	}
+	httpListener, err := net.Listen(`tcp`, *httpAddr)
+	if err != nil {
+		_ = grpcListener.Close()
+		return err
+	}
+
+	_, _ = fmt.Fprintf(c.Stderr, "serving gRPC on %s, HTTP on %s\n", grpcListener.Addr(), httpListener.Addr())
+	return server.Serve(ctx, grpcListener, httpListener)
+}
+
+// query queries chaincode method with proto request and unmarshals response payload to target
+func (s *session) query(ctx context.Context, method string, req, target proto.Message) error {
+	bb, err := proto.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	res, err := s.Chaincodes.Query(ctx, &gateway.ChaincodeQueryRequest{
+		Locator: s.Locator,
+		Input:   &gateway.ChaincodeInput{Args: [][]byte{[]byte(method), bb}},
+	})
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(res.Payload, target)
+}
+
+// writeJSON writes JSON as one line or indented
+func writeJSON(w io.Writer, bb []byte, indent bool) error {
+	buf := &bytes.Buffer{}
+
+	var err error
+	if indent {
+		err = json.Indent(buf, bb, ``, `  `)
+	} else {
+		err = json.Compact(buf, bb)
+	}
+	if err != nil {
+		return err
+	}
+
+	buf.WriteByte('\n')
+	_, err = w.Write(buf.Bytes())
+	return err
+}
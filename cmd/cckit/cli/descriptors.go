@@ -0,0 +1,184 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/s7techlab/cckit/state"
+	"github.com/s7techlab/cckit/state/mapping"
+	"github.com/s7techlab/cckit/state/schema"
+)
+
+type (
+	// Descriptors resolves chaincode methods, events and state entries with proto descriptors:
+	// loaded from file descriptor set, from chaincode catalog and registered in cli binary
+	Descriptors struct {
+		files   []*protoregistry.Files
+		catalog *mapping.CatalogResolver
+		// methodPrefix chaincode method names are prefixed with service name
+		methodPrefix bool
+	}
+)
+
+// NewDescriptors creates descriptors with proto files, registered in cli binary
+func NewDescriptors(methodPrefix bool) *Descriptors {
+	return &Descriptors{
+		files:        []*protoregistry.Files{protoregistry.GlobalFiles},
+		methodPrefix: methodPrefix,
+	}
+}
+
+// AddFiles adds file descriptor set, added files take precedence over registered
+func (d *Descriptors) AddFiles(fileSet *descriptorpb.FileDescriptorSet) error {
+	files, err := protodesc.NewFiles(fileSet)
+	if err != nil {
+		return fmt.Errorf(`file descriptor set: %w`, err)
+	}
+
+	d.files = append([]*protoregistry.Files{files}, d.files...)
+	return nil
+}
+
+// AddCatalog adds proto files from chaincode catalog, catalog is used to decode events and state entries
+func (d *Descriptors) AddCatalog(catalog *schema.Catalog) error {
+	resolver, err := mapping.NewCatalogResolver(catalog)
+	if err != nil {
+		return err
+	}
+	d.catalog = resolver
+	return d.AddFiles(catalog.GetFiles())
+}
+
+// Method returns method descriptor by full name, i.e. `examples.cpaper_asservice.CPaperService.Get`
+func (d *Descriptors) Method(name string) (protoreflect.MethodDescriptor, error) {
+	for _, files := range d.files {
+		desc, err := files.FindDescriptorByName(protoreflect.FullName(name))
+		if err != nil {
+			continue
+		}
+		if method, ok := desc.(protoreflect.MethodDescriptor); ok {
+			return method, nil
+		}
+	}
+	return nil, fmt.Errorf(`%w: %s`, ErrMethodNotFound, name)
+}
+
+// ChaincodeMethod returns name of chaincode router method, like chaincode gateway generator
+func (d *Descriptors) ChaincodeMethod(method protoreflect.MethodDescriptor) string {
+	if d.methodPrefix {
+		return fmt.Sprintf(`%s.%s`, method.Parent().Name(), method.Name())
+	}
+	return string(method.Name())
+}
+
+// Input returns proto encoded method input from JSON, empty JSON is empty message
+func (d *Descriptors) Input(method protoreflect.MethodDescriptor, inputJSON string) ([]byte, error) {
+	in := dynamicpb.NewMessage(method.Input())
+	if inputJSON != `` {
+		if err := protojson.Unmarshal([]byte(inputJSON), in); err != nil {
+			return nil, fmt.Errorf(`%w: %s: %s`, ErrInputInvalid, method.Input().FullName(), err)
+		}
+	}
+	return proto.Marshal(in)
+}
+
+// Output returns JSON of proto encoded method output
+func (d *Descriptors) Output(method protoreflect.MethodDescriptor, payload []byte) (json.RawMessage, error) {
+	return d.toJSON(method.Output(), payload)
+}
+
+// Event returns JSON of event payload, payload type is resolved with catalog or by event name,
+// equal to message name. Payload of unknown type is returned as string
+func (d *Descriptors) Event(name string, payload []byte) json.RawMessage {
+	if d.catalog != nil {
+		if _, msg, err := d.catalog.ResolveEvent(name, payload); err == nil {
+			if bb, ok := messageJSON(msg); ok {
+				return bb
+			}
+		}
+	}
+
+	if desc := d.message(protoreflect.Name(name)); desc != nil {
+		if bb, err := d.toJSON(desc, payload); err == nil {
+			return bb
+		}
+	}
+
+	return raw(payload)
+}
+
+// State returns JSON of state entry value, value type is resolved with catalog.
+// Value of unknown type is returned as string
+func (d *Descriptors) State(key []string, value []byte) json.RawMessage {
+	if d.catalog != nil {
+		if _, msg, err := d.catalog.ResolveState(state.Key(key), value); err == nil {
+			if bb, ok := messageJSON(msg); ok {
+				return bb
+			}
+		}
+	}
+
+	return raw(value)
+}
+
+func (d *Descriptors) toJSON(desc protoreflect.MessageDescriptor, bb []byte) (json.RawMessage, error) {
+	msg := dynamicpb.NewMessage(desc)
+	if err := proto.Unmarshal(bb, msg); err != nil {
+		return nil, fmt.Errorf(`unmarshal %s: %w`, desc.FullName(), err)
+	}
+	return protojson.Marshal(msg)
+}
+
+// message returns first message descriptor with name in any package
+func (d *Descriptors) message(name protoreflect.Name) protoreflect.MessageDescriptor {
+	var found protoreflect.MessageDescriptor
+	for _, files := range d.files {
+		files.RangeFiles(func(file protoreflect.FileDescriptor) bool {
+			if msg := file.Messages().ByName(name); msg != nil {
+				found = msg
+				return false
+			}
+			return true
+		})
+		if found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// messageJSON returns JSON of message, resolved with catalog, catalog resolver returns dynamic messages
+func messageJSON(msg interface{}) (json.RawMessage, bool) {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return nil, false
+	}
+	bb, err := protojson.Marshal(m)
+	return bb, err == nil
+}
+
+// raw returns JSON string of UTF-8 value or base64 encoded value
+func raw(value []byte) json.RawMessage {
+	var (
+		bb  []byte
+		err error
+	)
+	if utf8.Valid(value) {
+		bb, err = json.Marshal(string(value))
+	} else {
+		bb, err = json.Marshal(value)
+	}
+	if err != nil {
+		return nil
+	}
+	return bb
+}
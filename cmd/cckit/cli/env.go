@@ -0,0 +1,167 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	"gopkg.in/yaml.v2"
+
+	"github.com/s7techlab/cckit/gateway"
+	"github.com/s7techlab/cckit/identity"
+	testcc "github.com/s7techlab/cckit/testing"
+)
+
+type (
+	// Env local development environment: chaincodes on channels of mocked peer, identities
+	// and transactions, applied to chaincodes on environment start
+	Env struct {
+		Identities []*EnvIdentity `yaml:"identities"`
+		Channels   []*EnvChannel  `yaml:"channels"`
+		Setup      []*EnvTx       `yaml:"setup"`
+
+		// dir of env file, relative paths of certificates and keys are resolved from dir
+		dir string
+	}
+
+	EnvIdentity struct {
+		Name string `yaml:"name"`
+		MSP  string `yaml:"msp"`
+		// Cert path to PEM encoded certificate
+		Cert string `yaml:"cert"`
+		// Key path to PEM encoded ecdsa private key
+		Key string `yaml:"key"`
+	}
+
+	EnvChannel struct {
+		Name       string          `yaml:"name"`
+		Chaincodes []*EnvChaincode `yaml:"chaincodes"`
+	}
+
+	EnvChaincode struct {
+		Name string `yaml:"name"`
+		// Chaincode name of chaincode implementation, registered in cli with WithChaincode
+		Chaincode string `yaml:"chaincode"`
+		// Init chaincode init args, init tx is created by first identity
+		Init []string `yaml:"init"`
+	}
+
+	// EnvTx chaincode transaction, args are JSON encoded request of method from proto descriptors
+	// or raw string args of chaincode function
+	EnvTx struct {
+		Channel   string   `yaml:"channel"`
+		Chaincode string   `yaml:"chaincode"`
+		Method    string   `yaml:"method"`
+		Args      []string `yaml:"args"`
+		// Identity name of identity, first identity by default
+		Identity string `yaml:"identity"`
+	}
+)
+
+// LoadEnv reads environment from YAML file
+func LoadEnv(path string) (*Env, error) {
+	bb, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	env := &Env{dir: filepath.Dir(path)}
+	if err = yaml.UnmarshalStrict(bb, env); err != nil {
+		return nil, fmt.Errorf(`%w: %s`, ErrEnvInvalid, err)
+	}
+
+	if len(env.Identities) == 0 {
+		return nil, fmt.Errorf(`%w: no identities`, ErrEnvInvalid)
+	}
+	if len(env.Channels) == 0 {
+		return nil, fmt.Errorf(`%w: no channels`, ErrEnvInvalid)
+	}
+
+	return env, nil
+}
+
+// Identity returns signing identity by name, first identity if name is empty
+func (e *Env) Identity(name string) (*identity.CertSigningIdentity, error) {
+	for _, id := range e.Identities {
+		if name != `` && id.Name != name {
+			continue
+		}
+
+		certPEM, err := ioutil.ReadFile(e.path(id.Cert))
+		if err != nil {
+			return nil, fmt.Errorf(`identity %s: %w`, id.Name, err)
+		}
+		keyPEM, err := ioutil.ReadFile(e.path(id.Key))
+		if err != nil {
+			return nil, fmt.Errorf(`identity %s: %w`, id.Name, err)
+		}
+
+		return identity.NewSigning(id.MSP, certPEM, keyPEM)
+	}
+
+	return nil, fmt.Errorf(`%w: %s`, ErrIdentityNotFound, name)
+}
+
+// Locator returns chaincode locator, channel and chaincode can be omitted if environment has only one of them
+func (e *Env) Locator(channel, chaincode string) (*gateway.ChaincodeLocator, error) {
+	for _, ch := range e.Channels {
+		if channel != `` && ch.Name != channel {
+			continue
+		}
+		if channel == `` && len(e.Channels) > 1 {
+			return nil, fmt.Errorf(`%w: environment has many channels`, ErrLocatorRequired)
+		}
+
+		for _, cc := range ch.Chaincodes {
+			if chaincode != `` && cc.Name != chaincode {
+				continue
+			}
+			if chaincode == `` && len(ch.Chaincodes) > 1 {
+				return nil, fmt.Errorf(`%w: channel %s has many chaincodes`, ErrLocatorRequired, ch.Name)
+			}
+
+			return &gateway.ChaincodeLocator{Channel: ch.Name, Chaincode: cc.Name}, nil
+		}
+	}
+
+	return nil, fmt.Errorf(`%w: channel=%s, chaincode=%s`, gateway.ErrChaincodeNotExists, channel, chaincode)
+}
+
+// Peer creates mocked peer with initialized chaincodes, chaincode implementations are taken by name
+func (e *Env) Peer(chaincodes map[string]shim.Chaincode) (*testcc.MockedPeer, error) {
+	creator, err := e.Identity(``)
+	if err != nil {
+		return nil, err
+	}
+
+	peer := testcc.NewPeer()
+	for _, ch := range e.Channels {
+		for _, cc := range ch.Chaincodes {
+			impl, ok := chaincodes[cc.Chaincode]
+			if !ok {
+				return nil, fmt.Errorf(`%w: %s`, ErrChaincodeNotRegistered, cc.Chaincode)
+			}
+
+			mockStub := testcc.NewMockStub(cc.Name, impl)
+			args := make([]interface{}, len(cc.Init))
+			for i, arg := range cc.Init {
+				args[i] = arg
+			}
+			if res := mockStub.From(creator).Init(args...); res.Status != shim.OK {
+				return nil, fmt.Errorf(`init chaincode %s: %s`, cc.Name, res.Message)
+			}
+
+			peer.WithChannel(ch.Name, mockStub)
+		}
+	}
+
+	return peer, nil
+}
+
+func (e *Env) path(p string) string {
+	if filepath.IsAbs(p) {
+		return p
+	}
+	return filepath.Join(e.dir, p)
+}
@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"errors"
+)
+
+var (
+	// ErrUnknownCommand occurs when cli is called with unknown command
+	ErrUnknownCommand = errors.New(`unknown command`)
+
+	// ErrArgsInvalid occurs when command is called with wrong number of positional args
+	ErrArgsInvalid = errors.New(`invalid args`)
+
+	// ErrConnectionRequired occurs when neither dev environment nor gateway address is set
+	ErrConnectionRequired = errors.New(`environment file or gateway address required`)
+
+	// ErrEnvRequired occurs when command can be run only with dev environment
+	ErrEnvRequired = errors.New(`environment file required`)
+
+	// ErrEnvInvalid occurs when environment file can't be parsed or has no identities or channels
+	ErrEnvInvalid = errors.New(`environment invalid`)
+
+	// ErrIdentityNotFound occurs when environment has no identity with requested name
+	ErrIdentityNotFound = errors.New(`identity not found`)
+
+	// ErrLocatorRequired occurs when channel or chaincode is not set and can't be chosen by default
+	ErrLocatorRequired = errors.New(`channel and chaincode required`)
+
+	// ErrChaincodeNotRegistered occurs when environment refers to chaincode, not registered in cli
+	ErrChaincodeNotRegistered = errors.New(`chaincode not registered`)
+
+	// ErrMethodNotFound occurs when proto descriptors have no method with requested name
+	ErrMethodNotFound = errors.New(`method not found`)
+
+	// ErrInputInvalid occurs when JSON input can't be converted to method input message
+	ErrInputInvalid = errors.New(`input invalid`)
+)
@@ -0,0 +1,54 @@
+// Command cckit queries and invokes chaincode methods with JSON args, tails decoded chaincode events
+// and dumps chaincode state, against remote gateway or local dev environment with mocked peer.
+// Build own binary with cli.WithChaincode to run own chaincodes in dev environment
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/s7techlab/cckit/cmd/cckit/cli"
+	cpservice "github.com/s7techlab/cckit/examples/cpaper_asservice"
+	"github.com/s7techlab/cckit/extensions/catalog"
+	"github.com/s7techlab/cckit/extensions/debug"
+	"github.com/s7techlab/cckit/router"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	cpaper, err := cpaperChaincode()
+	if err != nil {
+		fatal(err)
+	}
+
+	if err = cli.New(cli.WithChaincode(`cpaper_asservice`, cpaper)).Run(ctx, os.Args[1:]); err != nil {
+		fatal(err)
+	}
+}
+
+// cpaperChaincode example commercial paper chaincode with catalog and debug state services
+func cpaperChaincode() (*router.Chaincode, error) {
+	r, err := cpservice.CCRouter(`CommercialPaper`)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = catalog.RegisterCatalogServiceChaincode(r,
+		catalog.NewService(cpservice.StateMappings, cpservice.EventMappings)); err != nil {
+		return nil, err
+	}
+	if err = debug.RegisterDebugStateServiceChaincode(r, debug.NewStateService()); err != nil {
+		return nil, err
+	}
+
+	return router.NewChaincode(r), nil
+}
+
+func fatal(err error) {
+	_, _ = fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
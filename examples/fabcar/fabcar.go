@@ -151,18 +151,7 @@ func (f *FabCarService) DeleteCar(ctx router.Context, id *CarId) (*CarView, erro
 		return nil, err
 	}
 
-	for _, owner := range carView.Owners.Items {
-		if err = State(ctx).Delete(owner); err != nil {
-			return nil, err
-		}
-	}
-
-	for _, details := range carView.Details.Items {
-		if err = State(ctx).Delete(details); err != nil {
-			return nil, err
-		}
-	}
-
+	// car owners and details are deleted with car, according to state mapping references
 	if err = State(ctx).Delete(carView.Car); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,42 @@
+// Code generated by protoc-gen-cc-gateway. DO NOT EDIT.
+// source: fabcar/fabcar.proto
+
+package fabcar
+
+import (
+	cckit_mapping "github.com/s7techlab/cckit/state/mapping"
+)
+
+// StateMappings state mappings, declared with (state.schema.state) message options
+var StateMappings = cckit_mapping.StateMappings{}.
+	Add(&Maker{},
+		cckit_mapping.PKeySchema(&MakerName{}),
+		cckit_mapping.List(&Makers{}),
+	).
+	Add(&Car{},
+		cckit_mapping.PKeySchema(&CarId{}),
+		cckit_mapping.List(&Cars{}),
+		cckit_mapping.WithReference(&cckit_mapping.StateReferenceDef{Name: "", Fields: []string{"Make"}, To: &Maker{}, OnDelete: cckit_mapping.RefRestrict}),
+	).
+	Add(&CarOwner{},
+		cckit_mapping.PKeySchema(&CarOwnerId{}),
+		cckit_mapping.List(&CarOwners{}),
+		cckit_mapping.WithReference(&cckit_mapping.StateReferenceDef{Name: "", Fields: []string{"CarId"}, To: &Car{}, OnDelete: cckit_mapping.RefCascade}),
+	).
+	Add(&CarDetail{},
+		cckit_mapping.PKeySchema(&CarDetailId{}),
+		cckit_mapping.List(&CarDetails{}),
+		cckit_mapping.WithReference(&cckit_mapping.StateReferenceDef{Name: "", Fields: []string{"CarId"}, To: &Car{}, OnDelete: cckit_mapping.RefCascade}),
+	)
+
+// EventMappings event mappings, declared with (state.schema.event) message options
+var EventMappings = cckit_mapping.EventMappings{}.
+	Add(&MakerCreated{}).
+	Add(&MakerDeleted{}).
+	Add(&CarCreated{}).
+	Add(&CarDeleted{}).
+	Add(&CarUpdated{}).
+	Add(&CarOwnersUpdated{}).
+	Add(&CarOwnerDeleted{}).
+	Add(&CarDetailsUpdated{}).
+	Add(&CarDetailDeleted{})
@@ -45,10 +45,12 @@ func (m *Mapper) CreateCar(ctx router.Context, req *CreateCarRequest) {
 
 	m.Car.Id = CreateCarID(m.Car)
 
+	// car should be inserted before owners and details, referring to car
+	m.State.Commands.Insert(m.Car)
+
 	m.SetCarOwners(ctx, req.Owners)
 	m.SetCarDetails(ctx, req.Details)
 
-	m.State.Commands.Insert(m.Car)
 	m.State.Event = mapping.EventFromPayload(&CarCreated{
 		Id:     m.Car.Id,
 		Make:   m.Car.Make,
@@ -1,6 +1,7 @@
 package fabcar_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/golang/protobuf/ptypes/empty"
@@ -10,6 +11,7 @@ import (
 	"github.com/s7techlab/cckit/examples/fabcar"
 	"github.com/s7techlab/cckit/examples/fabcar/testdata"
 	identitytestdata "github.com/s7techlab/cckit/identity/testdata"
+	"github.com/s7techlab/cckit/state/mapping"
 	testcc "github.com/s7techlab/cckit/testing"
 )
 
@@ -228,15 +230,33 @@ var _ = Describe(`FabCar service`, func() {
 			})
 		})
 
-		It("allow to delete car", func() {
+		It("disallow to delete maker with cars", func() {
 			cc.Tx(func() {
-				req := testdata.Car1.Clone()
-				req.Create.Make = testdata.MakerAudi.Create.Name
+				_, err := fabCarCC.DeleteMaker(ctx, &fabcar.MakerName{Name: testdata.MakerAudi.Create.Name})
+				Expect(errors.Is(err, mapping.ErrReferringEntriesExist)).To(BeTrue())
+			})
+		})
 
+		It("allow to delete car", func() {
+			req := testdata.Car1.Clone()
+			req.Create.Make = testdata.MakerAudi.Create.Name
+
+			cc.Tx(func() {
 				carView, err := fabCarCC.DeleteCar(ctx, &fabcar.CarId{Id: req.IdStrings()})
 				Expect(err).NotTo(HaveOccurred())
 				req.ExpectCreateEqualCarView(carView)
 			})
+
+			// car owners and details are deleted with car
+			cc.Tx(func() {
+				carOwners, err := fabCarCC.ListCarOwners(ctx, &fabcar.CarId{Id: req.IdStrings()})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(carOwners.Items).To(HaveLen(0))
+
+				carDetails, err := fabCarCC.ListCarDetails(ctx, &fabcar.CarId{Id: req.IdStrings()})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(carDetails.Items).To(HaveLen(0))
+			})
 		})
 
 		It("disallow to delete car", func() {
@@ -2,46 +2,38 @@
 package cars
 
 import (
-	"time"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/s7techlab/cckit/extensions/owner"
 	"github.com/s7techlab/cckit/router"
 	p "github.com/s7techlab/cckit/router/param"
+	"github.com/s7techlab/cckit/state"
+	m "github.com/s7techlab/cckit/state/mapping"
 )
 
 const CarEntity = `CAR`
 const CarRegisteredEvent = `CAR_REGISTERED`
+const CarCollection = `testCollection`
 
-// CarPayload chaincode method argument
-type CarPayload struct {
-	Id    string
-	Title string
-	Owner string
-}
-
-// Car struct for chaincode state
-type Car struct {
-	Id    string
-	Title string
-	Owner string
-
-	UpdatedAt time.Time // set by chaincode method
-}
-
-// Key for car entry in chaincode state
-func (c Car) Key() ([]string, error) {
-	return []string{CarEntity, c.Id}, nil
-}
+// StateMappings car entries stored in private data collection, stub entry `{}` - in public state
+var StateMappings = m.StateMappings{}.
+	Add(&Car{},
+		m.WithNamespace(state.Key{CarEntity}), // key namespace will be <"CAR", Id>
+		m.PKeyId(),
+		m.List(&CarList{}),
+		m.WithCollection(CarCollection, m.CollectionPublicStubEntry()))
 
 func New() *router.Chaincode {
 	r := router.New(`cars`) // also initialized logger with "cars" prefix
 
+	r.Use(m.MapStates(StateMappings)) // state operations with cars are routed to private data collection
+
 	r.Init(invokeInit)
 
 	r.Group(`car`).
 		Query(`List`, queryCars).                                             // chain code method name is carList
 		Query(`Get`, queryCar, p.String(`id`)).                               // chain code method name is carGet, method has 1 string argument "id"
-		Invoke(`Register`, invokeCarRegister, p.Struct(`car`, &CarPayload{}), // 1 struct argument
+		Invoke(`Register`, invokeCarRegister, p.Proto(`car`, &CarPayload{}), // 1 proto argument
 			owner.Only) // allow access to method only for chaincode owner (authority)
 
 	return router.NewChaincode(r)
@@ -56,31 +48,27 @@ func invokeInit(c router.Context) (interface{}, error) {
 
 // car get info chaincode method handler
 func queryCar(c router.Context) (interface{}, error) {
-	// get state entry by composite key using CarKeyPrefix and car.Id
-	//  and unmarshal from []byte to Car struct
-	return c.State().GetPrivate("testCollection", &Car{Id: c.ParamString(`id`)})
+	// get private state entry by composite key using CarEntity and car.Id
+	//  and unmarshal from []byte to Car proto
+	return c.State().Get(&Car{Id: c.ParamString(`id`)})
 }
 
 // cars car list chaincode method handler
 func queryCars(c router.Context) (interface{}, error) {
-	return c.State().ListPrivate(
-		"testCollection",
-		false,
-		CarEntity, // get list of state entries of type CarKeyPrefix
-		&Car{})    // unmarshal from []byte and append to []Car slice
+	return c.State().List(&Car{}) // list of private state entries, unmarshalled to CarList
 }
 
 // carRegister car register chaincode method handler
 func invokeCarRegister(c router.Context) (interface{}, error) {
 	// arg name defined in router method definition
-	param := c.Param(`car`).(CarPayload)
+	param := c.Param(`car`).(*CarPayload)
 
 	t, _ := c.Time() // tx time
 	car := &Car{     // data for chaincode state
 		Id:        param.Id,
 		Title:     param.Title,
 		Owner:     param.Owner,
-		UpdatedAt: t,
+		UpdatedAt: timestamppb.New(t),
 	}
 
 	// trigger event
@@ -88,12 +76,8 @@ func invokeCarRegister(c router.Context) (interface{}, error) {
 		return nil, err
 	}
 
-	if err := c.State().Put(car, "{}"); err != nil {
-		return nil, err
-	}
-
-	return car, // peer.Response payload will be json serialized car data
-		//put json serialized data to state
-		// create composite key using CarKeyPrefix and car.Id
-		c.State().InsertPrivate("testCollection", car)
+	return car, // peer.Response payload will be proto serialized car data
+		// put proto serialized data to private data collection and stub entry to public state,
+		// create composite key using CarEntity and car.Id
+		c.State().Insert(car)
 }
@@ -4,14 +4,17 @@ package cars
 import (
 	"github.com/s7techlab/cckit/router"
 	p "github.com/s7techlab/cckit/router/param"
+	m "github.com/s7techlab/cckit/state/mapping"
 )
 
 func NewWithoutAccessControl() *router.Chaincode {
 	r := router.New(`cars_without_access_control`) // also initialized logger with "cars" prefix
 
+	r.Use(m.MapStates(StateMappings))
+
 	r.Group(`car`).
 		Query(`List`, queryCars).                                             // chain code method name is carList
 		Query(`Get`, queryCar, p.String(`id`)).                               // chain code method name is carGet, method has 1 string argument "id"
-		Invoke(`Register`, invokeCarRegister, p.Struct(`car`, &CarPayload{})) // allow access to everyone
+		Invoke(`Register`, invokeCarRegister, p.Proto(`car`, &CarPayload{})) // allow access to everyone
 	return router.NewChaincode(r)
 }
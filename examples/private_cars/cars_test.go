@@ -61,20 +61,27 @@ var _ = Describe(`Cars`, func() {
 				state.ErrKeyAlreadyExists) //expect car id already exists
 		})
 
+		It("Allow to store car in private collection and stub entry in public state", func() {
+			key, err := cc.CreateCompositeKey(CarEntity, []string{Payloads[0].Id})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cc.PvtState[CarCollection]).To(HaveKey(key))
+			Expect(cc.State[key]).To(Equal([]byte(`{}`)))
+		})
+
 		It("Allow everyone to retrieve car information", func() {
 			car := expectcc.PayloadIs(cc.Invoke(`carGet`, Payloads[0].Id),
-				&Car{}).(Car)
+				&Car{}).(*Car)
 
 			Expect(car.Title).To(Equal(Payloads[0].Title))
 			Expect(car.Id).To(Equal(Payloads[0].Id))
 		})
 
 		It("Allow everyone to get car list", func() {
-			//  &[]Car{} - declares target type for unmarshalling from []byte received from chaincode
-			cars := expectcc.PayloadIs(cc.Invoke(`carList`), &[]Car{}).([]Car)
+			//  &CarList{} - declares target type for unmarshalling from []byte received from chaincode
+			cars := expectcc.PayloadIs(cc.Invoke(`carList`), &CarList{}).(*CarList)
 
-			Expect(len(cars)).To(Equal(1))
-			Expect(cars[0].Id).To(Equal(Payloads[0].Id))
+			Expect(len(cars.Items)).To(Equal(1))
+			Expect(cars.Items[0].Id).To(Equal(Payloads[0].Id))
 		})
 
 		It("Allow authority to add more information about car", func() {
@@ -82,9 +89,9 @@ var _ = Describe(`Cars`, func() {
 			expectcc.ResponseOk(cc.From(Authority).Invoke(`carRegister`, Payloads[1]))
 			cars := expectcc.PayloadIs(
 				cc.From(Authority).Invoke(`carList`),
-				&[]Car{}).([]Car)
+				&CarList{}).(*CarList)
 
-			Expect(len(cars)).To(Equal(2))
+			Expect(len(cars.Items)).To(Equal(2))
 		})
 	})
 })
@@ -1,6 +1,6 @@
 package cars
 
-var Payloads = []*Car{{
+var Payloads = []*CarPayload{{
 	Id:    `A777MP77`,
 	Title: `BMW`,
 	Owner: `victor-nosov`,
@@ -10,6 +10,7 @@ var (
 	StateMappings = m.StateMappings{}.
 		//  Create mapping for Allowance entity
 		// key `Allowance`,`{OwnerAddress}`,`{SpenderAddress}`,`{Path[0]}`..., `{Path[n]`
+		// no reference to owner Balance: allowance can be approved for zero balance, which has no state entry
 		Add(&Allowance{},
 			m.PKeySchema(&AllowanceId{}),
 			m.List(&Allowances{}), // Structure of result for List method
@@ -0,0 +1,28 @@
+package catalog
+
+import (
+	"github.com/golang/protobuf/ptypes/empty"
+
+	"github.com/s7techlab/cckit/router"
+	"github.com/s7techlab/cckit/state/mapping"
+	"github.com/s7techlab/cckit/state/schema"
+)
+
+type (
+	Service struct {
+		Catalog *schema.Catalog
+	}
+)
+
+var _ CatalogServiceChaincode = &Service{}
+
+// NewService creates catalog service, catalog is built once from state and event mappings
+func NewService(states mapping.StateMappings, events mapping.EventMappings) *Service {
+	return &Service{
+		Catalog: mapping.Catalog(states, events),
+	}
+}
+
+func (s *Service) GetCatalog(_ router.Context, _ *empty.Empty) (*schema.Catalog, error) {
+	return s.Catalog, nil
+}
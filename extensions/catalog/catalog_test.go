@@ -0,0 +1,90 @@
+package catalog_test
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/s7techlab/cckit/examples/fabcar"
+	"github.com/s7techlab/cckit/extensions/catalog"
+	"github.com/s7techlab/cckit/state"
+	"github.com/s7techlab/cckit/state/mapping"
+	"github.com/s7techlab/cckit/state/schema"
+	testcc "github.com/s7techlab/cckit/testing"
+)
+
+func TestCatalog(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Catalog suite")
+}
+
+var _ = Describe(`Catalog`, func() {
+
+	var (
+		svc     = catalog.NewService(fabcar.StateMappings, fabcar.EventMappings)
+		cc, ctx = testcc.NewTxHandler(`Catalog`)
+
+		stateDesc = func(c *schema.Catalog, name string) *schema.StateDescription {
+			for _, desc := range c.States {
+				if desc.Schema == name {
+					return desc
+				}
+			}
+			return nil
+		}
+	)
+
+	It(`Allow to get catalog of state and event mappings`, func() {
+		cc.Tx(func() {
+			c, err := svc.GetCatalog(ctx, &empty.Empty{})
+			Expect(err).NotTo(HaveOccurred())
+
+			car := stateDesc(c, `examples.fabcar.Car`)
+			Expect(car).NotTo(BeNil())
+			Expect(car.Namespace).To(Equal([]string{`Car`}))
+			Expect(car.PrimaryKey).To(Equal([]string{`Id`}))
+			Expect(car.List).To(Equal(`examples.fabcar.Cars`))
+			Expect(car.References).To(HaveLen(1))
+			Expect(car.References[0].To).To(Equal(`examples.fabcar.Maker`))
+
+			carId := stateDesc(c, `examples.fabcar.CarId`)
+			Expect(carId.KeyerFor).To(Equal(`examples.fabcar.Car`))
+
+			Expect(c.Events).To(HaveLen(9))
+			Expect(c.Events[0].Name).To(Equal(`CarCreated`))
+			Expect(c.Events[0].Schema).To(Equal(`examples.fabcar.CarCreated`))
+		})
+	})
+
+	It(`Allow to decode state entries and events with catalog without compiled-in types`, func() {
+		// catalog can be transferred as bytes
+		bb, err := proto.Marshal(svc.Catalog)
+		Expect(err).NotTo(HaveOccurred())
+		c := &schema.Catalog{}
+		Expect(proto.Unmarshal(bb, c)).NotTo(HaveOccurred())
+
+		resolver, err := mapping.NewCatalogResolver(c)
+		Expect(err).NotTo(HaveOccurred())
+
+		value, err := proto.Marshal(&fabcar.Car{Id: []string{`A`, `1`}, Make: `Toyota`})
+		Expect(err).NotTo(HaveOccurred())
+
+		desc, entry, err := resolver.ResolveState(state.Key{`Car`, `A`, `1`}, value)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(desc.Schema).To(Equal(`examples.fabcar.Car`))
+
+		msg := proto.MessageReflect(entry)
+		Expect(msg.Get(msg.Descriptor().Fields().ByName(`make`)).String()).To(Equal(`Toyota`))
+
+		payload, err := proto.Marshal(&fabcar.MakerCreated{Name: `Toyota`})
+		Expect(err).NotTo(HaveOccurred())
+
+		eventDesc, event, err := resolver.ResolveEvent(`MakerCreated`, payload)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(eventDesc.Schema).To(Equal(`examples.fabcar.MakerCreated`))
+		Expect(proto.MarshalTextString(event)).To(ContainSubstring(`Toyota`))
+	})
+})
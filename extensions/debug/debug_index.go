@@ -0,0 +1,70 @@
+package debug
+
+import (
+	"github.com/s7techlab/cckit/router"
+	"github.com/s7techlab/cckit/state/mapping"
+)
+
+type (
+	IndexService struct {
+		State    StateFn
+		Mappings mapping.StateMappings
+	}
+)
+
+var _ DebugIndexServiceChaincode = &IndexService{}
+
+func NewIndexService(mappings mapping.StateMappings) *IndexService {
+	return &IndexService{
+		State:    StateAsIs,
+		Mappings: mappings,
+	}
+}
+
+func (s *IndexService) CheckIndexes(ctx router.Context, req *IndexCheckRequest) (*IndexCheckResult, error) {
+	m, err := s.Mappings.GetByNamespace(req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := mapping.CheckIndexes(s.State(ctx), s.Mappings, m.Schema(), req.Limit, req.Bookmark)
+	if err != nil {
+		return nil, err
+	}
+
+	return indexCheckResult(res), nil
+}
+
+func (s *IndexService) RebuildIndexes(ctx router.Context, req *IndexCheckRequest) (*IndexCheckResult, error) {
+	m, err := s.Mappings.GetByNamespace(req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := mapping.RebuildIndexes(s.State(ctx), s.Mappings, m.Schema(), req.Limit, req.Bookmark)
+	if err != nil {
+		return nil, err
+	}
+
+	return indexCheckResult(res), nil
+}
+
+func indexCheckResult(res *mapping.IndexCheckResult) *IndexCheckResult {
+	out := &IndexCheckResult{
+		Checked:  res.Checked,
+		Bookmark: res.Bookmark,
+	}
+
+	for _, issue := range res.Issues {
+		out.Issues = append(out.Issues, &IndexIssue{
+			Type:     IndexIssueType(IndexIssueType_value[string(issue.Type)]),
+			Index:    issue.Index,
+			RefKey:   issue.RefKey,
+			PKey:     issue.PKey,
+			RefPKey:  issue.RefPKey,
+			Repaired: issue.Repaired,
+		})
+	}
+
+	return out
+}
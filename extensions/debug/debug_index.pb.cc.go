@@ -0,0 +1,127 @@
+// Code generated by protoc-gen-cc-gateway. DO NOT EDIT.
+// source: debug/debug_index.proto
+
+/*
+Package debug contains
+  *   chaincode methods names {service_name}Chaincode_{method_name}
+  *   chaincode interface definition {service_name}Chaincode
+  *   chaincode gateway definition {service_name}}Gateway
+  *   chaincode service to cckit router registration func
+*/
+package debug
+
+import (
+	context "context"
+	_ "embed"
+
+	cckit_gateway "github.com/s7techlab/cckit/gateway"
+	cckit_router "github.com/s7techlab/cckit/router"
+	cckit_defparam "github.com/s7techlab/cckit/router/param/defparam"
+	cckit_sdk "github.com/s7techlab/cckit/sdk"
+)
+
+// DebugIndexServiceChaincode method names
+const (
+
+	// DebugIndexServiceChaincodeMethodPrefix allows to use multiple services with same method names in one chaincode
+	DebugIndexServiceChaincodeMethodPrefix = "DebugIndexService."
+
+	DebugIndexServiceChaincode_CheckIndexes = DebugIndexServiceChaincodeMethodPrefix + "CheckIndexes"
+
+	DebugIndexServiceChaincode_RebuildIndexes = DebugIndexServiceChaincodeMethodPrefix + "RebuildIndexes"
+)
+
+// DebugIndexServiceChaincode chaincode methods interface
+type DebugIndexServiceChaincode interface {
+	CheckIndexes(cckit_router.Context, *IndexCheckRequest) (*IndexCheckResult, error)
+
+	RebuildIndexes(cckit_router.Context, *IndexCheckRequest) (*IndexCheckResult, error)
+}
+
+// RegisterDebugIndexServiceChaincode registers service methods as chaincode router handlers
+func RegisterDebugIndexServiceChaincode(r *cckit_router.Group, cc DebugIndexServiceChaincode) error {
+
+	r.Query(DebugIndexServiceChaincode_CheckIndexes,
+		func(ctx cckit_router.Context) (interface{}, error) {
+			return cc.CheckIndexes(ctx, ctx.Param().(*IndexCheckRequest))
+		},
+		cckit_defparam.Proto(&IndexCheckRequest{}))
+
+	r.Invoke(DebugIndexServiceChaincode_RebuildIndexes,
+		func(ctx cckit_router.Context) (interface{}, error) {
+			return cc.RebuildIndexes(ctx, ctx.Param().(*IndexCheckRequest))
+		},
+		cckit_defparam.Proto(&IndexCheckRequest{}))
+
+	return nil
+}
+
+//go:embed debug_index.swagger.json
+var DebugIndexServiceSwagger []byte
+
+// NewDebugIndexServiceGateway creates gateway to access chaincode method via chaincode service
+func NewDebugIndexServiceGateway(sdk cckit_sdk.SDK, channel, chaincode string, opts ...cckit_gateway.Opt) *DebugIndexServiceGateway {
+	return NewDebugIndexServiceGatewayFromInstance(
+		cckit_gateway.NewChaincodeInstanceService(
+			sdk,
+			&cckit_gateway.ChaincodeLocator{Channel: channel, Chaincode: chaincode},
+			opts...,
+		))
+}
+
+func NewDebugIndexServiceGatewayFromInstance(chaincodeInstance cckit_gateway.ChaincodeInstance) *DebugIndexServiceGateway {
+	return &DebugIndexServiceGateway{
+		ChaincodeInstance: chaincodeInstance,
+	}
+}
+
+// gateway implementation
+// gateway can be used as kind of SDK, GRPC or REST server ( via grpc-gateway or clay )
+type DebugIndexServiceGateway struct {
+	ChaincodeInstance cckit_gateway.ChaincodeInstance
+}
+
+func (c *DebugIndexServiceGateway) Invoker() cckit_gateway.ChaincodeInstanceInvoker {
+	return cckit_gateway.NewChaincodeInstanceServiceInvoker(c.ChaincodeInstance)
+}
+
+// ServiceDef returns service definition
+func (c *DebugIndexServiceGateway) ServiceDef() cckit_gateway.ServiceDef {
+	return cckit_gateway.NewServiceDef(
+		_DebugIndexService_serviceDesc.ServiceName,
+		DebugIndexServiceSwagger,
+		&_DebugIndexService_serviceDesc,
+		c,
+		RegisterDebugIndexServiceHandlerFromEndpoint,
+	)
+}
+
+func (c *DebugIndexServiceGateway) CheckIndexes(ctx context.Context, in *IndexCheckRequest) (*IndexCheckResult, error) {
+	var inMsg interface{} = in
+	if v, ok := inMsg.(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	if res, err := c.Invoker().Query(ctx, DebugIndexServiceChaincode_CheckIndexes, []interface{}{in}, &IndexCheckResult{}); err != nil {
+		return nil, err
+	} else {
+		return res.(*IndexCheckResult), nil
+	}
+}
+
+func (c *DebugIndexServiceGateway) RebuildIndexes(ctx context.Context, in *IndexCheckRequest) (*IndexCheckResult, error) {
+	var inMsg interface{} = in
+	if v, ok := inMsg.(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	if res, err := c.Invoker().Invoke(ctx, DebugIndexServiceChaincode_RebuildIndexes, []interface{}{in}, &IndexCheckResult{}); err != nil {
+		return nil, err
+	} else {
+		return res.(*IndexCheckResult), nil
+	}
+}
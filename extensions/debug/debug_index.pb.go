@@ -0,0 +1,584 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        (unknown)
+// source: debug/debug_index.proto
+
+package debug
+
+import (
+	context "context"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Index issue type
+type IndexIssueType int32
+
+const (
+	// entry has no key ref for index value
+	IndexIssueType_MISSING IndexIssueType = 0
+	// key ref refers to non-existent entry or entry with another index value
+	IndexIssueType_DANGLING IndexIssueType = 1
+	// two entries have same value of uniq index
+	IndexIssueType_CONFLICT IndexIssueType = 2
+)
+
+// Enum value maps for IndexIssueType.
+var (
+	IndexIssueType_name = map[int32]string{
+		0: "MISSING",
+		1: "DANGLING",
+		2: "CONFLICT",
+	}
+	IndexIssueType_value = map[string]int32{
+		"MISSING":  0,
+		"DANGLING": 1,
+		"CONFLICT": 2,
+	}
+)
+
+func (x IndexIssueType) Enum() *IndexIssueType {
+	p := new(IndexIssueType)
+	*p = x
+	return p
+}
+
+func (x IndexIssueType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IndexIssueType) Descriptor() protoreflect.EnumDescriptor {
+	return file_debug_debug_index_proto_enumTypes[0].Descriptor()
+}
+
+func (IndexIssueType) Type() protoreflect.EnumType {
+	return &file_debug_debug_index_proto_enumTypes[0]
+}
+
+func (x IndexIssueType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IndexIssueType.Descriptor instead.
+func (IndexIssueType) EnumDescriptor() ([]byte, []int) {
+	return file_debug_debug_index_proto_rawDescGZIP(), []int{0}
+}
+
+// Index check request
+type IndexCheckRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// namespace of mapped schema
+	Namespace []string `protobuf:"bytes,1,rep,name=namespace,proto3" json:"namespace,omitempty"`
+	// max number of entries and key refs to check in one batch, 0 - no limit
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// bookmark from previous batch result
+	Bookmark string `protobuf:"bytes,3,opt,name=bookmark,proto3" json:"bookmark,omitempty"`
+}
+
+func (x *IndexCheckRequest) Reset() {
+	*x = IndexCheckRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_debug_index_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IndexCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexCheckRequest) ProtoMessage() {}
+
+func (x *IndexCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_debug_index_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexCheckRequest.ProtoReflect.Descriptor instead.
+func (*IndexCheckRequest) Descriptor() ([]byte, []int) {
+	return file_debug_debug_index_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *IndexCheckRequest) GetNamespace() []string {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+func (x *IndexCheckRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *IndexCheckRequest) GetBookmark() string {
+	if x != nil {
+		return x.Bookmark
+	}
+	return ""
+}
+
+// Inconsistency between mapped entry and key refs
+type IndexIssue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type IndexIssueType `protobuf:"varint,1,opt,name=type,proto3,enum=extensions.debug.IndexIssueType" json:"type,omitempty"`
+	// index name
+	Index string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	// index value
+	RefKey []string `protobuf:"bytes,3,rep,name=ref_key,json=refKey,proto3" json:"ref_key,omitempty"`
+	// primary key of entry, key ref should refer to
+	PKey []string `protobuf:"bytes,4,rep,name=p_key,json=pKey,proto3" json:"p_key,omitempty"`
+	// primary key, key ref actually refers to
+	RefPKey []string `protobuf:"bytes,5,rep,name=ref_p_key,json=refPKey,proto3" json:"ref_p_key,omitempty"`
+	// issue was fixed
+	Repaired bool `protobuf:"varint,6,opt,name=repaired,proto3" json:"repaired,omitempty"`
+}
+
+func (x *IndexIssue) Reset() {
+	*x = IndexIssue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_debug_index_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IndexIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexIssue) ProtoMessage() {}
+
+func (x *IndexIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_debug_index_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexIssue.ProtoReflect.Descriptor instead.
+func (*IndexIssue) Descriptor() ([]byte, []int) {
+	return file_debug_debug_index_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IndexIssue) GetType() IndexIssueType {
+	if x != nil {
+		return x.Type
+	}
+	return IndexIssueType_MISSING
+}
+
+func (x *IndexIssue) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+func (x *IndexIssue) GetRefKey() []string {
+	if x != nil {
+		return x.RefKey
+	}
+	return nil
+}
+
+func (x *IndexIssue) GetPKey() []string {
+	if x != nil {
+		return x.PKey
+	}
+	return nil
+}
+
+func (x *IndexIssue) GetRefPKey() []string {
+	if x != nil {
+		return x.RefPKey
+	}
+	return nil
+}
+
+func (x *IndexIssue) GetRepaired() bool {
+	if x != nil {
+		return x.Repaired
+	}
+	return false
+}
+
+// Index check result for one batch
+type IndexCheckResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Issues []*IndexIssue `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+	// number of checked entries and key refs
+	Checked uint32 `protobuf:"varint,2,opt,name=checked,proto3" json:"checked,omitempty"`
+	// bookmark for next batch, empty if scan is completed
+	Bookmark string `protobuf:"bytes,3,opt,name=bookmark,proto3" json:"bookmark,omitempty"`
+}
+
+func (x *IndexCheckResult) Reset() {
+	*x = IndexCheckResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_debug_index_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IndexCheckResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexCheckResult) ProtoMessage() {}
+
+func (x *IndexCheckResult) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_debug_index_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexCheckResult.ProtoReflect.Descriptor instead.
+func (*IndexCheckResult) Descriptor() ([]byte, []int) {
+	return file_debug_debug_index_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *IndexCheckResult) GetIssues() []*IndexIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *IndexCheckResult) GetChecked() uint32 {
+	if x != nil {
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *IndexCheckResult) GetBookmark() string {
+	if x != nil {
+		return x.Bookmark
+	}
+	return ""
+}
+
+var File_debug_debug_index_proto protoreflect.FileDescriptor
+
+var file_debug_debug_index_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x1a, 0x1c, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x63, 0x0a, 0x11, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x22, 0xbe,
+	0x01, 0x0a, 0x0a, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x49, 0x73, 0x73, 0x75, 0x65, 0x12, 0x34, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x49, 0x73, 0x73, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x65, 0x66,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x66, 0x4b,
+	0x65, 0x79, 0x12, 0x13, 0x0a, 0x05, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x5f, 0x70,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x66, 0x50,
+	0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x22,
+	0x7e, 0x0a, 0x10, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x2a,
+	0x39, 0x0a, 0x0e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x49, 0x73, 0x73, 0x75, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0c,
+	0x0a, 0x08, 0x44, 0x41, 0x4e, 0x47, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08,
+	0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x10, 0x02, 0x32, 0x90, 0x02, 0x0a, 0x11, 0x44,
+	0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x7f, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73,
+	0x12, 0x23, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x20, 0x12, 0x1e, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x7d, 0x12, 0x7a, 0x0a, 0x0e, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x1f, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x19, 0x22, 0x14, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x2f, 0x72, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x3a, 0x01, 0x2a, 0x42, 0x2d, 0x5a,
+	0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x37, 0x74, 0x65,
+	0x63, 0x68, 0x6c, 0x61, 0x62, 0x2f, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2f, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_debug_debug_index_proto_rawDescOnce sync.Once
+	file_debug_debug_index_proto_rawDescData = file_debug_debug_index_proto_rawDesc
+)
+
+func file_debug_debug_index_proto_rawDescGZIP() []byte {
+	file_debug_debug_index_proto_rawDescOnce.Do(func() {
+		file_debug_debug_index_proto_rawDescData = protoimpl.X.CompressGZIP(file_debug_debug_index_proto_rawDescData)
+	})
+	return file_debug_debug_index_proto_rawDescData
+}
+
+var file_debug_debug_index_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_debug_debug_index_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_debug_debug_index_proto_goTypes = []interface{}{
+	(IndexIssueType)(0),       // 0: extensions.debug.IndexIssueType
+	(*IndexCheckRequest)(nil), // 1: extensions.debug.IndexCheckRequest
+	(*IndexIssue)(nil),        // 2: extensions.debug.IndexIssue
+	(*IndexCheckResult)(nil),  // 3: extensions.debug.IndexCheckResult
+}
+var file_debug_debug_index_proto_depIdxs = []int32{
+	0, // 0: extensions.debug.IndexIssue.type:type_name -> extensions.debug.IndexIssueType
+	2, // 1: extensions.debug.IndexCheckResult.issues:type_name -> extensions.debug.IndexIssue
+	1, // 2: extensions.debug.DebugIndexService.CheckIndexes:input_type -> extensions.debug.IndexCheckRequest
+	1, // 3: extensions.debug.DebugIndexService.RebuildIndexes:input_type -> extensions.debug.IndexCheckRequest
+	3, // 4: extensions.debug.DebugIndexService.CheckIndexes:output_type -> extensions.debug.IndexCheckResult
+	3, // 5: extensions.debug.DebugIndexService.RebuildIndexes:output_type -> extensions.debug.IndexCheckResult
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_debug_debug_index_proto_init() }
+func file_debug_debug_index_proto_init() {
+	if File_debug_debug_index_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_debug_debug_index_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndexCheckRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_debug_index_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndexIssue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_debug_index_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndexCheckResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_debug_debug_index_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_debug_debug_index_proto_goTypes,
+		DependencyIndexes: file_debug_debug_index_proto_depIdxs,
+		EnumInfos:         file_debug_debug_index_proto_enumTypes,
+		MessageInfos:      file_debug_debug_index_proto_msgTypes,
+	}.Build()
+	File_debug_debug_index_proto = out.File
+	file_debug_debug_index_proto_rawDesc = nil
+	file_debug_debug_index_proto_goTypes = nil
+	file_debug_debug_index_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// DebugIndexServiceClient is the client API for DebugIndexService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DebugIndexServiceClient interface {
+	// Check indexes of mapped schema, reports missing, dangling and conflicting key refs
+	CheckIndexes(ctx context.Context, in *IndexCheckRequest, opts ...grpc.CallOption) (*IndexCheckResult, error)
+	// Rebuild indexes of mapped schema, inserts missing and deletes dangling key refs
+	RebuildIndexes(ctx context.Context, in *IndexCheckRequest, opts ...grpc.CallOption) (*IndexCheckResult, error)
+}
+
+type debugIndexServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDebugIndexServiceClient(cc grpc.ClientConnInterface) DebugIndexServiceClient {
+	return &debugIndexServiceClient{cc}
+}
+
+func (c *debugIndexServiceClient) CheckIndexes(ctx context.Context, in *IndexCheckRequest, opts ...grpc.CallOption) (*IndexCheckResult, error) {
+	out := new(IndexCheckResult)
+	err := c.cc.Invoke(ctx, "/extensions.debug.DebugIndexService/CheckIndexes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugIndexServiceClient) RebuildIndexes(ctx context.Context, in *IndexCheckRequest, opts ...grpc.CallOption) (*IndexCheckResult, error) {
+	out := new(IndexCheckResult)
+	err := c.cc.Invoke(ctx, "/extensions.debug.DebugIndexService/RebuildIndexes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DebugIndexServiceServer is the server API for DebugIndexService service.
+type DebugIndexServiceServer interface {
+	// Check indexes of mapped schema, reports missing, dangling and conflicting key refs
+	CheckIndexes(context.Context, *IndexCheckRequest) (*IndexCheckResult, error)
+	// Rebuild indexes of mapped schema, inserts missing and deletes dangling key refs
+	RebuildIndexes(context.Context, *IndexCheckRequest) (*IndexCheckResult, error)
+}
+
+// UnimplementedDebugIndexServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedDebugIndexServiceServer struct {
+}
+
+func (*UnimplementedDebugIndexServiceServer) CheckIndexes(context.Context, *IndexCheckRequest) (*IndexCheckResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckIndexes not implemented")
+}
+func (*UnimplementedDebugIndexServiceServer) RebuildIndexes(context.Context, *IndexCheckRequest) (*IndexCheckResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildIndexes not implemented")
+}
+
+func RegisterDebugIndexServiceServer(s *grpc.Server, srv DebugIndexServiceServer) {
+	s.RegisterService(&_DebugIndexService_serviceDesc, srv)
+}
+
+func _DebugIndexService_CheckIndexes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IndexCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugIndexServiceServer).CheckIndexes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/extensions.debug.DebugIndexService/CheckIndexes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugIndexServiceServer).CheckIndexes(ctx, req.(*IndexCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebugIndexService_RebuildIndexes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IndexCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugIndexServiceServer).RebuildIndexes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/extensions.debug.DebugIndexService/RebuildIndexes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugIndexServiceServer).RebuildIndexes(ctx, req.(*IndexCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DebugIndexService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "extensions.debug.DebugIndexService",
+	HandlerType: (*DebugIndexServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CheckIndexes",
+			Handler:    _DebugIndexService_CheckIndexes_Handler,
+		},
+		{
+			MethodName: "RebuildIndexes",
+			Handler:    _DebugIndexService_RebuildIndexes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "debug/debug_index.proto",
+}
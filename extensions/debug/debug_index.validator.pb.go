@@ -0,0 +1,34 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: debug/debug_index.proto
+
+package debug
+
+import (
+	fmt "fmt"
+	math "math"
+	proto "github.com/golang/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	github_com_mwitkow_go_proto_validators "github.com/mwitkow/go-proto-validators"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+func (this *IndexCheckRequest) Validate() error {
+	return nil
+}
+func (this *IndexIssue) Validate() error {
+	return nil
+}
+func (this *IndexCheckResult) Validate() error {
+	for _, item := range this.Issues {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Issues", err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package debug_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/s7techlab/cckit/extensions/debug"
+	"github.com/s7techlab/cckit/router"
+	"github.com/s7techlab/cckit/state"
+	"github.com/s7techlab/cckit/state/mapping"
+	"github.com/s7techlab/cckit/state/mapping/testdata"
+	"github.com/s7techlab/cckit/state/mapping/testdata/schema"
+	testcc "github.com/s7techlab/cckit/testing"
+)
+
+var _ = Describe(`Index`, func() {
+
+	var (
+		mappings = testdata.EntityWithIndexesStateMapping
+		dbg      = debug.NewIndexService(mappings)
+
+		cc, ctx = testcc.NewTxHandler(`Debug index`)
+
+		checkAll = func(svc *debug.IndexService, limit uint32) (issues []*debug.IndexIssue, batches int) {
+			bookmark := ``
+			for {
+				cc.From(Owner).Tx(func() {
+					res, err := svc.CheckIndexes(ctx, &debug.IndexCheckRequest{
+						Namespace: []string{`EntityWithIndexes`},
+						Limit:     limit,
+						Bookmark:  bookmark,
+					})
+					Expect(err).NotTo(HaveOccurred())
+					issues = append(issues, res.Issues...)
+					bookmark = res.Bookmark
+				})
+				batches++
+
+				if bookmark == `` {
+					return issues, batches
+				}
+			}
+		}
+	)
+
+	It("Allow to check consistent indexes", func() {
+		for _, create := range testdata.CreateEntityWithIndexes {
+			cc.From(Owner).Tx(func() {
+				Expect(mapping.WrapState(ctx.State(), mappings).Insert(&schema.EntityWithIndexes{
+					Id:                  create.Id,
+					ExternalId:          create.ExternalId,
+					OptionalExternalIds: create.OptionalExternalIds,
+					Value:               create.Value,
+				})).NotTo(HaveOccurred())
+			})
+		}
+
+		cc.From(Owner).Tx(func() {
+			res, err := dbg.CheckIndexes(ctx, &debug.IndexCheckRequest{Namespace: []string{`EntityWithIndexes`}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Issues).To(HaveLen(0))
+			// 2 entries, 4 key refs
+			Expect(res.Checked).To(Equal(uint32(6)))
+			Expect(res.Bookmark).To(BeEmpty())
+		})
+	})
+
+	It("Allow to find missing and dangling key refs in batches", func() {
+		cc.From(Owner).Tx(func() {
+			// key ref for existing entry removed
+			refKey, err := mapping.NewKeyRefIDInstance(
+				&schema.EntityWithIndexes{}, `ExternalId`, state.Key{`aaa_aaa`}).Key()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ctx.State().Delete(refKey)).NotTo(HaveOccurred())
+
+			// key ref for non-existent entry
+			Expect(ctx.State().Put(mapping.NewKeyRefInstance(
+				&schema.EntityWithIndexes{}, `ExternalId`, state.Key{`zzz_zzz`},
+				state.Key{`EntityWithIndexes`, `zzz`}))).NotTo(HaveOccurred())
+		})
+
+		issues, batches := checkAll(dbg, 2)
+		Expect(batches).To(Equal(3))
+		Expect(issues).To(HaveLen(2))
+
+		Expect(issues[0].Type).To(Equal(debug.IndexIssueType_MISSING))
+		Expect(issues[0].Index).To(Equal(`ExternalId`))
+		Expect(issues[0].RefKey).To(Equal([]string{`aaa_aaa`}))
+		Expect(issues[0].PKey).To(Equal([]string{`EntityWithIndexes`, `aaa`}))
+
+		Expect(issues[1].Type).To(Equal(debug.IndexIssueType_DANGLING))
+		Expect(issues[1].RefKey).To(Equal([]string{`zzz_zzz`}))
+		Expect(issues[1].RefPKey).To(Equal([]string{`EntityWithIndexes`, `zzz`}))
+	})
+
+	It("Allow to check indexes in batches with state without paginated keys support", func() {
+		// state.KeysPaginator is not implemented by wrapper
+		svc := &debug.IndexService{
+			State: func(ctx router.Context) state.State {
+				return struct{ state.State }{ctx.State()}
+			},
+			Mappings: mappings,
+		}
+
+		issues, batches := checkAll(svc, 2)
+		Expect(batches).To(Equal(3))
+		Expect(issues).To(HaveLen(2))
+	})
+
+	It("Allow to rebuild indexes", func() {
+		cc.From(Owner).Tx(func() {
+			res, err := dbg.RebuildIndexes(ctx, &debug.IndexCheckRequest{Namespace: []string{`EntityWithIndexes`}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Issues).To(HaveLen(2))
+			Expect(res.Issues[0].Repaired).To(BeTrue())
+			Expect(res.Issues[1].Repaired).To(BeTrue())
+		})
+
+		issues, _ := checkAll(dbg, 0)
+		Expect(issues).To(HaveLen(0))
+	})
+})
@@ -0,0 +1,295 @@
+package fsm
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/s7techlab/cckit/router"
+	"github.com/s7techlab/cckit/state/mapping"
+)
+
+var (
+	// ErrStateFieldNotFound occurs when schema has no enum field with state
+	ErrStateFieldNotFound = errors.New(`state field not found`)
+
+	// ErrTransitionNotAllowed occurs when transition from current state to requested state is not declared
+	ErrTransitionNotAllowed = errors.New(`transition not allowed`)
+
+	// ErrTransitionDenied occurs when transition guard returns error
+	ErrTransitionDenied = errors.New(`transition denied`)
+
+	// ErrStateMachineNotFound occurs when state machine for schema is not defined
+	ErrStateMachineNotFound = errors.New(`state machine not found`)
+)
+
+type (
+	// Machine finite state machine for enum field of mapped schema
+	Machine struct {
+		schema      proto.Message
+		field       protoreflect.FieldDescriptor
+		states      mapping.StateMappings
+		events      mapping.EventMappings
+		transitions []*Transition
+	}
+
+	// Transition allowed transition from one of states to state
+	Transition struct {
+		Name   string
+		From   []protoreflect.EnumNumber
+		To     protoreflect.EnumNumber
+		Guards []Guard
+		// Event name of Transitioned event, by default - {SchemaName}Transitioned
+		Event string
+	}
+
+	Opt func(*Machine)
+
+	TransitionOpt func(*Transition)
+)
+
+// New creates state machine for enum field of schema, schema should be mapped in state mappings
+func New(states mapping.StateMappings, schema proto.Message, field string, opts ...Opt) (*Machine, error) {
+	fd := proto.MessageReflect(schema).Descriptor().Fields().ByName(protoreflect.Name(field))
+	if fd == nil || fd.Kind() != protoreflect.EnumKind {
+		return nil, fmt.Errorf(`%w: %s.%s`, ErrStateFieldNotFound, schemaName(schema), field)
+	}
+
+	if _, err := states.Get(schema); err != nil {
+		return nil, err
+	}
+
+	m := &Machine{
+		schema: schema,
+		field:  fd,
+		states: states,
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	if m.events != nil {
+		for _, t := range m.transitions {
+			// all transition events have same payload type, so mappings are stored by event name
+			m.events.AddNamed(&Transitioned{}, t.Event)
+		}
+	}
+
+	return m, nil
+}
+
+// WithEventMappings registers Transitioned events of all transitions in event mappings
+func WithEventMappings(events mapping.EventMappings) Opt {
+	return func(m *Machine) {
+		m.events = events
+	}
+}
+
+// From returns list of states, transition is allowed from
+func From(states ...protoreflect.Enum) []protoreflect.Enum {
+	return states
+}
+
+// Allow declares transition from one of states to state
+func Allow(name string, from []protoreflect.Enum, to protoreflect.Enum, opts ...TransitionOpt) Opt {
+	return func(m *Machine) {
+		t := &Transition{
+			Name:  name,
+			To:    to.Number(),
+			Event: TransitionedEventName(m.schema),
+		}
+
+		for _, f := range from {
+			t.From = append(t.From, f.Number())
+		}
+
+		for _, opt := range opts {
+			opt(t)
+		}
+
+		m.transitions = append(m.transitions, t)
+	}
+}
+
+// WithGuard adds guard, checked before transition
+func WithGuard(guards ...Guard) TransitionOpt {
+	return func(t *Transition) {
+		t.Guards = append(t.Guards, guards...)
+	}
+}
+
+// WithEvent sets name of event, emitted on transition
+func WithEvent(name string) TransitionOpt {
+	return func(t *Transition) {
+		t.Event = name
+	}
+}
+
+// TransitionedEventName returns default name of transition event, for example `CommercialPaperTransitioned`
+func TransitionedEventName(schema proto.Message) string {
+	return string(proto.MessageReflect(schema).Descriptor().Name()) + `Transitioned`
+}
+
+// Transition moves stored entity to state: checks transition is allowed from current stored state and guards,
+// puts entity with new state through mapped state and emits Transitioned event.
+// Entity should contain primary key fields and can contain another changed fields: fields, set in entity,
+// are copied to stored entity, other stored fields are kept. Guards check stored entity with changed fields.
+// Field can't be cleared this way, as it is not set in entity, use TransitionWithMask to clear fields
+func (m *Machine) Transition(ctx router.Context, entity proto.Message, to protoreflect.Enum) (proto.Message, error) {
+	return m.transit(ctx, entity, to, func(stored proto.Message) (proto.Message, error) {
+		return m.merge(stored, entity), nil
+	})
+}
+
+// TransitionWithMask moves stored entity to state as Transition, but only fields listed in mask
+// are copied from entity to stored entity, field is cleared if it is not set in entity
+func (m *Machine) TransitionWithMask(
+	ctx router.Context, entity proto.Message, mask *fieldmaskpb.FieldMask, to protoreflect.Enum) (proto.Message, error) {
+	return m.transit(ctx, entity, to, func(stored proto.Message) (proto.Message, error) {
+		changed := proto.Clone(stored)
+		if err := mapping.ApplyFieldMask(changed, entity, mask); err != nil {
+			return nil, err
+		}
+		// state field is changed only by transition
+		proto.MessageReflect(changed).Set(m.field, proto.MessageReflect(stored).Get(m.field))
+		return changed, nil
+	})
+}
+
+func (m *Machine) transit(ctx router.Context, entity proto.Message, to protoreflect.Enum,
+	change func(stored proto.Message) (proto.Message, error)) (proto.Message, error) {
+	st := mapping.WrapState(ctx.State(), m.states)
+
+	stored, err := st.Get(entity, proto.MessageReflect(entity).New().Interface())
+	if err != nil {
+		return nil, err
+	}
+
+	from := proto.MessageReflect(stored.(proto.Message)).Get(m.field).Enum()
+
+	t := m.transition(from, to.Number())
+	if t == nil {
+		return nil, fmt.Errorf(`%w: %s: %s -> %s`,
+			ErrTransitionNotAllowed, schemaName(m.schema), m.stateName(from), m.stateName(to.Number()))
+	}
+
+	changed, err := change(stored.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, guard := range t.Guards {
+		if err = guard(ctx, changed); err != nil {
+			return nil, fmt.Errorf(`%w: %s: %s`, ErrTransitionDenied, t.Name, err)
+		}
+	}
+
+	proto.MessageReflect(changed).Set(m.field, protoreflect.ValueOfEnum(t.To))
+
+	if err = st.Put(changed); err != nil {
+		return nil, err
+	}
+
+	mapper, err := m.states.Get(changed)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := mapper.PrimaryKey(changed)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = ctx.Event().Set(t.Event, &Transitioned{
+		Schema:     schemaName(m.schema),
+		Key:        key,
+		Transition: t.Name,
+		From:       m.stateName(from),
+		To:         m.stateName(t.To),
+	}); err != nil {
+		return nil, fmt.Errorf(`transition event: %w`, err)
+	}
+
+	return changed, nil
+}
+
+// merge returns copy of stored entity with fields, set in entity. State field is taken from stored entity
+func (m *Machine) merge(stored, entity proto.Message) proto.Message {
+	merged := proto.Clone(stored)
+	mergedReflect := proto.MessageReflect(merged)
+
+	proto.MessageReflect(entity).Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Number() != m.field.Number() {
+			mergedReflect.Set(fd, v)
+		}
+		return true
+	})
+
+	return merged
+}
+
+// Graph returns states and transitions of state machine
+func (m *Machine) Graph() *StateGraph {
+	graph := &StateGraph{
+		Schema: schemaName(m.schema),
+		Field:  string(m.field.Name()),
+	}
+
+	values := m.field.Enum().Values()
+	for i := 0; i < values.Len(); i++ {
+		graph.States = append(graph.States, string(values.Get(i).Name()))
+	}
+
+	dot := &strings.Builder{}
+	fmt.Fprintf(dot, "digraph %q {\n", graph.Schema)
+	for _, t := range m.transitions {
+		desc := &TransitionDescription{
+			Name:  t.Name,
+			To:    m.stateName(t.To),
+			Event: t.Event,
+		}
+
+		for _, from := range t.From {
+			desc.From = append(desc.From, m.stateName(from))
+			fmt.Fprintf(dot, "  %q -> %q [label=%q];\n", m.stateName(from), desc.To, t.Name)
+		}
+
+		graph.Transitions = append(graph.Transitions, desc)
+	}
+	dot.WriteString("}\n")
+	graph.Dot = dot.String()
+
+	return graph
+}
+
+func (m *Machine) transition(from, to protoreflect.EnumNumber) *Transition {
+	for _, t := range m.transitions {
+		if t.To != to {
+			continue
+		}
+
+		for _, f := range t.From {
+			if f == from {
+				return t
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *Machine) stateName(n protoreflect.EnumNumber) string {
+	if v := m.field.Enum().Values().ByNumber(n); v != nil {
+		return string(v.Name())
+	}
+	return fmt.Sprintf(`%d`, n)
+}
+
+func schemaName(schema proto.Message) string {
+	return string(proto.MessageReflect(schema).Descriptor().FullName())
+}
@@ -0,0 +1,128 @@
+// Code generated by protoc-gen-cc-gateway. DO NOT EDIT.
+// source: fsm/fsm.proto
+
+/*
+Package fsm contains
+  *   chaincode methods names {service_name}Chaincode_{method_name}
+  *   chaincode interface definition {service_name}Chaincode
+  *   chaincode gateway definition {service_name}}Gateway
+  *   chaincode service to cckit router registration func
+*/
+package fsm
+
+import (
+	context "context"
+	_ "embed"
+
+	cckit_gateway "github.com/s7techlab/cckit/gateway"
+	cckit_router "github.com/s7techlab/cckit/router"
+	cckit_defparam "github.com/s7techlab/cckit/router/param/defparam"
+	cckit_sdk "github.com/s7techlab/cckit/sdk"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// FSMServiceChaincode method names
+const (
+
+	// FSMServiceChaincodeMethodPrefix allows to use multiple services with same method names in one chaincode
+	FSMServiceChaincodeMethodPrefix = "FSMService."
+
+	FSMServiceChaincode_ListGraphs = FSMServiceChaincodeMethodPrefix + "ListGraphs"
+
+	FSMServiceChaincode_GetGraph = FSMServiceChaincodeMethodPrefix + "GetGraph"
+)
+
+// FSMServiceChaincode chaincode methods interface
+type FSMServiceChaincode interface {
+	ListGraphs(cckit_router.Context, *emptypb.Empty) (*StateGraphs, error)
+
+	GetGraph(cckit_router.Context, *StateGraphRequest) (*StateGraph, error)
+}
+
+// RegisterFSMServiceChaincode registers service methods as chaincode router handlers
+func RegisterFSMServiceChaincode(r *cckit_router.Group, cc FSMServiceChaincode) error {
+
+	r.Query(FSMServiceChaincode_ListGraphs,
+		func(ctx cckit_router.Context) (interface{}, error) {
+			return cc.ListGraphs(ctx, ctx.Param().(*emptypb.Empty))
+		},
+		cckit_defparam.Proto(&emptypb.Empty{}))
+
+	r.Query(FSMServiceChaincode_GetGraph,
+		func(ctx cckit_router.Context) (interface{}, error) {
+			return cc.GetGraph(ctx, ctx.Param().(*StateGraphRequest))
+		},
+		cckit_defparam.Proto(&StateGraphRequest{}))
+
+	return nil
+}
+
+//go:embed fsm.swagger.json
+var FSMServiceSwagger []byte
+
+// NewFSMServiceGateway creates gateway to access chaincode method via chaincode service
+func NewFSMServiceGateway(sdk cckit_sdk.SDK, channel, chaincode string, opts ...cckit_gateway.Opt) *FSMServiceGateway {
+	return NewFSMServiceGatewayFromInstance(
+		cckit_gateway.NewChaincodeInstanceService(
+			sdk,
+			&cckit_gateway.ChaincodeLocator{Channel: channel, Chaincode: chaincode},
+			opts...,
+		))
+}
+
+func NewFSMServiceGatewayFromInstance(chaincodeInstance cckit_gateway.ChaincodeInstance) *FSMServiceGateway {
+	return &FSMServiceGateway{
+		ChaincodeInstance: chaincodeInstance,
+	}
+}
+
+// gateway implementation
+// gateway can be used as kind of SDK, GRPC or REST server ( via grpc-gateway or clay )
+type FSMServiceGateway struct {
+	ChaincodeInstance cckit_gateway.ChaincodeInstance
+}
+
+func (c *FSMServiceGateway) Invoker() cckit_gateway.ChaincodeInstanceInvoker {
+	return cckit_gateway.NewChaincodeInstanceServiceInvoker(c.ChaincodeInstance)
+}
+
+// ServiceDef returns service definition
+func (c *FSMServiceGateway) ServiceDef() cckit_gateway.ServiceDef {
+	return cckit_gateway.NewServiceDef(
+		_FSMService_serviceDesc.ServiceName,
+		FSMServiceSwagger,
+		&_FSMService_serviceDesc,
+		c,
+		RegisterFSMServiceHandlerFromEndpoint,
+	)
+}
+
+func (c *FSMServiceGateway) ListGraphs(ctx context.Context, in *emptypb.Empty) (*StateGraphs, error) {
+	var inMsg interface{} = in
+	if v, ok := inMsg.(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	if res, err := c.Invoker().Query(ctx, FSMServiceChaincode_ListGraphs, []interface{}{in}, &StateGraphs{}); err != nil {
+		return nil, err
+	} else {
+		return res.(*StateGraphs), nil
+	}
+}
+
+func (c *FSMServiceGateway) GetGraph(ctx context.Context, in *StateGraphRequest) (*StateGraph, error) {
+	var inMsg interface{} = in
+	if v, ok := inMsg.(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	if res, err := c.Invoker().Query(ctx, FSMServiceChaincode_GetGraph, []interface{}{in}, &StateGraph{}); err != nil {
+		return nil, err
+	} else {
+		return res.(*StateGraph), nil
+	}
+}
@@ -0,0 +1,670 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        (unknown)
+// source: fsm/fsm.proto
+
+package fsm
+
+import (
+	context "context"
+	_ "github.com/mwitkow/go-proto-validators"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// StateGraphRequest request of state graph
+type StateGraphRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// full name of proto message, for example `examples.cpaper_asservice.CommercialPaper`
+	Schema string `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+}
+
+func (x *StateGraphRequest) Reset() {
+	*x = StateGraphRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fsm_fsm_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateGraphRequest) ProtoMessage() {}
+
+func (x *StateGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fsm_fsm_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateGraphRequest.ProtoReflect.Descriptor instead.
+func (*StateGraphRequest) Descriptor() ([]byte, []int) {
+	return file_fsm_fsm_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StateGraphRequest) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+// StateGraph states and transitions of state machine
+type StateGraph struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// full name of proto message with state field
+	Schema string `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	// name of enum state field
+	Field string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	// names of enum values
+	States      []string                 `protobuf:"bytes,3,rep,name=states,proto3" json:"states,omitempty"`
+	Transitions []*TransitionDescription `protobuf:"bytes,4,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	// graph in Graphviz DOT format
+	Dot string `protobuf:"bytes,5,opt,name=dot,proto3" json:"dot,omitempty"`
+}
+
+func (x *StateGraph) Reset() {
+	*x = StateGraph{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fsm_fsm_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateGraph) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateGraph) ProtoMessage() {}
+
+func (x *StateGraph) ProtoReflect() protoreflect.Message {
+	mi := &file_fsm_fsm_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateGraph.ProtoReflect.Descriptor instead.
+func (*StateGraph) Descriptor() ([]byte, []int) {
+	return file_fsm_fsm_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StateGraph) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *StateGraph) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *StateGraph) GetStates() []string {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *StateGraph) GetTransitions() []*TransitionDescription {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+func (x *StateGraph) GetDot() string {
+	if x != nil {
+		return x.Dot
+	}
+	return ""
+}
+
+// StateGraphs list of state graphs
+type StateGraphs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*StateGraph `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *StateGraphs) Reset() {
+	*x = StateGraphs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fsm_fsm_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateGraphs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateGraphs) ProtoMessage() {}
+
+func (x *StateGraphs) ProtoReflect() protoreflect.Message {
+	mi := &file_fsm_fsm_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateGraphs.ProtoReflect.Descriptor instead.
+func (*StateGraphs) Descriptor() ([]byte, []int) {
+	return file_fsm_fsm_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StateGraphs) GetItems() []*StateGraph {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// TransitionDescription allowed transition
+type TransitionDescription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// transition name
+	Name string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	From []string `protobuf:"bytes,2,rep,name=from,proto3" json:"from,omitempty"`
+	To   string   `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// name of event, emitted on transition
+	Event string `protobuf:"bytes,4,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *TransitionDescription) Reset() {
+	*x = TransitionDescription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fsm_fsm_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransitionDescription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitionDescription) ProtoMessage() {}
+
+func (x *TransitionDescription) ProtoReflect() protoreflect.Message {
+	mi := &file_fsm_fsm_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitionDescription.ProtoReflect.Descriptor instead.
+func (*TransitionDescription) Descriptor() ([]byte, []int) {
+	return file_fsm_fsm_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TransitionDescription) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TransitionDescription) GetFrom() []string {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *TransitionDescription) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *TransitionDescription) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+// Transitioned event emitted on transition of entity state
+type Transitioned struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// full name of proto message with state field
+	Schema string `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	// primary key of entity
+	Key []string `protobuf:"bytes,2,rep,name=key,proto3" json:"key,omitempty"`
+	// transition name
+	Transition string `protobuf:"bytes,3,opt,name=transition,proto3" json:"transition,omitempty"`
+	From       string `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To         string `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *Transitioned) Reset() {
+	*x = Transitioned{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fsm_fsm_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Transitioned) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transitioned) ProtoMessage() {}
+
+func (x *Transitioned) ProtoReflect() protoreflect.Message {
+	mi := &file_fsm_fsm_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transitioned.ProtoReflect.Descriptor instead.
+func (*Transitioned) Descriptor() ([]byte, []int) {
+	return file_fsm_fsm_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Transitioned) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *Transitioned) GetKey() []string {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *Transitioned) GetTransition() string {
+	if x != nil {
+		return x.Transition
+	}
+	return ""
+}
+
+func (x *Transitioned) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Transitioned) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+var File_fsm_fsm_proto protoreflect.FileDescriptor
+
+var file_fsm_fsm_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x66, 0x73, 0x6d, 0x2f, 0x66, 0x73, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x66, 0x73, 0x6d, 0x1a,
+	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2b, 0x6d, 0x77, 0x69, 0x74,
+	0x6b, 0x6f, 0x77, 0x2f, 0x67, 0x6f, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2d, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x33, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x06,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf,
+	0x1f, 0x02, 0x58, 0x01, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0xad, 0x01, 0x0a,
+	0x0a, 0x53, 0x74, 0x61, 0x74, 0x65, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x73, 0x12, 0x47, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x66, 0x73, 0x6d, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x6f,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x6f, 0x74, 0x22, 0x3f, 0x0a, 0x0b,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x47, 0x72, 0x61, 0x70, 0x68, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x66, 0x73, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x65, 0x0a,
+	0x15, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e,
+	0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x22, 0x7c, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1e,
+	0x0a, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x74, 0x6f, 0x32, 0xbf, 0x01, 0x0a, 0x0a, 0x46, 0x53, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x4f, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x73, 0x12,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x66, 0x73, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x47, 0x72,
+	0x61, 0x70, 0x68, 0x73, 0x22, 0x0c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x06, 0x12, 0x04, 0x2f, 0x66,
+	0x73, 0x6d, 0x12, 0x60, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x21,
+	0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x66, 0x73, 0x6d, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x66,
+	0x73, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x47, 0x72, 0x61, 0x70, 0x68, 0x22, 0x15, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x66, 0x73, 0x6d, 0x2f, 0x7b, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x7d, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x73, 0x37, 0x74, 0x65, 0x63, 0x68, 0x6c, 0x61, 0x62, 0x2f, 0x63, 0x63, 0x6b,
+	0x69, 0x74, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x66, 0x73,
+	0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_fsm_fsm_proto_rawDescOnce sync.Once
+	file_fsm_fsm_proto_rawDescData = file_fsm_fsm_proto_rawDesc
+)
+
+func file_fsm_fsm_proto_rawDescGZIP() []byte {
+	file_fsm_fsm_proto_rawDescOnce.Do(func() {
+		file_fsm_fsm_proto_rawDescData = protoimpl.X.CompressGZIP(file_fsm_fsm_proto_rawDescData)
+	})
+	return file_fsm_fsm_proto_rawDescData
+}
+
+var file_fsm_fsm_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_fsm_fsm_proto_goTypes = []interface{}{
+	(*StateGraphRequest)(nil),     // 0: extensions.fsm.StateGraphRequest
+	(*StateGraph)(nil),            // 1: extensions.fsm.StateGraph
+	(*StateGraphs)(nil),           // 2: extensions.fsm.StateGraphs
+	(*TransitionDescription)(nil), // 3: extensions.fsm.TransitionDescription
+	(*Transitioned)(nil),          // 4: extensions.fsm.Transitioned
+	(*emptypb.Empty)(nil),         // 5: google.protobuf.Empty
+}
+var file_fsm_fsm_proto_depIdxs = []int32{
+	3, // 0: extensions.fsm.StateGraph.transitions:type_name -> extensions.fsm.TransitionDescription
+	1, // 1: extensions.fsm.StateGraphs.items:type_name -> extensions.fsm.StateGraph
+	5, // 2: extensions.fsm.FSMService.ListGraphs:input_type -> google.protobuf.Empty
+	0, // 3: extensions.fsm.FSMService.GetGraph:input_type -> extensions.fsm.StateGraphRequest
+	2, // 4: extensions.fsm.FSMService.ListGraphs:output_type -> extensions.fsm.StateGraphs
+	1, // 5: extensions.fsm.FSMService.GetGraph:output_type -> extensions.fsm.StateGraph
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_fsm_fsm_proto_init() }
+func file_fsm_fsm_proto_init() {
+	if File_fsm_fsm_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_fsm_fsm_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateGraphRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fsm_fsm_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateGraph); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fsm_fsm_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateGraphs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fsm_fsm_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitionDescription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fsm_fsm_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Transitioned); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_fsm_fsm_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_fsm_fsm_proto_goTypes,
+		DependencyIndexes: file_fsm_fsm_proto_depIdxs,
+		MessageInfos:      file_fsm_fsm_proto_msgTypes,
+	}.Build()
+	File_fsm_fsm_proto = out.File
+	file_fsm_fsm_proto_rawDesc = nil
+	file_fsm_fsm_proto_goTypes = nil
+	file_fsm_fsm_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// FSMServiceClient is the client API for FSMService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type FSMServiceClient interface {
+	// List state graphs of all state machines
+	ListGraphs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*StateGraphs, error)
+	// Get state graph of state machine for schema
+	GetGraph(ctx context.Context, in *StateGraphRequest, opts ...grpc.CallOption) (*StateGraph, error)
+}
+
+type fSMServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFSMServiceClient(cc grpc.ClientConnInterface) FSMServiceClient {
+	return &fSMServiceClient{cc}
+}
+
+func (c *fSMServiceClient) ListGraphs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*StateGraphs, error) {
+	out := new(StateGraphs)
+	err := c.cc.Invoke(ctx, "/extensions.fsm.FSMService/ListGraphs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fSMServiceClient) GetGraph(ctx context.Context, in *StateGraphRequest, opts ...grpc.CallOption) (*StateGraph, error) {
+	out := new(StateGraph)
+	err := c.cc.Invoke(ctx, "/extensions.fsm.FSMService/GetGraph", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FSMServiceServer is the server API for FSMService service.
+type FSMServiceServer interface {
+	// List state graphs of all state machines
+	ListGraphs(context.Context, *emptypb.Empty) (*StateGraphs, error)
+	// Get state graph of state machine for schema
+	GetGraph(context.Context, *StateGraphRequest) (*StateGraph, error)
+}
+
+// UnimplementedFSMServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedFSMServiceServer struct {
+}
+
+func (*UnimplementedFSMServiceServer) ListGraphs(context.Context, *emptypb.Empty) (*StateGraphs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGraphs not implemented")
+}
+func (*UnimplementedFSMServiceServer) GetGraph(context.Context, *StateGraphRequest) (*StateGraph, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGraph not implemented")
+}
+
+func RegisterFSMServiceServer(s *grpc.Server, srv FSMServiceServer) {
+	s.RegisterService(&_FSMService_serviceDesc, srv)
+}
+
+func _FSMService_ListGraphs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FSMServiceServer).ListGraphs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/extensions.fsm.FSMService/ListGraphs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FSMServiceServer).ListGraphs(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FSMService_GetGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StateGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FSMServiceServer).GetGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/extensions.fsm.FSMService/GetGraph",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FSMServiceServer).GetGraph(ctx, req.(*StateGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _FSMService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "extensions.fsm.FSMService",
+	HandlerType: (*FSMServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListGraphs",
+			Handler:    _FSMService_ListGraphs_Handler,
+		},
+		{
+			MethodName: "GetGraph",
+			Handler:    _FSMService_GetGraph_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "fsm/fsm.proto",
+}
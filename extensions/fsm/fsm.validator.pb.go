@@ -0,0 +1,52 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: fsm/fsm.proto
+
+package fsm
+
+import (
+	fmt "fmt"
+	math "math"
+	proto "github.com/golang/protobuf/proto"
+	_ "github.com/mwitkow/go-proto-validators"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	_ "google.golang.org/protobuf/types/known/emptypb"
+	github_com_mwitkow_go_proto_validators "github.com/mwitkow/go-proto-validators"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+func (this *StateGraphRequest) Validate() error {
+	if this.Schema == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Schema", fmt.Errorf(`value '%v' must not be an empty string`, this.Schema))
+	}
+	return nil
+}
+func (this *StateGraph) Validate() error {
+	for _, item := range this.Transitions {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Transitions", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *StateGraphs) Validate() error {
+	for _, item := range this.Items {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Items", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *TransitionDescription) Validate() error {
+	return nil
+}
+func (this *Transitioned) Validate() error {
+	return nil
+}
@@ -0,0 +1,42 @@
+package fsm
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	"github.com/s7techlab/cckit/router"
+)
+
+type (
+	// Service introspection of state machines
+	Service struct {
+		Machines []*Machine
+	}
+)
+
+var _ FSMServiceChaincode = &Service{}
+
+func NewService(machines ...*Machine) *Service {
+	return &Service{
+		Machines: machines,
+	}
+}
+
+func (s *Service) ListGraphs(_ router.Context, _ *empty.Empty) (*StateGraphs, error) {
+	graphs := &StateGraphs{}
+	for _, m := range s.Machines {
+		graphs.Items = append(graphs.Items, m.Graph())
+	}
+	return graphs, nil
+}
+
+func (s *Service) GetGraph(_ router.Context, req *StateGraphRequest) (*StateGraph, error) {
+	for _, m := range s.Machines {
+		if schemaName(m.schema) == req.Schema {
+			return m.Graph(), nil
+		}
+	}
+
+	return nil, fmt.Errorf(`%w: %s`, ErrStateMachineNotFound, req.Schema)
+}
@@ -0,0 +1,208 @@
+package fsm_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	cpaper "github.com/s7techlab/cckit/examples/cpaper_asservice"
+	cpapertestdata "github.com/s7techlab/cckit/examples/cpaper_asservice/testdata"
+	"github.com/s7techlab/cckit/extensions/fsm"
+	identitytestdata "github.com/s7techlab/cckit/identity/testdata"
+	"github.com/s7techlab/cckit/router"
+	"github.com/s7techlab/cckit/state/mapping"
+	testcc "github.com/s7techlab/cckit/testing"
+)
+
+func TestFSM(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "FSM suite")
+}
+
+var _ = Describe(`FSM`, func() {
+
+	var (
+		issuer   = identitytestdata.Certificates[0].MustIdentity(`ISSUER_MSP`)
+		investor = identitytestdata.Certificates[1].MustIdentity(`INVESTOR_MSP`)
+
+		events = mapping.EventMappings{}
+
+		machine, machineErr = fsm.New(cpaper.StateMappings, &cpaper.CommercialPaper{}, `state`,
+			fsm.Allow(`buy`,
+				fsm.From(cpaper.CommercialPaper_STATE_ISSUED, cpaper.CommercialPaper_STATE_TRADING),
+				cpaper.CommercialPaper_STATE_TRADING,
+				fsm.WithGuard(fsm.Require(`owner changed`, func(ctx router.Context, entity proto.Message) bool {
+					return entity.(*cpaper.CommercialPaper).Owner != cpapertestdata.Id1.Issuer
+				}))),
+			fsm.Allow(`redeem`,
+				fsm.From(cpaper.CommercialPaper_STATE_TRADING),
+				cpaper.CommercialPaper_STATE_REDEEMED,
+				fsm.WithGuard(fsm.RequireMSP(`ISSUER_MSP`)),
+				fsm.WithEvent(`CommercialPaperRedeemed`)),
+			fsm.WithEventMappings(events))
+
+		cc, ctx = testcc.NewTxHandler(`FSM`)
+
+		paper = func(owner string) *cpaper.CommercialPaper {
+			return &cpaper.CommercialPaper{
+				Issuer:       cpapertestdata.Issue1.Issuer,
+				PaperNumber:  cpapertestdata.Issue1.PaperNumber,
+				Owner:        owner,
+				IssueDate:    cpapertestdata.Issue1.IssueDate,
+				MaturityDate: cpapertestdata.Issue1.MaturityDate,
+				FaceValue:    cpapertestdata.Issue1.FaceValue,
+				ExternalId:   cpapertestdata.Issue1.ExternalId,
+			}
+		}
+	)
+
+	It(`Allow to create state machine for enum field`, func() {
+		Expect(machineErr).NotTo(HaveOccurred())
+
+		_, err := fsm.New(cpaper.StateMappings, &cpaper.CommercialPaper{}, `owner`)
+		Expect(errors.Is(err, fsm.ErrStateFieldNotFound)).To(BeTrue())
+	})
+
+	It(`Allow to issue entity in initial state`, func() {
+		cc.From(issuer).Tx(func() {
+			_, err := cpaper.NewService().Issue(ctx, cpapertestdata.Issue1)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	It(`Disallow transition not declared in state machine`, func() {
+		cc.From(issuer).Tx(func() {
+			_, err := machine.Transition(ctx, paper(cpapertestdata.Id1.Issuer), cpaper.CommercialPaper_STATE_REDEEMED)
+			Expect(errors.Is(err, fsm.ErrTransitionNotAllowed)).To(BeTrue())
+		})
+	})
+
+	It(`Disallow transition if guard failed`, func() {
+		cc.From(investor).Tx(func() {
+			_, err := machine.Transition(ctx, paper(cpapertestdata.Id1.Issuer), cpaper.CommercialPaper_STATE_TRADING)
+			Expect(errors.Is(err, fsm.ErrTransitionDenied)).To(BeTrue())
+		})
+	})
+
+	It(`Allow transition and emit transition event`, func() {
+		cc.From(investor).Tx(func() {
+			moved, err := machine.Transition(ctx, paper(`SomeBuyer`), cpaper.CommercialPaper_STATE_TRADING)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(moved.(*cpaper.CommercialPaper).State).To(Equal(cpaper.CommercialPaper_STATE_TRADING))
+
+			event := cc.TxEvent()
+			Expect(event.EventName).To(Equal(`CommercialPaperTransitioned`))
+
+			transitioned := &fsm.Transitioned{}
+			Expect(proto.Unmarshal(event.Payload, transitioned)).NotTo(HaveOccurred())
+			Expect(transitioned.Transition).To(Equal(`buy`))
+			Expect(transitioned.From).To(Equal(`STATE_ISSUED`))
+			Expect(transitioned.To).To(Equal(`STATE_TRADING`))
+			Expect(transitioned.Key).To(Equal([]string{`CommercialPaper`, `SomeIssuer`, `0001`}))
+
+			resolved, err := events.Resolve(event.EventName, event.Payload)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(proto.Equal(resolved.(proto.Message), transitioned)).To(BeTrue())
+		})
+
+		cc.Tx(func() {
+			stored, err := cpaper.NewService().Get(ctx, cpapertestdata.Id1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stored.State).To(Equal(cpaper.CommercialPaper_STATE_TRADING))
+			Expect(stored.Owner).To(Equal(`SomeBuyer`))
+		})
+	})
+
+	It(`Allow transition of entity with primary key fields only`, func() {
+		cc.From(investor).Tx(func() {
+			moved, err := machine.Transition(ctx, &cpaper.CommercialPaper{
+				Issuer:      cpapertestdata.Id1.Issuer,
+				PaperNumber: cpapertestdata.Id1.PaperNumber,
+			}, cpaper.CommercialPaper_STATE_TRADING)
+			// guard checks owner of stored entity
+			Expect(err).NotTo(HaveOccurred())
+			Expect(moved.(*cpaper.CommercialPaper).Owner).To(Equal(`SomeBuyer`))
+		})
+
+		cc.Tx(func() {
+			stored, err := cpaper.NewService().Get(ctx, cpapertestdata.Id1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stored.State).To(Equal(cpaper.CommercialPaper_STATE_TRADING))
+			Expect(stored.Owner).To(Equal(`SomeBuyer`))
+			Expect(stored.FaceValue).To(Equal(cpapertestdata.Issue1.FaceValue))
+			Expect(stored.ExternalId).To(Equal(cpapertestdata.Issue1.ExternalId))
+			Expect(proto.Equal(stored.IssueDate, cpapertestdata.Issue1.IssueDate)).To(BeTrue())
+			Expect(proto.Equal(stored.MaturityDate, cpapertestdata.Issue1.MaturityDate)).To(BeTrue())
+		})
+	})
+
+	It(`Allow transition with field mask, clearing fields not set in entity`, func() {
+		cc.From(investor).Tx(func() {
+			_, err := machine.TransitionWithMask(ctx, paper(`OtherBuyer`),
+				&fieldmaskpb.FieldMask{Paths: []string{`unknown`}}, cpaper.CommercialPaper_STATE_TRADING)
+			Expect(err).To(MatchError(ContainSubstring(mapping.ErrFieldMaskInvalid.Error())))
+		})
+
+		cc.From(investor).Tx(func() {
+			entity := paper(`OtherBuyer`)
+			entity.ExternalId = ``
+			entity.FaceValue = 0
+
+			moved, err := machine.TransitionWithMask(ctx, entity,
+				&fieldmaskpb.FieldMask{Paths: []string{`owner`, `external_id`, `state`}}, cpaper.CommercialPaper_STATE_TRADING)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(moved.(*cpaper.CommercialPaper).State).To(Equal(cpaper.CommercialPaper_STATE_TRADING))
+		})
+
+		cc.Tx(func() {
+			stored, err := cpaper.NewService().Get(ctx, cpapertestdata.Id1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stored.Owner).To(Equal(`OtherBuyer`))
+			Expect(stored.ExternalId).To(BeEmpty())
+			// not listed in mask
+			Expect(stored.FaceValue).To(Equal(cpapertestdata.Issue1.FaceValue))
+		})
+	})
+
+	It(`Disallow transition for tx creator from not allowed msp`, func() {
+		cc.From(investor).Tx(func() {
+			_, err := machine.Transition(ctx, paper(cpapertestdata.Id1.Issuer), cpaper.CommercialPaper_STATE_REDEEMED)
+			Expect(errors.Is(err, fsm.ErrTransitionDenied)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring(fsm.ErrGuardMSPNotAllowed.Error()))
+		})
+	})
+
+	It(`Allow transition with transition event name`, func() {
+		cc.From(issuer).Tx(func() {
+			_, err := machine.Transition(ctx, paper(cpapertestdata.Id1.Issuer), cpaper.CommercialPaper_STATE_REDEEMED)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cc.TxEvent().EventName).To(Equal(`CommercialPaperRedeemed`))
+		})
+	})
+
+	It(`Allow to get state graph`, func() {
+		svc := fsm.NewService(machine)
+
+		cc.Tx(func() {
+			graphs, err := svc.ListGraphs(ctx, &empty.Empty{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(graphs.Items).To(HaveLen(1))
+
+			graph, err := svc.GetGraph(ctx, &fsm.StateGraphRequest{Schema: `examples.cpaper_asservice.CommercialPaper`})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(graph.Field).To(Equal(`state`))
+			Expect(graph.States).To(Equal([]string{`STATE_ISSUED`, `STATE_TRADING`, `STATE_REDEEMED`}))
+			Expect(graph.Transitions).To(HaveLen(2))
+			Expect(graph.Transitions[0].From).To(Equal([]string{`STATE_ISSUED`, `STATE_TRADING`}))
+			Expect(graph.Dot).To(ContainSubstring(`"STATE_TRADING" -> "STATE_REDEEMED" [label="redeem"];`))
+
+			_, err = svc.GetGraph(ctx, &fsm.StateGraphRequest{Schema: `unknown`})
+			Expect(errors.Is(err, fsm.ErrStateMachineNotFound)).To(BeTrue())
+		})
+	})
+})
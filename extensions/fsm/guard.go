@@ -0,0 +1,59 @@
+package fsm
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/s7techlab/cckit/router"
+)
+
+var (
+	// ErrGuardMSPNotAllowed occurs when tx creator MSP is not in list of allowed MSPs
+	ErrGuardMSPNotAllowed = errors.New(`tx creator msp not allowed`)
+
+	// ErrGuardPredicateFailed occurs when predicate guard returns false
+	ErrGuardPredicateFailed = errors.New(`guard predicate failed`)
+)
+
+type (
+	// Guard checks transition of entity is allowed, entity is stored entity with changed fields, but without new state
+	Guard func(ctx router.Context, entity proto.Message) error
+
+	// Predicate returns true if transition of entity is allowed
+	Predicate func(ctx router.Context, entity proto.Message) bool
+)
+
+// RequireMSP allows transition only for tx creators from one of MSPs
+func RequireMSP(mspIDs ...string) Guard {
+	return func(ctx router.Context, _ proto.Message) error {
+		client, err := ctx.Client()
+		if err != nil {
+			return err
+		}
+
+		mspID, err := client.GetMSPID()
+		if err != nil {
+			return err
+		}
+
+		for _, id := range mspIDs {
+			if id == mspID {
+				return nil
+			}
+		}
+
+		return fmt.Errorf(`%w: %s`, ErrGuardMSPNotAllowed, mspID)
+	}
+}
+
+// Require allows transition only if predicate returns true
+func Require(name string, predicate Predicate) Guard {
+	return func(ctx router.Context, entity proto.Message) error {
+		if !predicate(ctx, entity) {
+			return fmt.Errorf(`%w: %s`, ErrGuardPredicateFailed, name)
+		}
+		return nil
+	}
+}
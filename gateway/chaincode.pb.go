@@ -80,6 +80,67 @@ func (InvocationType) EnumDescriptor() ([]byte, []int) {
 	return file_chaincode_proto_rawDescGZIP(), []int{0}
 }
 
+// Transaction waiting policy: which peers must commit transaction before invoke returns
+type TxWaitPolicy int32
+
+const (
+	// Tx waiter from context or SDK default
+	TxWaitPolicy_TX_WAIT_POLICY_UNSPECIFIED TxWaitPolicy = 0
+	// Don't wait for transaction commit
+	TxWaitPolicy_TX_WAIT_POLICY_NONE TxWaitPolicy = 1
+	// Wait for commit on peer of own organization
+	TxWaitPolicy_TX_WAIT_POLICY_OWN_ORG TxWaitPolicy = 2
+	// Wait for commit on peers of all endorsing organizations
+	TxWaitPolicy_TX_WAIT_POLICY_ALL TxWaitPolicy = 3
+	// Wait for commit on peers of N of M endorsing organizations
+	TxWaitPolicy_TX_WAIT_POLICY_N_OF_M TxWaitPolicy = 4
+)
+
+// Enum value maps for TxWaitPolicy.
+var (
+	TxWaitPolicy_name = map[int32]string{
+		0: "TX_WAIT_POLICY_UNSPECIFIED",
+		1: "TX_WAIT_POLICY_NONE",
+		2: "TX_WAIT_POLICY_OWN_ORG",
+		3: "TX_WAIT_POLICY_ALL",
+		4: "TX_WAIT_POLICY_N_OF_M",
+	}
+	TxWaitPolicy_value = map[string]int32{
+		"TX_WAIT_POLICY_UNSPECIFIED": 0,
+		"TX_WAIT_POLICY_NONE":        1,
+		"TX_WAIT_POLICY_OWN_ORG":     2,
+		"TX_WAIT_POLICY_ALL":         3,
+		"TX_WAIT_POLICY_N_OF_M":      4,
+	}
+)
+
+func (x TxWaitPolicy) Enum() *TxWaitPolicy {
+	p := new(TxWaitPolicy)
+	*p = x
+	return p
+}
+
+func (x TxWaitPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TxWaitPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_chaincode_proto_enumTypes[1].Descriptor()
+}
+
+func (TxWaitPolicy) Type() protoreflect.EnumType {
+	return &file_chaincode_proto_enumTypes[1]
+}
+
+func (x TxWaitPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TxWaitPolicy.Descriptor instead.
+func (TxWaitPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{1}
+}
+
 // Chaincode locator - channel name and chaincode name
 type ChaincodeLocator struct {
 	state         protoimpl.MessageState
@@ -325,6 +386,8 @@ type ChaincodeInvokeRequest struct {
 
 	Locator *ChaincodeLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator,omitempty"`
 	Input   *ChaincodeInput   `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"`
+	// Transaction waiting policy, tx waiter from context is used if not set
+	TxWaiter *TxWaiter `protobuf:"bytes,4,opt,name=tx_waiter,json=txWaiter,proto3" json:"tx_waiter,omitempty"`
 }
 
 func (x *ChaincodeInvokeRequest) Reset() {
@@ -373,6 +436,255 @@ func (x *ChaincodeInvokeRequest) GetInput() *ChaincodeInput {
 	return nil
 }
 
+func (x *ChaincodeInvokeRequest) GetTxWaiter() *TxWaiter {
+	if x != nil {
+		return x.TxWaiter
+	}
+	return nil
+}
+
+type TxWaiter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy TxWaitPolicy `protobuf:"varint,1,opt,name=policy,proto3,enum=cckit.gateway.TxWaitPolicy" json:"policy,omitempty"`
+	// Number of endorsing organizations for TX_WAIT_POLICY_N_OF_M, 0 < n <= M,
+	// M (number of organizations from endorsement policy) is checked by SDK
+	N uint32 `protobuf:"varint,2,opt,name=n,proto3" json:"n,omitempty"`
+}
+
+func (x *TxWaiter) Reset() {
+	*x = TxWaiter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaincode_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxWaiter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxWaiter) ProtoMessage() {}
+
+func (x *TxWaiter) ProtoReflect() protoreflect.Message {
+	mi := &file_chaincode_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxWaiter.ProtoReflect.Descriptor instead.
+func (*TxWaiter) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TxWaiter) GetPolicy() TxWaitPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return TxWaitPolicy_TX_WAIT_POLICY_UNSPECIFIED
+}
+
+func (x *TxWaiter) GetN() uint32 {
+	if x != nil {
+		return x.N
+	}
+	return 0
+}
+
+// Transaction commit status
+type TransactionStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	// Number of block with transaction, 0 if transaction commit is not known
+	BlockNumber uint64 `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// Validation code (protos.TxValidationCode name) of committed transaction, i.e. VALID or MVCC_READ_CONFLICT
+	ValidationCode string                 `protobuf:"bytes,3,opt,name=validation_code,json=validationCode,proto3" json:"validation_code,omitempty"`
+	Timestamp      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *TransactionStatus) Reset() {
+	*x = TransactionStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaincode_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionStatus) ProtoMessage() {}
+
+func (x *TransactionStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_chaincode_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionStatus.ProtoReflect.Descriptor instead.
+func (*TransactionStatus) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TransactionStatus) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *TransactionStatus) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *TransactionStatus) GetValidationCode() string {
+	if x != nil {
+		return x.ValidationCode
+	}
+	return ""
+}
+
+func (x *TransactionStatus) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+// Chaincode response with transaction commit status
+type ChaincodeInvokeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response *peer.Response     `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Tx       *TransactionStatus `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx,omitempty"`
+}
+
+func (x *ChaincodeInvokeResponse) Reset() {
+	*x = ChaincodeInvokeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaincode_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChaincodeInvokeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaincodeInvokeResponse) ProtoMessage() {}
+
+func (x *ChaincodeInvokeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chaincode_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaincodeInvokeResponse.ProtoReflect.Descriptor instead.
+func (*ChaincodeInvokeResponse) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ChaincodeInvokeResponse) GetResponse() *peer.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *ChaincodeInvokeResponse) GetTx() *TransactionStatus {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+type ChaincodeTransactionStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locator *ChaincodeLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator,omitempty"`
+	TxId    string            `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+}
+
+func (x *ChaincodeTransactionStatusRequest) Reset() {
+	*x = ChaincodeTransactionStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaincode_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChaincodeTransactionStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaincodeTransactionStatusRequest) ProtoMessage() {}
+
+func (x *ChaincodeTransactionStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaincode_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaincodeTransactionStatusRequest.ProtoReflect.Descriptor instead.
+func (*ChaincodeTransactionStatusRequest) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ChaincodeTransactionStatusRequest) GetLocator() *ChaincodeLocator {
+	if x != nil {
+		return x.Locator
+	}
+	return nil
+}
+
+func (x *ChaincodeTransactionStatusRequest) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
 // Block limit number for event stream subscription or event list
 // Values can be negative
 type BlockLimit struct {
@@ -387,7 +699,7 @@ type BlockLimit struct {
 func (x *BlockLimit) Reset() {
 	*x = BlockLimit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaincode_proto_msgTypes[5]
+		mi := &file_chaincode_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -400,7 +712,7 @@ func (x *BlockLimit) String() string {
 func (*BlockLimit) ProtoMessage() {}
 
 func (x *BlockLimit) ProtoReflect() protoreflect.Message {
-	mi := &file_chaincode_proto_msgTypes[5]
+	mi := &file_chaincode_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -413,7 +725,7 @@ func (x *BlockLimit) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockLimit.ProtoReflect.Descriptor instead.
 func (*BlockLimit) Descriptor() ([]byte, []int) {
-	return file_chaincode_proto_rawDescGZIP(), []int{5}
+	return file_chaincode_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *BlockLimit) GetNum() int64 {
@@ -433,12 +745,14 @@ type ChaincodeEventsStreamRequest struct {
 	FromBlock *BlockLimit       `protobuf:"bytes,2,opt,name=from_block,json=fromBlock,proto3" json:"from_block,omitempty"`
 	ToBlock   *BlockLimit       `protobuf:"bytes,3,opt,name=to_block,json=toBlock,proto3" json:"to_block,omitempty"`
 	EventName []string          `protobuf:"bytes,4,rep,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
+	// Filter expression on decoded event payload, i.e. owner == "X" && amount > 100
+	PayloadFilter string `protobuf:"bytes,5,opt,name=payload_filter,json=payloadFilter,proto3" json:"payload_filter,omitempty"`
 }
 
 func (x *ChaincodeEventsStreamRequest) Reset() {
 	*x = ChaincodeEventsStreamRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaincode_proto_msgTypes[6]
+		mi := &file_chaincode_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -451,7 +765,7 @@ func (x *ChaincodeEventsStreamRequest) String() string {
 func (*ChaincodeEventsStreamRequest) ProtoMessage() {}
 
 func (x *ChaincodeEventsStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaincode_proto_msgTypes[6]
+	mi := &file_chaincode_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -464,7 +778,7 @@ func (x *ChaincodeEventsStreamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChaincodeEventsStreamRequest.ProtoReflect.Descriptor instead.
 func (*ChaincodeEventsStreamRequest) Descriptor() ([]byte, []int) {
-	return file_chaincode_proto_rawDescGZIP(), []int{6}
+	return file_chaincode_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ChaincodeEventsStreamRequest) GetLocator() *ChaincodeLocator {
@@ -495,6 +809,13 @@ func (x *ChaincodeEventsStreamRequest) GetEventName() []string {
 	return nil
 }
 
+func (x *ChaincodeEventsStreamRequest) GetPayloadFilter() string {
+	if x != nil {
+		return x.PayloadFilter
+	}
+	return ""
+}
+
 // Chaincode events list request
 type ChaincodeEventsRequest struct {
 	state         protoimpl.MessageState
@@ -506,12 +827,14 @@ type ChaincodeEventsRequest struct {
 	ToBlock   *BlockLimit       `protobuf:"bytes,3,opt,name=to_block,json=toBlock,proto3" json:"to_block,omitempty"`
 	EventName []string          `protobuf:"bytes,4,rep,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
 	Limit     uint32            `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Filter expression on decoded event payload, i.e. owner == "X" && amount > 100
+	PayloadFilter string `protobuf:"bytes,6,opt,name=payload_filter,json=payloadFilter,proto3" json:"payload_filter,omitempty"`
 }
 
 func (x *ChaincodeEventsRequest) Reset() {
 	*x = ChaincodeEventsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaincode_proto_msgTypes[7]
+		mi := &file_chaincode_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -524,7 +847,7 @@ func (x *ChaincodeEventsRequest) String() string {
 func (*ChaincodeEventsRequest) ProtoMessage() {}
 
 func (x *ChaincodeEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaincode_proto_msgTypes[7]
+	mi := &file_chaincode_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -537,7 +860,7 @@ func (x *ChaincodeEventsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChaincodeEventsRequest.ProtoReflect.Descriptor instead.
 func (*ChaincodeEventsRequest) Descriptor() ([]byte, []int) {
-	return file_chaincode_proto_rawDescGZIP(), []int{7}
+	return file_chaincode_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ChaincodeEventsRequest) GetLocator() *ChaincodeLocator {
@@ -575,6 +898,13 @@ func (x *ChaincodeEventsRequest) GetLimit() uint32 {
 	return 0
 }
 
+func (x *ChaincodeEventsRequest) GetPayloadFilter() string {
+	if x != nil {
+		return x.PayloadFilter
+	}
+	return ""
+}
+
 type ChaincodeInstanceExecRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -587,7 +917,7 @@ type ChaincodeInstanceExecRequest struct {
 func (x *ChaincodeInstanceExecRequest) Reset() {
 	*x = ChaincodeInstanceExecRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaincode_proto_msgTypes[8]
+		mi := &file_chaincode_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -600,7 +930,7 @@ func (x *ChaincodeInstanceExecRequest) String() string {
 func (*ChaincodeInstanceExecRequest) ProtoMessage() {}
 
 func (x *ChaincodeInstanceExecRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaincode_proto_msgTypes[8]
+	mi := &file_chaincode_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -613,7 +943,7 @@ func (x *ChaincodeInstanceExecRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChaincodeInstanceExecRequest.ProtoReflect.Descriptor instead.
 func (*ChaincodeInstanceExecRequest) Descriptor() ([]byte, []int) {
-	return file_chaincode_proto_rawDescGZIP(), []int{8}
+	return file_chaincode_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ChaincodeInstanceExecRequest) GetType() InvocationType {
@@ -641,7 +971,7 @@ type ChaincodeInstanceQueryRequest struct {
 func (x *ChaincodeInstanceQueryRequest) Reset() {
 	*x = ChaincodeInstanceQueryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaincode_proto_msgTypes[9]
+		mi := &file_chaincode_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -654,7 +984,7 @@ func (x *ChaincodeInstanceQueryRequest) String() string {
 func (*ChaincodeInstanceQueryRequest) ProtoMessage() {}
 
 func (x *ChaincodeInstanceQueryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaincode_proto_msgTypes[9]
+	mi := &file_chaincode_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -667,7 +997,7 @@ func (x *ChaincodeInstanceQueryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChaincodeInstanceQueryRequest.ProtoReflect.Descriptor instead.
 func (*ChaincodeInstanceQueryRequest) Descriptor() ([]byte, []int) {
-	return file_chaincode_proto_rawDescGZIP(), []int{9}
+	return file_chaincode_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ChaincodeInstanceQueryRequest) GetInput() *ChaincodeInput {
@@ -683,12 +1013,14 @@ type ChaincodeInstanceInvokeRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	Input *ChaincodeInput `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	// Transaction waiting policy, tx waiter from context is used if not set
+	TxWaiter *TxWaiter `protobuf:"bytes,2,opt,name=tx_waiter,json=txWaiter,proto3" json:"tx_waiter,omitempty"`
 }
 
 func (x *ChaincodeInstanceInvokeRequest) Reset() {
 	*x = ChaincodeInstanceInvokeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaincode_proto_msgTypes[10]
+		mi := &file_chaincode_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -701,7 +1033,7 @@ func (x *ChaincodeInstanceInvokeRequest) String() string {
 func (*ChaincodeInstanceInvokeRequest) ProtoMessage() {}
 
 func (x *ChaincodeInstanceInvokeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaincode_proto_msgTypes[10]
+	mi := &file_chaincode_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -714,7 +1046,7 @@ func (x *ChaincodeInstanceInvokeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChaincodeInstanceInvokeRequest.ProtoReflect.Descriptor instead.
 func (*ChaincodeInstanceInvokeRequest) Descriptor() ([]byte, []int) {
-	return file_chaincode_proto_rawDescGZIP(), []int{10}
+	return file_chaincode_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ChaincodeInstanceInvokeRequest) GetInput() *ChaincodeInput {
@@ -724,20 +1056,76 @@ func (x *ChaincodeInstanceInvokeRequest) GetInput() *ChaincodeInput {
 	return nil
 }
 
-type ChaincodeInstanceEventsStreamRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
+func (x *ChaincodeInstanceInvokeRequest) GetTxWaiter() *TxWaiter {
+	if x != nil {
+		return x.TxWaiter
+	}
+	return nil
+}
+
+type ChaincodeInstanceTransactionStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+}
+
+func (x *ChaincodeInstanceTransactionStatusRequest) Reset() {
+	*x = ChaincodeInstanceTransactionStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaincode_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChaincodeInstanceTransactionStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaincodeInstanceTransactionStatusRequest) ProtoMessage() {}
+
+func (x *ChaincodeInstanceTransactionStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaincode_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaincodeInstanceTransactionStatusRequest.ProtoReflect.Descriptor instead.
+func (*ChaincodeInstanceTransactionStatusRequest) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ChaincodeInstanceTransactionStatusRequest) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+type ChaincodeInstanceEventsStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
 	FromBlock *BlockLimit `protobuf:"bytes,1,opt,name=from_block,json=fromBlock,proto3" json:"from_block,omitempty"`
 	ToBlock   *BlockLimit `protobuf:"bytes,2,opt,name=to_block,json=toBlock,proto3" json:"to_block,omitempty"`
 	EventName []string    `protobuf:"bytes,3,rep,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
+	// Filter expression on decoded event payload, i.e. owner == "X" && amount > 100
+	PayloadFilter string `protobuf:"bytes,4,opt,name=payload_filter,json=payloadFilter,proto3" json:"payload_filter,omitempty"`
 }
 
 func (x *ChaincodeInstanceEventsStreamRequest) Reset() {
 	*x = ChaincodeInstanceEventsStreamRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaincode_proto_msgTypes[11]
+		mi := &file_chaincode_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -750,7 +1138,7 @@ func (x *ChaincodeInstanceEventsStreamRequest) String() string {
 func (*ChaincodeInstanceEventsStreamRequest) ProtoMessage() {}
 
 func (x *ChaincodeInstanceEventsStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaincode_proto_msgTypes[11]
+	mi := &file_chaincode_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -763,7 +1151,7 @@ func (x *ChaincodeInstanceEventsStreamRequest) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use ChaincodeInstanceEventsStreamRequest.ProtoReflect.Descriptor instead.
 func (*ChaincodeInstanceEventsStreamRequest) Descriptor() ([]byte, []int) {
-	return file_chaincode_proto_rawDescGZIP(), []int{11}
+	return file_chaincode_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ChaincodeInstanceEventsStreamRequest) GetFromBlock() *BlockLimit {
@@ -787,6 +1175,13 @@ func (x *ChaincodeInstanceEventsStreamRequest) GetEventName() []string {
 	return nil
 }
 
+func (x *ChaincodeInstanceEventsStreamRequest) GetPayloadFilter() string {
+	if x != nil {
+		return x.PayloadFilter
+	}
+	return ""
+}
+
 type ChaincodeInstanceEventsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -796,12 +1191,14 @@ type ChaincodeInstanceEventsRequest struct {
 	ToBlock   *BlockLimit `protobuf:"bytes,2,opt,name=to_block,json=toBlock,proto3" json:"to_block,omitempty"`
 	EventName []string    `protobuf:"bytes,3,rep,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
 	Limit     uint32      `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Filter expression on decoded event payload, i.e. owner == "X" && amount > 100
+	PayloadFilter string `protobuf:"bytes,5,opt,name=payload_filter,json=payloadFilter,proto3" json:"payload_filter,omitempty"`
 }
 
 func (x *ChaincodeInstanceEventsRequest) Reset() {
 	*x = ChaincodeInstanceEventsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaincode_proto_msgTypes[12]
+		mi := &file_chaincode_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -814,7 +1211,7 @@ func (x *ChaincodeInstanceEventsRequest) String() string {
 func (*ChaincodeInstanceEventsRequest) ProtoMessage() {}
 
 func (x *ChaincodeInstanceEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaincode_proto_msgTypes[12]
+	mi := &file_chaincode_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -827,7 +1224,7 @@ func (x *ChaincodeInstanceEventsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChaincodeInstanceEventsRequest.ProtoReflect.Descriptor instead.
 func (*ChaincodeInstanceEventsRequest) Descriptor() ([]byte, []int) {
-	return file_chaincode_proto_rawDescGZIP(), []int{12}
+	return file_chaincode_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ChaincodeInstanceEventsRequest) GetFromBlock() *BlockLimit {
@@ -858,6 +1255,13 @@ func (x *ChaincodeInstanceEventsRequest) GetLimit() uint32 {
 	return 0
 }
 
+func (x *ChaincodeInstanceEventsRequest) GetPayloadFilter() string {
+	if x != nil {
+		return x.PayloadFilter
+	}
+	return ""
+}
+
 type ChaincodeEvents struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -872,7 +1276,7 @@ type ChaincodeEvents struct {
 func (x *ChaincodeEvents) Reset() {
 	*x = ChaincodeEvents{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaincode_proto_msgTypes[13]
+		mi := &file_chaincode_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -885,7 +1289,7 @@ func (x *ChaincodeEvents) String() string {
 func (*ChaincodeEvents) ProtoMessage() {}
 
 func (x *ChaincodeEvents) ProtoReflect() protoreflect.Message {
-	mi := &file_chaincode_proto_msgTypes[13]
+	mi := &file_chaincode_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -898,7 +1302,7 @@ func (x *ChaincodeEvents) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChaincodeEvents.ProtoReflect.Descriptor instead.
 func (*ChaincodeEvents) Descriptor() ([]byte, []int) {
-	return file_chaincode_proto_rawDescGZIP(), []int{13}
+	return file_chaincode_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ChaincodeEvents) GetLocator() *ChaincodeLocator {
@@ -940,7 +1344,7 @@ type RawJson struct {
 func (x *RawJson) Reset() {
 	*x = RawJson{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaincode_proto_msgTypes[14]
+		mi := &file_chaincode_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -953,7 +1357,7 @@ func (x *RawJson) String() string {
 func (*RawJson) ProtoMessage() {}
 
 func (x *RawJson) ProtoReflect() protoreflect.Message {
-	mi := &file_chaincode_proto_msgTypes[14]
+	mi := &file_chaincode_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -966,7 +1370,7 @@ func (x *RawJson) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RawJson.ProtoReflect.Descriptor instead.
 func (*RawJson) Descriptor() ([]byte, []int) {
-	return file_chaincode_proto_rawDescGZIP(), []int{14}
+	return file_chaincode_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *RawJson) GetValue() []byte {
@@ -985,12 +1389,14 @@ type ChaincodeEvent struct {
 	Block       uint64                 `protobuf:"varint,2,opt,name=block,proto3" json:"block,omitempty"`
 	TxTimestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=tx_timestamp,json=txTimestamp,proto3" json:"tx_timestamp,omitempty"`
 	Payload     *RawJson               `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	// name of latest version of event, payload is resolved to by versioned event resolver
+	ResolvedName string `protobuf:"bytes,5,opt,name=resolved_name,json=resolvedName,proto3" json:"resolved_name,omitempty"`
 }
 
 func (x *ChaincodeEvent) Reset() {
 	*x = ChaincodeEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaincode_proto_msgTypes[15]
+		mi := &file_chaincode_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1003,7 +1409,7 @@ func (x *ChaincodeEvent) String() string {
 func (*ChaincodeEvent) ProtoMessage() {}
 
 func (x *ChaincodeEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_chaincode_proto_msgTypes[15]
+	mi := &file_chaincode_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1016,7 +1422,7 @@ func (x *ChaincodeEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChaincodeEvent.ProtoReflect.Descriptor instead.
 func (*ChaincodeEvent) Descriptor() ([]byte, []int) {
-	return file_chaincode_proto_rawDescGZIP(), []int{15}
+	return file_chaincode_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ChaincodeEvent) GetEvent() *peer.ChaincodeEvent {
@@ -1047,6 +1453,377 @@ func (x *ChaincodeEvent) GetPayload() *RawJson {
 	return nil
 }
 
+func (x *ChaincodeEvent) GetResolvedName() string {
+	if x != nil {
+		return x.ResolvedName
+	}
+	return ""
+}
+
+type ChaincodeProposeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locator *ChaincodeLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator,omitempty"`
+	Input   *ChaincodeInput   `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	// Serialized identity (msp.SerializedIdentity) of proposal creator
+	Creator []byte `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (x *ChaincodeProposeRequest) Reset() {
+	*x = ChaincodeProposeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaincode_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChaincodeProposeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaincodeProposeRequest) ProtoMessage() {}
+
+func (x *ChaincodeProposeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaincode_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaincodeProposeRequest.ProtoReflect.Descriptor instead.
+func (*ChaincodeProposeRequest) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ChaincodeProposeRequest) GetLocator() *ChaincodeLocator {
+	if x != nil {
+		return x.Locator
+	}
+	return nil
+}
+
+func (x *ChaincodeProposeRequest) GetInput() *ChaincodeInput {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *ChaincodeProposeRequest) GetCreator() []byte {
+	if x != nil {
+		return x.Creator
+	}
+	return nil
+}
+
+// Proposal to be signed by creator
+type UnsignedProposal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	// Serialized proposal (protos.Proposal)
+	Proposal []byte `protobuf:"bytes,2,opt,name=proposal,proto3" json:"proposal,omitempty"`
+}
+
+func (x *UnsignedProposal) Reset() {
+	*x = UnsignedProposal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaincode_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsignedProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsignedProposal) ProtoMessage() {}
+
+func (x *UnsignedProposal) ProtoReflect() protoreflect.Message {
+	mi := &file_chaincode_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsignedProposal.ProtoReflect.Descriptor instead.
+func (*UnsignedProposal) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UnsignedProposal) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *UnsignedProposal) GetProposal() []byte {
+	if x != nil {
+		return x.Proposal
+	}
+	return nil
+}
+
+type ChaincodeEndorseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locator *ChaincodeLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator,omitempty"`
+	// Serialized proposal (protos.Proposal)
+	Proposal []byte `protobuf:"bytes,2,opt,name=proposal,proto3" json:"proposal,omitempty"`
+	// Creator signature of serialized proposal
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *ChaincodeEndorseRequest) Reset() {
+	*x = ChaincodeEndorseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaincode_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChaincodeEndorseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaincodeEndorseRequest) ProtoMessage() {}
+
+func (x *ChaincodeEndorseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaincode_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaincodeEndorseRequest.ProtoReflect.Descriptor instead.
+func (*ChaincodeEndorseRequest) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ChaincodeEndorseRequest) GetLocator() *ChaincodeLocator {
+	if x != nil {
+		return x.Locator
+	}
+	return nil
+}
+
+func (x *ChaincodeEndorseRequest) GetProposal() []byte {
+	if x != nil {
+		return x.Proposal
+	}
+	return nil
+}
+
+func (x *ChaincodeEndorseRequest) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// Endorsed transaction to be signed by creator
+type UnsignedTransaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	// Chaincode response of endorsement
+	Response *peer.Response `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	// Serialized transaction envelope payload (common.Payload)
+	Payload []byte `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *UnsignedTransaction) Reset() {
+	*x = UnsignedTransaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaincode_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsignedTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsignedTransaction) ProtoMessage() {}
+
+func (x *UnsignedTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_chaincode_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsignedTransaction.ProtoReflect.Descriptor instead.
+func (*UnsignedTransaction) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UnsignedTransaction) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *UnsignedTransaction) GetResponse() *peer.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *UnsignedTransaction) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type ChaincodeSubmitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locator *ChaincodeLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator,omitempty"`
+	// Serialized transaction envelope payload (common.Payload)
+	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Creator signature of transaction envelope payload
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *ChaincodeSubmitRequest) Reset() {
+	*x = ChaincodeSubmitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaincode_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChaincodeSubmitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaincodeSubmitRequest) ProtoMessage() {}
+
+func (x *ChaincodeSubmitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaincode_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaincodeSubmitRequest.ProtoReflect.Descriptor instead.
+func (*ChaincodeSubmitRequest) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ChaincodeSubmitRequest) GetLocator() *ChaincodeLocator {
+	if x != nil {
+		return x.Locator
+	}
+	return nil
+}
+
+func (x *ChaincodeSubmitRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ChaincodeSubmitRequest) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type ChaincodeSubmitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+}
+
+func (x *ChaincodeSubmitResponse) Reset() {
+	*x = ChaincodeSubmitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaincode_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChaincodeSubmitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaincodeSubmitResponse) ProtoMessage() {}
+
+func (x *ChaincodeSubmitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chaincode_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaincodeSubmitResponse.ProtoReflect.Descriptor instead.
+func (*ChaincodeSubmitResponse) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ChaincodeSubmitResponse) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
 var File_chaincode_proto protoreflect.FileDescriptor
 
 var file_chaincode_proto_rawDesc = []byte{
@@ -1103,7 +1880,7 @@ var file_chaincode_proto_rawDesc = []byte{
 	0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x63, 0x6b, 0x69,
 	0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63,
 	0x6f, 0x64, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x22,
-	0x90, 0x01, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x76,
+	0xc6, 0x01, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x76,
 	0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x07, 0x6c, 0x6f,
 	0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x63,
 	0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69,
@@ -1112,230 +1889,402 @@ var file_chaincode_proto_rawDesc = []byte{
 	0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63,
 	0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61,
 	0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70,
-	0x75, 0x74, 0x22, 0x1e, 0x0a, 0x0a, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6e,
-	0x75, 0x6d, 0x22, 0xf0, 0x01, 0x0a, 0x1c, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x07, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x38, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x63, 0x6b,
+	0x75, 0x74, 0x12, 0x34, 0x0a, 0x09, 0x74, 0x78, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x78, 0x57, 0x61, 0x69, 0x74, 0x65, 0x72, 0x52, 0x08,
+	0x74, 0x78, 0x57, 0x61, 0x69, 0x74, 0x65, 0x72, 0x22, 0x4d, 0x0a, 0x08, 0x54, 0x78, 0x57, 0x61,
+	0x69, 0x74, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x78, 0x57, 0x61, 0x69, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x01, 0x6e, 0x22, 0xae, 0x01, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x13, 0x0a,
+	0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x38,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x79, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x30, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x02, 0x74, 0x78, 0x22, 0x83, 0x01, 0x0a, 0x21, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x07, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x63, 0x6b,
+	0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x06, 0xe2, 0xdf, 0x1f,
+	0x02, 0x20, 0x01, 0x52, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x05,
+	0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f,
+	0x02, 0x58, 0x01, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x22, 0x1e, 0x0a, 0x0a, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x22, 0x97, 0x02, 0x0a, 0x1c, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x07, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x63,
+	0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x06, 0xe2, 0xdf,
+	0x1f, 0x02, 0x20, 0x01, 0x52, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x38, 0x0a,
+	0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x09, 0x66, 0x72,
+	0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x34, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x63, 0x6b, 0x69,
+	0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x52, 0x07, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1d, 0x0a,
+	0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x22, 0xa7, 0x02, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41,
+	0x0a, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72,
+	0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x38, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x34, 0x0a, 0x08, 0x74,
+	0x6f, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x07, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x86, 0x01,
+	0x0a, 0x1c, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x63,
+	0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x49, 0x6e, 0x76,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x33, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52,
+	0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x54, 0x0a, 0x1d, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x8b, 0x01, 0x0a,
+	0x1e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x33, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x12, 0x34, 0x0a, 0x09, 0x74, 0x78, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x78, 0x57, 0x61, 0x69, 0x74, 0x65, 0x72,
+	0x52, 0x08, 0x74, 0x78, 0x57, 0x61, 0x69, 0x74, 0x65, 0x72, 0x22, 0x48, 0x0a, 0x29, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x04,
+	0x74, 0x78, 0x49, 0x64, 0x22, 0xdc, 0x01, 0x0a, 0x24, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a,
+	0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x09, 0x66, 0x72,
+	0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x34, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x63, 0x6b, 0x69,
+	0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x52, 0x07, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1d, 0x0a,
+	0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x22, 0xec, 0x01, 0x0a, 0x1e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x63, 0x6b,
 	0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
 	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x12, 0x34, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01,
+	0x12, 0x34, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77,
 	0x61, 0x79, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x07, 0x74,
 	0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x80, 0x02, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63,
-	0x6f, 0x64, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x41, 0x0a, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74,
-	0x6f, 0x72, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x07, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x6f, 0x72, 0x12, 0x38, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e,
-	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x34, 0x0a,
-	0x08, 0x74, 0x6f, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x07, 0x74, 0x6f, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x86, 0x01, 0x0a, 0x1c, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x78,
-	0x65, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e,
-	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x05,
-	0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x63,
-	0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69,
-	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75,
-	0x74, 0x22, 0x54, 0x0a, 0x1d, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x33, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x55, 0x0a, 0x1e, 0x43, 0x68, 0x61, 0x69, 0x6e,
-	0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x6f,
-	0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x05, 0x69, 0x6e, 0x70,
-	0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74,
-	0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f,
-	0x64, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x22, 0xb5,
-	0x01, 0x0a, 0x24, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x63,
-	0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x12, 0x34, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x07,
-	0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xc5, 0x01, 0x0a, 0x1e, 0x43, 0x68, 0x61, 0x69, 0x6e,
-	0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x66, 0x72, 0x6f,
-	0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x22, 0xf9, 0x01, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01,
+	0x52, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x38, 0x0a, 0x0a, 0x66, 0x72, 0x6f,
+	0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
 	0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x42, 0x6c,
 	0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x6c,
 	0x6f, 0x63, 0x6b, 0x12, 0x34, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61,
 	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x52, 0x07, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xf9,
-	0x01, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x12, 0x41, 0x0a, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x4c, 0x6f, 0x63,
-	0x61, 0x74, 0x6f, 0x72, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x07, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x38, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x63, 0x6b, 0x69,
-	0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c,
-	0x69, 0x6d, 0x69, 0x74, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
-	0x34, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x07, 0x74, 0x6f,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x33, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x1f, 0x0a, 0x07, 0x52, 0x61,
-	0x77, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xc5, 0x01, 0x0a, 0x0e,
-	0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2c,
-	0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x12, 0x3d, 0x0a, 0x0c, 0x74, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x74, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x12, 0x30, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77,
-	0x61, 0x79, 0x2e, 0x52, 0x61, 0x77, 0x4a, 0x73, 0x6f, 0x6e, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c,
-	0x6f, 0x61, 0x64, 0x2a, 0x47, 0x0a, 0x0e, 0x49, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x49, 0x4e, 0x56, 0x4f, 0x43, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x10, 0x00,
-	0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x56, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x4f, 0x4b, 0x45, 0x10, 0x01, 0x32, 0x95, 0x04, 0x0a,
-	0x10, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x59, 0x0a, 0x04, 0x45, 0x78, 0x65, 0x63, 0x12, 0x23, 0x2e, 0x63, 0x63, 0x6b, 0x69,
+	0x52, 0x07, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x33, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74,
+	0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x1f,
+	0x0a, 0x07, 0x52, 0x61, 0x77, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0xea, 0x01, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x3d, 0x0a, 0x0c, 0x74, 0x78, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x74, 0x78, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x30, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x52, 0x61, 0x77, 0x4a, 0x73, 0x6f, 0x6e, 0x52, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xbb, 0x01, 0x0a,
+	0x17, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x07, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x63, 0x6b, 0x69,
 	0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63,
-	0x6f, 0x64, 0x65, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x22, 0x0f, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x63, 0x6f, 0x64, 0x65, 0x2f, 0x65, 0x78, 0x65, 0x63, 0x3a, 0x01, 0x2a, 0x12, 0x59, 0x0a, 0x05,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x24, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61,
-	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64,
-	0x65, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x5f, 0x0a, 0x06, 0x49, 0x6e, 0x76, 0x6f, 0x6b,
-	0x65, 0x12, 0x25, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x6b,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x16, 0x22, 0x11, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2f, 0x69,
-	0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x7e, 0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x2b, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74,
+	0x6f, 0x64, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02,
+	0x20, 0x01, 0x52, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x3b, 0x0a, 0x05, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x63, 0x6b,
+	0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20,
+	0x01, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x70,
+	0x00, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x43, 0x0a, 0x10, 0x55, 0x6e,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x13,
+	0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x78, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x22,
+	0xa6, 0x01, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x64,
+	0x6f, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x07, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63,
+	0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x06, 0xe2,
+	0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x22,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x70, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x12, 0x24, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x70, 0x00, 0x52, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x72, 0x0a, 0x13, 0x55, 0x6e, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x78, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xa3, 0x01, 0x0a,
+	0x16, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74,
 	0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f,
-	0x64, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61,
-	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2d,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x30, 0x01, 0x12, 0x6a, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x12, 0x25, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77,
-	0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x63, 0x6b, 0x69,
+	0x64, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20,
+	0x01, 0x52, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0xe2, 0xdf, 0x1f,
+	0x02, 0x70, 0x00, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x24, 0x0a, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x70, 0x00, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x22, 0x2e, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x13, 0x0a,
+	0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78,
+	0x49, 0x64, 0x2a, 0x47, 0x0a, 0x0e, 0x49, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x49, 0x4e, 0x56, 0x4f, 0x43, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x10, 0x00, 0x12,
+	0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x56, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x4f, 0x4b, 0x45, 0x10, 0x01, 0x2a, 0x96, 0x01, 0x0a, 0x0c,
+	0x54, 0x78, 0x57, 0x61, 0x69, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x1a,
+	0x54, 0x58, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13,
+	0x54, 0x58, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x4e,
+	0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x58, 0x5f, 0x57, 0x41, 0x49, 0x54,
+	0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x4f, 0x57, 0x4e, 0x5f, 0x4f, 0x52, 0x47, 0x10,
+	0x02, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x58, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x50, 0x4f, 0x4c,
+	0x49, 0x43, 0x59, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x58, 0x5f,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x4e, 0x5f, 0x4f, 0x46,
+	0x5f, 0x4d, 0x10, 0x04, 0x32, 0xa7, 0x06, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x59, 0x0a, 0x04, 0x45, 0x78, 0x65,
+	0x63, 0x12, 0x23, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x78, 0x65, 0x63, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14,
+	0x22, 0x0f, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2f, 0x65, 0x78, 0x65,
+	0x63, 0x3a, 0x01, 0x2a, 0x12, 0x59, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x24, 0x2e,
+	0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x5f, 0x0a, 0x06, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x12, 0x25, 0x2e, 0x63, 0x63, 0x6b, 0x69,
 	0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63,
-	0x6f, 0x64, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x13, 0x12, 0x11, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2f, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x73, 0x32, 0x84, 0x02, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f,
-	0x64, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x7e, 0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
-	0x2b, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e,
-	0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63,
+	0x6f, 0x64, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x22, 0x11, 0x2f, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2f, 0x69, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x3a, 0x01, 0x2a,
+	0x12, 0x7a, 0x0a, 0x08, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x54, 0x78, 0x12, 0x25, 0x2e, 0x63,
+	0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x76,
+	0x6f, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x19, 0x22, 0x14, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2f,
+	0x69, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x2d, 0x74, 0x78, 0x3a, 0x01, 0x2a, 0x12, 0x93, 0x01, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x21, 0x12, 0x1f, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x74, 0x78, 0x5f, 0x69,
+	0x64, 0x7d, 0x12, 0x7e, 0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x12, 0x2b, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x20,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2d, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x30, 0x01, 0x12, 0x6a, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x63,
 	0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x20, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2f,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2d, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x30, 0x01, 0x12,
-	0x6a, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x63, 0x63, 0x6b, 0x69,
+	0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x32, 0x84,
+	0x02, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7e, 0x0a, 0x0c, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x2b, 0x2e, 0x63, 0x63, 0x6b, 0x69,
 	0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63,
-	0x6f, 0x64, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1e, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x63, 0x6f, 0x64, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x32, 0xf3, 0x04, 0x0a, 0x18,
-	0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6a, 0x0a, 0x04, 0x45, 0x78, 0x65, 0x63,
-	0x12, 0x2b, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63,
-	0x6f, 0x64, 0x65, 0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x65, 0x78, 0x65,
-	0x63, 0x3a, 0x01, 0x2a, 0x12, 0x6a, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x2c, 0x2e,
-	0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68,
-	0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64,
-	0x65, 0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x12, 0x70, 0x0a, 0x06, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x12, 0x2d, 0x2e, 0x63, 0x63, 0x6b,
+	0x6f, 0x64, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x2d, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x30, 0x01, 0x12, 0x6a, 0x0a, 0x06, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x63, 0x6b,
 	0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e,
-	0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x6f,
-	0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1f, 0x22, 0x1a, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2d,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x3a,
-	0x01, 0x2a, 0x12, 0x8f, 0x01, 0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x12, 0x33, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74,
+	0x63, 0x6f, 0x64, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x13, 0x12, 0x11, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x32, 0xa8, 0x07, 0x0a, 0x18, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x6a, 0x0a, 0x04, 0x45, 0x78, 0x65, 0x63, 0x12, 0x2b, 0x2e, 0x63, 0x63, 0x6b,
+	0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x78, 0x65, 0x63,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1d, 0x22, 0x18, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2d, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x65, 0x78, 0x65, 0x63, 0x3a, 0x01, 0x2a, 0x12, 0x6a,
+	0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x2c, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12,
+	0x19, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2d, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x70, 0x0a, 0x06, 0x49, 0x6e,
+	0x76, 0x6f, 0x6b, 0x65, 0x12, 0x2d, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1a, 0x2f,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x2f, 0x69, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x8b, 0x01, 0x0a,
+	0x08, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x54, 0x78, 0x12, 0x2d, 0x2e, 0x63, 0x63, 0x6b, 0x69,
+	0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x6b,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74,
 	0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f,
-	0x64, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12,
-	0x21, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2d, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2d, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x30, 0x01, 0x12, 0x7b, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2d,
-	0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43,
-	0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x64, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x22, 0x1d, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e,
+	0x76, 0x6f, 0x6b, 0x65, 0x2d, 0x74, 0x78, 0x3a, 0x01, 0x2a, 0x12, 0xa4, 0x01, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x38, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x74, 0x78, 0x5f, 0x69, 0x64,
+	0x7d, 0x12, 0x8f, 0x01, 0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x12, 0x33, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21,
+	0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2d, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x30, 0x01, 0x12, 0x7b, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2d, 0x2e,
 	0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68,
-	0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x22, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64,
-	0x65, 0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x73, 0x32, 0xaf, 0x02, 0x0a, 0x1e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x8f, 0x01, 0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x33, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61,
-	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x63, 0x6b,
+	0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63,
+	0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x22, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x32, 0xaf, 0x02, 0x0a, 0x1e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x8f, 0x01, 0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x12, 0x33, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x63, 0x6b, 0x69,
+	0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23,
+	0x12, 0x21, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2d, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2d, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x30, 0x01, 0x12, 0x7b, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x2d, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x22,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x32, 0xfd, 0x02, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x54, 0x78, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x74, 0x0a, 0x07, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x12, 0x26, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63,
+	0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x55, 0x6e, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x22, 0x20, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x2f, 0x74, 0x78, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x3a, 0x01, 0x2a, 0x12,
+	0x77, 0x0a, 0x07, 0x45, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x12, 0x26, 0x2e, 0x63, 0x63, 0x6b,
 	0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e,
-	0x63, 0x6f, 0x64, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x23, 0x12, 0x21, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2d, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2d, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x30, 0x01, 0x12, 0x7b, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x12, 0x2d, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1e, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e,
-	0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22,
-	0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63,
-	0x6f, 0x64, 0x65, 0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x42, 0x24, 0x5a, 0x22, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x73, 0x37, 0x74, 0x65, 0x63, 0x68, 0x6c, 0x61, 0x62, 0x2f, 0x63, 0x63, 0x6b, 0x69,
-	0x74, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x63, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x2e, 0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15,
+	0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2f, 0x74, 0x78, 0x2f, 0x65, 0x6e,
+	0x64, 0x6f, 0x72, 0x73, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x78, 0x0a, 0x06, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x12, 0x25, 0x2e, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x63, 0x6b, 0x69,
+	0x74, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x14, 0x2f, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2f, 0x74, 0x78, 0x2f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x3a,
+	0x01, 0x2a, 0x42, 0x24, 0x5a, 0x22, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x73, 0x37, 0x74, 0x65, 0x63, 0x68, 0x6c, 0x61, 0x62, 0x2f, 0x63, 0x63, 0x6b, 0x69, 0x74,
+	0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1350,94 +2299,132 @@ func file_chaincode_proto_rawDescGZIP() []byte {
 	return file_chaincode_proto_rawDescData
 }
 
-var file_chaincode_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_chaincode_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_chaincode_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_chaincode_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_chaincode_proto_goTypes = []interface{}{
-	(InvocationType)(0),                          // 0: cckit.gateway.InvocationType
-	(*ChaincodeLocator)(nil),                     // 1: cckit.gateway.ChaincodeLocator
-	(*ChaincodeInput)(nil),                       // 2: cckit.gateway.ChaincodeInput
-	(*ChaincodeExecRequest)(nil),                 // 3: cckit.gateway.ChaincodeExecRequest
-	(*ChaincodeQueryRequest)(nil),                // 4: cckit.gateway.ChaincodeQueryRequest
-	(*ChaincodeInvokeRequest)(nil),               // 5: cckit.gateway.ChaincodeInvokeRequest
-	(*BlockLimit)(nil),                           // 6: cckit.gateway.BlockLimit
-	(*ChaincodeEventsStreamRequest)(nil),         // 7: cckit.gateway.ChaincodeEventsStreamRequest
-	(*ChaincodeEventsRequest)(nil),               // 8: cckit.gateway.ChaincodeEventsRequest
-	(*ChaincodeInstanceExecRequest)(nil),         // 9: cckit.gateway.ChaincodeInstanceExecRequest
-	(*ChaincodeInstanceQueryRequest)(nil),        // 10: cckit.gateway.ChaincodeInstanceQueryRequest
-	(*ChaincodeInstanceInvokeRequest)(nil),       // 11: cckit.gateway.ChaincodeInstanceInvokeRequest
-	(*ChaincodeInstanceEventsStreamRequest)(nil), // 12: cckit.gateway.ChaincodeInstanceEventsStreamRequest
-	(*ChaincodeInstanceEventsRequest)(nil),       // 13: cckit.gateway.ChaincodeInstanceEventsRequest
-	(*ChaincodeEvents)(nil),                      // 14: cckit.gateway.ChaincodeEvents
-	(*RawJson)(nil),                              // 15: cckit.gateway.RawJson
-	(*ChaincodeEvent)(nil),                       // 16: cckit.gateway.ChaincodeEvent
-	nil,                                          // 17: cckit.gateway.ChaincodeInput.TransientEntry
-	(*peer.ChaincodeEvent)(nil),                  // 18: protos.ChaincodeEvent
-	(*timestamppb.Timestamp)(nil),                // 19: google.protobuf.Timestamp
-	(*peer.Response)(nil),                        // 20: protos.Response
+	(InvocationType)(0),                               // 0: cckit.gateway.InvocationType
+	(TxWaitPolicy)(0),                                 // 1: cckit.gateway.TxWaitPolicy
+	(*ChaincodeLocator)(nil),                          // 2: cckit.gateway.ChaincodeLocator
+	(*ChaincodeInput)(nil),                            // 3: cckit.gateway.ChaincodeInput
+	(*ChaincodeExecRequest)(nil),                      // 4: cckit.gateway.ChaincodeExecRequest
+	(*ChaincodeQueryRequest)(nil),                     // 5: cckit.gateway.ChaincodeQueryRequest
+	(*ChaincodeInvokeRequest)(nil),                    // 6: cckit.gateway.ChaincodeInvokeRequest
+	(*TxWaiter)(nil),                                  // 7: cckit.gateway.TxWaiter
+	(*TransactionStatus)(nil),                         // 8: cckit.gateway.TransactionStatus
+	(*ChaincodeInvokeResponse)(nil),                   // 9: cckit.gateway.ChaincodeInvokeResponse
+	(*ChaincodeTransactionStatusRequest)(nil),         // 10: cckit.gateway.ChaincodeTransactionStatusRequest
+	(*BlockLimit)(nil),                                // 11: cckit.gateway.BlockLimit
+	(*ChaincodeEventsStreamRequest)(nil),              // 12: cckit.gateway.ChaincodeEventsStreamRequest
+	(*ChaincodeEventsRequest)(nil),                    // 13: cckit.gateway.ChaincodeEventsRequest
+	(*ChaincodeInstanceExecRequest)(nil),              // 14: cckit.gateway.ChaincodeInstanceExecRequest
+	(*ChaincodeInstanceQueryRequest)(nil),             // 15: cckit.gateway.ChaincodeInstanceQueryRequest
+	(*ChaincodeInstanceInvokeRequest)(nil),            // 16: cckit.gateway.ChaincodeInstanceInvokeRequest
+	(*ChaincodeInstanceTransactionStatusRequest)(nil), // 17: cckit.gateway.ChaincodeInstanceTransactionStatusRequest
+	(*ChaincodeInstanceEventsStreamRequest)(nil),      // 18: cckit.gateway.ChaincodeInstanceEventsStreamRequest
+	(*ChaincodeInstanceEventsRequest)(nil),            // 19: cckit.gateway.ChaincodeInstanceEventsRequest
+	(*ChaincodeEvents)(nil),                           // 20: cckit.gateway.ChaincodeEvents
+	(*RawJson)(nil),                                   // 21: cckit.gateway.RawJson
+	(*ChaincodeEvent)(nil),                            // 22: cckit.gateway.ChaincodeEvent
+	(*ChaincodeProposeRequest)(nil),                   // 23: cckit.gateway.ChaincodeProposeRequest
+	(*UnsignedProposal)(nil),                          // 24: cckit.gateway.UnsignedProposal
+	(*ChaincodeEndorseRequest)(nil),                   // 25: cckit.gateway.ChaincodeEndorseRequest
+	(*UnsignedTransaction)(nil),                       // 26: cckit.gateway.UnsignedTransaction
+	(*ChaincodeSubmitRequest)(nil),                    // 27: cckit.gateway.ChaincodeSubmitRequest
+	(*ChaincodeSubmitResponse)(nil),                   // 28: cckit.gateway.ChaincodeSubmitResponse
+	nil,                                               // 29: cckit.gateway.ChaincodeInput.TransientEntry
+	(*timestamppb.Timestamp)(nil),                     // 30: google.protobuf.Timestamp
+	(*peer.Response)(nil),                             // 31: protos.Response
+	(*peer.ChaincodeEvent)(nil),                       // 32: protos.ChaincodeEvent
 }
 var file_chaincode_proto_depIdxs = []int32{
-	17, // 0: cckit.gateway.ChaincodeInput.transient:type_name -> cckit.gateway.ChaincodeInput.TransientEntry
-	1,  // 1: cckit.gateway.ChaincodeExecRequest.locator:type_name -> cckit.gateway.ChaincodeLocator
+	29, // 0: cckit.gateway.ChaincodeInput.transient:type_name -> cckit.gateway.ChaincodeInput.TransientEntry
+	2,  // 1: cckit.gateway.ChaincodeExecRequest.locator:type_name -> cckit.gateway.ChaincodeLocator
 	0,  // 2: cckit.gateway.ChaincodeExecRequest.type:type_name -> cckit.gateway.InvocationType
-	2,  // 3: cckit.gateway.ChaincodeExecRequest.input:type_name -> cckit.gateway.ChaincodeInput
-	1,  // 4: cckit.gateway.ChaincodeQueryRequest.locator:type_name -> cckit.gateway.ChaincodeLocator
-	2,  // 5: cckit.gateway.ChaincodeQueryRequest.input:type_name -> cckit.gateway.ChaincodeInput
-	1,  // 6: cckit.gateway.ChaincodeInvokeRequest.locator:type_name -> cckit.gateway.ChaincodeLocator
-	2,  // 7: cckit.gateway.ChaincodeInvokeRequest.input:type_name -> cckit.gateway.ChaincodeInput
-	1,  // 8: cckit.gateway.ChaincodeEventsStreamRequest.locator:type_name -> cckit.gateway.ChaincodeLocator
-	6,  // 9: cckit.gateway.ChaincodeEventsStreamRequest.from_block:type_name -> cckit.gateway.BlockLimit
-	6,  // 10: cckit.gateway.ChaincodeEventsStreamRequest.to_block:type_name -> cckit.gateway.BlockLimit
-	1,  // 11: cckit.gateway.ChaincodeEventsRequest.locator:type_name -> cckit.gateway.ChaincodeLocator
-	6,  // 12: cckit.gateway.ChaincodeEventsRequest.from_block:type_name -> cckit.gateway.BlockLimit
-	6,  // 13: cckit.gateway.ChaincodeEventsRequest.to_block:type_name -> cckit.gateway.BlockLimit
-	0,  // 14: cckit.gateway.ChaincodeInstanceExecRequest.type:type_name -> cckit.gateway.InvocationType
-	2,  // 15: cckit.gateway.ChaincodeInstanceExecRequest.input:type_name -> cckit.gateway.ChaincodeInput
-	2,  // 16: cckit.gateway.ChaincodeInstanceQueryRequest.input:type_name -> cckit.gateway.ChaincodeInput
-	2,  // 17: cckit.gateway.ChaincodeInstanceInvokeRequest.input:type_name -> cckit.gateway.ChaincodeInput
-	6,  // 18: cckit.gateway.ChaincodeInstanceEventsStreamRequest.from_block:type_name -> cckit.gateway.BlockLimit
-	6,  // 19: cckit.gateway.ChaincodeInstanceEventsStreamRequest.to_block:type_name -> cckit.gateway.BlockLimit
-	6,  // 20: cckit.gateway.ChaincodeInstanceEventsRequest.from_block:type_name -> cckit.gateway.BlockLimit
-	6,  // 21: cckit.gateway.ChaincodeInstanceEventsRequest.to_block:type_name -> cckit.gateway.BlockLimit
-	1,  // 22: cckit.gateway.ChaincodeEvents.locator:type_name -> cckit.gateway.ChaincodeLocator
-	6,  // 23: cckit.gateway.ChaincodeEvents.from_block:type_name -> cckit.gateway.BlockLimit
-	6,  // 24: cckit.gateway.ChaincodeEvents.to_block:type_name -> cckit.gateway.BlockLimit
-	16, // 25: cckit.gateway.ChaincodeEvents.items:type_name -> cckit.gateway.ChaincodeEvent
-	18, // 26: cckit.gateway.ChaincodeEvent.event:type_name -> protos.ChaincodeEvent
-	19, // 27: cckit.gateway.ChaincodeEvent.tx_timestamp:type_name -> google.protobuf.Timestamp
-	15, // 28: cckit.gateway.ChaincodeEvent.payload:type_name -> cckit.gateway.RawJson
-	3,  // 29: cckit.gateway.ChaincodeService.Exec:input_type -> cckit.gateway.ChaincodeExecRequest
-	4,  // 30: cckit.gateway.ChaincodeService.Query:input_type -> cckit.gateway.ChaincodeQueryRequest
-	5,  // 31: cckit.gateway.ChaincodeService.Invoke:input_type -> cckit.gateway.ChaincodeInvokeRequest
-	7,  // 32: cckit.gateway.ChaincodeService.EventsStream:input_type -> cckit.gateway.ChaincodeEventsStreamRequest
-	8,  // 33: cckit.gateway.ChaincodeService.Events:input_type -> cckit.gateway.ChaincodeEventsRequest
-	7,  // 34: cckit.gateway.ChaincodeEventsService.EventsStream:input_type -> cckit.gateway.ChaincodeEventsStreamRequest
-	8,  // 35: cckit.gateway.ChaincodeEventsService.Events:input_type -> cckit.gateway.ChaincodeEventsRequest
-	9,  // 36: cckit.gateway.ChaincodeInstanceService.Exec:input_type -> cckit.gateway.ChaincodeInstanceExecRequest
-	10, // 37: cckit.gateway.ChaincodeInstanceService.Query:input_type -> cckit.gateway.ChaincodeInstanceQueryRequest
-	11, // 38: cckit.gateway.ChaincodeInstanceService.Invoke:input_type -> cckit.gateway.ChaincodeInstanceInvokeRequest
-	12, // 39: cckit.gateway.ChaincodeInstanceService.EventsStream:input_type -> cckit.gateway.ChaincodeInstanceEventsStreamRequest
-	13, // 40: cckit.gateway.ChaincodeInstanceService.Events:input_type -> cckit.gateway.ChaincodeInstanceEventsRequest
-	12, // 41: cckit.gateway.ChaincodeInstanceEventsService.EventsStream:input_type -> cckit.gateway.ChaincodeInstanceEventsStreamRequest
-	13, // 42: cckit.gateway.ChaincodeInstanceEventsService.Events:input_type -> cckit.gateway.ChaincodeInstanceEventsRequest
-	20, // 43: cckit.gateway.ChaincodeService.Exec:output_type -> protos.Response
-	20, // 44: cckit.gateway.ChaincodeService.Query:output_type -> protos.Response
-	20, // 45: cckit.gateway.ChaincodeService.Invoke:output_type -> protos.Response
-	16, // 46: cckit.gateway.ChaincodeService.EventsStream:output_type -> cckit.gateway.ChaincodeEvent
-	14, // 47: cckit.gateway.ChaincodeService.Events:output_type -> cckit.gateway.ChaincodeEvents
-	16, // 48: cckit.gateway.ChaincodeEventsService.EventsStream:output_type -> cckit.gateway.ChaincodeEvent
-	14, // 49: cckit.gateway.ChaincodeEventsService.Events:output_type -> cckit.gateway.ChaincodeEvents
-	20, // 50: cckit.gateway.ChaincodeInstanceService.Exec:output_type -> protos.Response
-	20, // 51: cckit.gateway.ChaincodeInstanceService.Query:output_type -> protos.Response
-	20, // 52: cckit.gateway.ChaincodeInstanceService.Invoke:output_type -> protos.Response
-	16, // 53: cckit.gateway.ChaincodeInstanceService.EventsStream:output_type -> cckit.gateway.ChaincodeEvent
-	14, // 54: cckit.gateway.ChaincodeInstanceService.Events:output_type -> cckit.gateway.ChaincodeEvents
-	16, // 55: cckit.gateway.ChaincodeInstanceEventsService.EventsStream:output_type -> cckit.gateway.ChaincodeEvent
-	14, // 56: cckit.gateway.ChaincodeInstanceEventsService.Events:output_type -> cckit.gateway.ChaincodeEvents
-	43, // [43:57] is the sub-list for method output_type
-	29, // [29:43] is the sub-list for method input_type
-	29, // [29:29] is the sub-list for extension type_name
-	29, // [29:29] is the sub-list for extension extendee
-	0,  // [0:29] is the sub-list for field type_name
+	3,  // 3: cckit.gateway.ChaincodeExecRequest.input:type_name -> cckit.gateway.ChaincodeInput
+	2,  // 4: cckit.gateway.ChaincodeQueryRequest.locator:type_name -> cckit.gateway.ChaincodeLocator
+	3,  // 5: cckit.gateway.ChaincodeQueryRequest.input:type_name -> cckit.gateway.ChaincodeInput
+	2,  // 6: cckit.gateway.ChaincodeInvokeRequest.locator:type_name -> cckit.gateway.ChaincodeLocator
+	3,  // 7: cckit.gateway.ChaincodeInvokeRequest.input:type_name -> cckit.gateway.ChaincodeInput
+	7,  // 8: cckit.gateway.ChaincodeInvokeRequest.tx_waiter:type_name -> cckit.gateway.TxWaiter
+	1,  // 9: cckit.gateway.TxWaiter.policy:type_name -> cckit.gateway.TxWaitPolicy
+	30, // 10: cckit.gateway.TransactionStatus.timestamp:type_name -> google.protobuf.Timestamp
+	31, // 11: cckit.gateway.ChaincodeInvokeResponse.response:type_name -> protos.Response
+	8,  // 12: cckit.gateway.ChaincodeInvokeResponse.tx:type_name -> cckit.gateway.TransactionStatus
+	2,  // 13: cckit.gateway.ChaincodeTransactionStatusRequest.locator:type_name -> cckit.gateway.ChaincodeLocator
+	2,  // 14: cckit.gateway.ChaincodeEventsStreamRequest.locator:type_name -> cckit.gateway.ChaincodeLocator
+	11, // 15: cckit.gateway.ChaincodeEventsStreamRequest.from_block:type_name -> cckit.gateway.BlockLimit
+	11, // 16: cckit.gateway.ChaincodeEventsStreamRequest.to_block:type_name -> cckit.gateway.BlockLimit
+	2,  // 17: cckit.gateway.ChaincodeEventsRequest.locator:type_name -> cckit.gateway.ChaincodeLocator
+	11, // 18: cckit.gateway.ChaincodeEventsRequest.from_block:type_name -> cckit.gateway.BlockLimit
+	11, // 19: cckit.gateway.ChaincodeEventsRequest.to_block:type_name -> cckit.gateway.BlockLimit
+	0,  // 20: cckit.gateway.ChaincodeInstanceExecRequest.type:type_name -> cckit.gateway.InvocationType
+	3,  // 21: cckit.gateway.ChaincodeInstanceExecRequest.input:type_name -> cckit.gateway.ChaincodeInput
+	3,  // 22: cckit.gateway.ChaincodeInstanceQueryRequest.input:type_name -> cckit.gateway.ChaincodeInput
+	3,  // 23: cckit.gateway.ChaincodeInstanceInvokeRequest.input:type_name -> cckit.gateway.ChaincodeInput
+	7,  // 24: cckit.gateway.ChaincodeInstanceInvokeRequest.tx_waiter:type_name -> cckit.gateway.TxWaiter
+	11, // 25: cckit.gateway.ChaincodeInstanceEventsStreamRequest.from_block:type_name -> cckit.gateway.BlockLimit
+	11, // 26: cckit.gateway.ChaincodeInstanceEventsStreamRequest.to_block:type_name -> cckit.gateway.BlockLimit
+	11, // 27: cckit.gateway.ChaincodeInstanceEventsRequest.from_block:type_name -> cckit.gateway.BlockLimit
+	11, // 28: cckit.gateway.ChaincodeInstanceEventsRequest.to_block:type_name -> cckit.gateway.BlockLimit
+	2,  // 29: cckit.gateway.ChaincodeEvents.locator:type_name -> cckit.gateway.ChaincodeLocator
+	11, // 30: cckit.gateway.ChaincodeEvents.from_block:type_name -> cckit.gateway.BlockLimit
+	11, // 31: cckit.gateway.ChaincodeEvents.to_block:type_name -> cckit.gateway.BlockLimit
+	22, // 32: cckit.gateway.ChaincodeEvents.items:type_name -> cckit.gateway.ChaincodeEvent
+	32, // 33: cckit.gateway.ChaincodeEvent.event:type_name -> protos.ChaincodeEvent
+	30, // 34: cckit.gateway.ChaincodeEvent.tx_timestamp:type_name -> google.protobuf.Timestamp
+	21, // 35: cckit.gateway.ChaincodeEvent.payload:type_name -> cckit.gateway.RawJson
+	2,  // 36: cckit.gateway.ChaincodeProposeRequest.locator:type_name -> cckit.gateway.ChaincodeLocator
+	3,  // 37: cckit.gateway.ChaincodeProposeRequest.input:type_name -> cckit.gateway.ChaincodeInput
+	2,  // 38: cckit.gateway.ChaincodeEndorseRequest.locator:type_name -> cckit.gateway.ChaincodeLocator
+	31, // 39: cckit.gateway.UnsignedTransaction.response:type_name -> protos.Response
+	2,  // 40: cckit.gateway.ChaincodeSubmitRequest.locator:type_name -> cckit.gateway.ChaincodeLocator
+	4,  // 41: cckit.gateway.ChaincodeService.Exec:input_type -> cckit.gateway.ChaincodeExecRequest
+	5,  // 42: cckit.gateway.ChaincodeService.Query:input_type -> cckit.gateway.ChaincodeQueryRequest
+	6,  // 43: cckit.gateway.ChaincodeService.Invoke:input_type -> cckit.gateway.ChaincodeInvokeRequest
+	6,  // 44: cckit.gateway.ChaincodeService.InvokeTx:input_type -> cckit.gateway.ChaincodeInvokeRequest
+	10, // 45: cckit.gateway.ChaincodeService.GetTransactionStatus:input_type -> cckit.gateway.ChaincodeTransactionStatusRequest
+	12, // 46: cckit.gateway.ChaincodeService.EventsStream:input_type -> cckit.gateway.ChaincodeEventsStreamRequest
+	13, // 47: cckit.gateway.ChaincodeService.Events:input_type -> cckit.gateway.ChaincodeEventsRequest
+	12, // 48: cckit.gateway.ChaincodeEventsService.EventsStream:input_type -> cckit.gateway.ChaincodeEventsStreamRequest
+	13, // 49: cckit.gateway.ChaincodeEventsService.Events:input_type -> cckit.gateway.ChaincodeEventsRequest
+	14, // 50: cckit.gateway.ChaincodeInstanceService.Exec:input_type -> cckit.gateway.ChaincodeInstanceExecRequest
+	15, // 51: cckit.gateway.ChaincodeInstanceService.Query:input_type -> cckit.gateway.ChaincodeInstanceQueryRequest
+	16, // 52: cckit.gateway.ChaincodeInstanceService.Invoke:input_type -> cckit.gateway.ChaincodeInstanceInvokeRequest
+	16, // 53: cckit.gateway.ChaincodeInstanceService.InvokeTx:input_type -> cckit.gateway.ChaincodeInstanceInvokeRequest
+	17, // 54: cckit.gateway.ChaincodeInstanceService.GetTransactionStatus:input_type -> cckit.gateway.ChaincodeInstanceTransactionStatusRequest
+	18, // 55: cckit.gateway.ChaincodeInstanceService.EventsStream:input_type -> cckit.gateway.ChaincodeInstanceEventsStreamRequest
+	19, // 56: cckit.gateway.ChaincodeInstanceService.Events:input_type -> cckit.gateway.ChaincodeInstanceEventsRequest
+	18, // 57: cckit.gateway.ChaincodeInstanceEventsService.EventsStream:input_type -> cckit.gateway.ChaincodeInstanceEventsStreamRequest
+	19, // 58: cckit.gateway.ChaincodeInstanceEventsService.Events:input_type -> cckit.gateway.ChaincodeInstanceEventsRequest
+	23, // 59: cckit.gateway.ChaincodeTxService.Propose:input_type -> cckit.gateway.ChaincodeProposeRequest
+	25, // 60: cckit.gateway.ChaincodeTxService.Endorse:input_type -> cckit.gateway.ChaincodeEndorseRequest
+	27, // 61: cckit.gateway.ChaincodeTxService.Submit:input_type -> cckit.gateway.ChaincodeSubmitRequest
+	31, // 62: cckit.gateway.ChaincodeService.Exec:output_type -> protos.Response
+	31, // 63: cckit.gateway.ChaincodeService.Query:output_type -> protos.Response
+	31, // 64: cckit.gateway.ChaincodeService.Invoke:output_type -> protos.Response
+	9,  // 65: cckit.gateway.ChaincodeService.InvokeTx:output_type -> cckit.gateway.ChaincodeInvokeResponse
+	8,  // 66: cckit.gateway.ChaincodeService.GetTransactionStatus:output_type -> cckit.gateway.TransactionStatus
+	22, // 67: cckit.gateway.ChaincodeService.EventsStream:output_type -> cckit.gateway.ChaincodeEvent
+	20, // 68: cckit.gateway.ChaincodeService.Events:output_type -> cckit.gateway.ChaincodeEvents
+	22, // 69: cckit.gateway.ChaincodeEventsService.EventsStream:output_type -> cckit.gateway.ChaincodeEvent
+	20, // 70: cckit.gateway.ChaincodeEventsService.Events:output_type -> cckit.gateway.ChaincodeEvents
+	31, // 71: cckit.gateway.ChaincodeInstanceService.Exec:output_type -> protos.Response
+	31, // 72: cckit.gateway.ChaincodeInstanceService.Query:output_type -> protos.Response
+	31, // 73: cckit.gateway.ChaincodeInstanceService.Invoke:output_type -> protos.Response
+	9,  // 74: cckit.gateway.ChaincodeInstanceService.InvokeTx:output_type -> cckit.gateway.ChaincodeInvokeResponse
+	8,  // 75: cckit.gateway.ChaincodeInstanceService.GetTransactionStatus:output_type -> cckit.gateway.TransactionStatus
+	22, // 76: cckit.gateway.ChaincodeInstanceService.EventsStream:output_type -> cckit.gateway.ChaincodeEvent
+	20, // 77: cckit.gateway.ChaincodeInstanceService.Events:output_type -> cckit.gateway.ChaincodeEvents
+	22, // 78: cckit.gateway.ChaincodeInstanceEventsService.EventsStream:output_type -> cckit.gateway.ChaincodeEvent
+	20, // 79: cckit.gateway.ChaincodeInstanceEventsService.Events:output_type -> cckit.gateway.ChaincodeEvents
+	24, // 80: cckit.gateway.ChaincodeTxService.Propose:output_type -> cckit.gateway.UnsignedProposal
+	26, // 81: cckit.gateway.ChaincodeTxService.Endorse:output_type -> cckit.gateway.UnsignedTransaction
+	28, // 82: cckit.gateway.ChaincodeTxService.Submit:output_type -> cckit.gateway.ChaincodeSubmitResponse
+	62, // [62:83] is the sub-list for method output_type
+	41, // [41:62] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
 }
 
 func init() { file_chaincode_proto_init() }
@@ -1458,8 +2445,104 @@ func file_chaincode_proto_init() {
 				return nil
 			}
 		}
-		file_chaincode_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChaincodeInput); i {
+		file_chaincode_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChaincodeInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaincode_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChaincodeExecRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaincode_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChaincodeQueryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaincode_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChaincodeInvokeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaincode_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxWaiter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaincode_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaincode_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChaincodeInvokeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaincode_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChaincodeTransactionStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaincode_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockLimit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1470,8 +2553,8 @@ func file_chaincode_proto_init() {
 				return nil
 			}
 		}
-		file_chaincode_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChaincodeExecRequest); i {
+		file_chaincode_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChaincodeEventsStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1482,8 +2565,8 @@ func file_chaincode_proto_init() {
 				return nil
 			}
 		}
-		file_chaincode_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChaincodeQueryRequest); i {
+		file_chaincode_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChaincodeEventsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1494,8 +2577,8 @@ func file_chaincode_proto_init() {
 				return nil
 			}
 		}
-		file_chaincode_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChaincodeInvokeRequest); i {
+		file_chaincode_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChaincodeInstanceExecRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1506,8 +2589,8 @@ func file_chaincode_proto_init() {
 				return nil
 			}
 		}
-		file_chaincode_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockLimit); i {
+		file_chaincode_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChaincodeInstanceQueryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1518,8 +2601,8 @@ func file_chaincode_proto_init() {
 				return nil
 			}
 		}
-		file_chaincode_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChaincodeEventsStreamRequest); i {
+		file_chaincode_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChaincodeInstanceInvokeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1530,8 +2613,8 @@ func file_chaincode_proto_init() {
 				return nil
 			}
 		}
-		file_chaincode_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChaincodeEventsRequest); i {
+		file_chaincode_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChaincodeInstanceTransactionStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1542,8 +2625,8 @@ func file_chaincode_proto_init() {
 				return nil
 			}
 		}
-		file_chaincode_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChaincodeInstanceExecRequest); i {
+		file_chaincode_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChaincodeInstanceEventsStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1554,8 +2637,8 @@ func file_chaincode_proto_init() {
 				return nil
 			}
 		}
-		file_chaincode_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChaincodeInstanceQueryRequest); i {
+		file_chaincode_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChaincodeInstanceEventsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1566,8 +2649,8 @@ func file_chaincode_proto_init() {
 				return nil
 			}
 		}
-		file_chaincode_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChaincodeInstanceInvokeRequest); i {
+		file_chaincode_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChaincodeEvents); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1578,8 +2661,8 @@ func file_chaincode_proto_init() {
 				return nil
 			}
 		}
-		file_chaincode_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChaincodeInstanceEventsStreamRequest); i {
+		file_chaincode_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RawJson); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1590,8 +2673,8 @@ func file_chaincode_proto_init() {
 				return nil
 			}
 		}
-		file_chaincode_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChaincodeInstanceEventsRequest); i {
+		file_chaincode_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChaincodeEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1602,8 +2685,8 @@ func file_chaincode_proto_init() {
 				return nil
 			}
 		}
-		file_chaincode_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChaincodeEvents); i {
+		file_chaincode_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChaincodeProposeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1614,8 +2697,8 @@ func file_chaincode_proto_init() {
 				return nil
 			}
 		}
-		file_chaincode_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RawJson); i {
+		file_chaincode_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsignedProposal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1626,8 +2709,44 @@ func file_chaincode_proto_init() {
 				return nil
 			}
 		}
-		file_chaincode_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChaincodeEvent); i {
+		file_chaincode_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChaincodeEndorseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaincode_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsignedTransaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaincode_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChaincodeSubmitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaincode_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChaincodeSubmitResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1644,10 +2763,10 @@ func file_chaincode_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_chaincode_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   17,
+			NumEnums:      2,
+			NumMessages:   28,
 			NumExtensions: 0,
-			NumServices:   4,
+			NumServices:   5,
 		},
 		GoTypes:           file_chaincode_proto_goTypes,
 		DependencyIndexes: file_chaincode_proto_depIdxs,
@@ -1678,6 +2797,10 @@ type ChaincodeServiceClient interface {
 	Query(ctx context.Context, in *ChaincodeQueryRequest, opts ...grpc.CallOption) (*peer.Response, error)
 	// Invoke chaincode on peers, according to endorsement policy and the SEND to orderer
 	Invoke(ctx context.Context, in *ChaincodeInvokeRequest, opts ...grpc.CallOption) (*peer.Response, error)
+	// Invoke chaincode on peers, returns chaincode response with transaction commit status
+	InvokeTx(ctx context.Context, in *ChaincodeInvokeRequest, opts ...grpc.CallOption) (*ChaincodeInvokeResponse, error)
+	// Transaction commit status: block number, validation code and timestamp
+	GetTransactionStatus(ctx context.Context, in *ChaincodeTransactionStatusRequest, opts ...grpc.CallOption) (*TransactionStatus, error)
 	// Chaincode events stream
 	EventsStream(ctx context.Context, in *ChaincodeEventsStreamRequest, opts ...grpc.CallOption) (ChaincodeService_EventsStreamClient, error)
 	// Chaincode events
@@ -1719,6 +2842,24 @@ func (c *chaincodeServiceClient) Invoke(ctx context.Context, in *ChaincodeInvoke
 	return out, nil
 }
 
+func (c *chaincodeServiceClient) InvokeTx(ctx context.Context, in *ChaincodeInvokeRequest, opts ...grpc.CallOption) (*ChaincodeInvokeResponse, error) {
+	out := new(ChaincodeInvokeResponse)
+	err := c.cc.Invoke(ctx, "/cckit.gateway.ChaincodeService/InvokeTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaincodeServiceClient) GetTransactionStatus(ctx context.Context, in *ChaincodeTransactionStatusRequest, opts ...grpc.CallOption) (*TransactionStatus, error) {
+	out := new(TransactionStatus)
+	err := c.cc.Invoke(ctx, "/cckit.gateway.ChaincodeService/GetTransactionStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chaincodeServiceClient) EventsStream(ctx context.Context, in *ChaincodeEventsStreamRequest, opts ...grpc.CallOption) (ChaincodeService_EventsStreamClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_ChaincodeService_serviceDesc.Streams[0], "/cckit.gateway.ChaincodeService/EventsStream", opts...)
 	if err != nil {
@@ -1768,6 +2909,10 @@ type ChaincodeServiceServer interface {
 	Query(context.Context, *ChaincodeQueryRequest) (*peer.Response, error)
 	// Invoke chaincode on peers, according to endorsement policy and the SEND to orderer
 	Invoke(context.Context, *ChaincodeInvokeRequest) (*peer.Response, error)
+	// Invoke chaincode on peers, returns chaincode response with transaction commit status
+	InvokeTx(context.Context, *ChaincodeInvokeRequest) (*ChaincodeInvokeResponse, error)
+	// Transaction commit status: block number, validation code and timestamp
+	GetTransactionStatus(context.Context, *ChaincodeTransactionStatusRequest) (*TransactionStatus, error)
 	// Chaincode events stream
 	EventsStream(*ChaincodeEventsStreamRequest, ChaincodeService_EventsStreamServer) error
 	// Chaincode events
@@ -1787,6 +2932,12 @@ func (*UnimplementedChaincodeServiceServer) Query(context.Context, *ChaincodeQue
 func (*UnimplementedChaincodeServiceServer) Invoke(context.Context, *ChaincodeInvokeRequest) (*peer.Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Invoke not implemented")
 }
+func (*UnimplementedChaincodeServiceServer) InvokeTx(context.Context, *ChaincodeInvokeRequest) (*ChaincodeInvokeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvokeTx not implemented")
+}
+func (*UnimplementedChaincodeServiceServer) GetTransactionStatus(context.Context, *ChaincodeTransactionStatusRequest) (*TransactionStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionStatus not implemented")
+}
 func (*UnimplementedChaincodeServiceServer) EventsStream(*ChaincodeEventsStreamRequest, ChaincodeService_EventsStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method EventsStream not implemented")
 }
@@ -1852,6 +3003,42 @@ func _ChaincodeService_Invoke_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChaincodeService_InvokeTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChaincodeInvokeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaincodeServiceServer).InvokeTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cckit.gateway.ChaincodeService/InvokeTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaincodeServiceServer).InvokeTx(ctx, req.(*ChaincodeInvokeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaincodeService_GetTransactionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChaincodeTransactionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaincodeServiceServer).GetTransactionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cckit.gateway.ChaincodeService/GetTransactionStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaincodeServiceServer).GetTransactionStatus(ctx, req.(*ChaincodeTransactionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ChaincodeService_EventsStream_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(ChaincodeEventsStreamRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1907,6 +3094,14 @@ var _ChaincodeService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Invoke",
 			Handler:    _ChaincodeService_Invoke_Handler,
 		},
+		{
+			MethodName: "InvokeTx",
+			Handler:    _ChaincodeService_InvokeTx_Handler,
+		},
+		{
+			MethodName: "GetTransactionStatus",
+			Handler:    _ChaincodeService_GetTransactionStatus_Handler,
+		},
 		{
 			MethodName: "Events",
 			Handler:    _ChaincodeService_Events_Handler,
@@ -2072,6 +3267,10 @@ type ChaincodeInstanceServiceClient interface {
 	Query(ctx context.Context, in *ChaincodeInstanceQueryRequest, opts ...grpc.CallOption) (*peer.Response, error)
 	// Invoke chaincode on peers, according to endorsement policy and the SEND to orderer
 	Invoke(ctx context.Context, in *ChaincodeInstanceInvokeRequest, opts ...grpc.CallOption) (*peer.Response, error)
+	// Invoke chaincode on peers, returns chaincode response with transaction commit status
+	InvokeTx(ctx context.Context, in *ChaincodeInstanceInvokeRequest, opts ...grpc.CallOption) (*ChaincodeInvokeResponse, error)
+	// Transaction commit status: block number, validation code and timestamp
+	GetTransactionStatus(ctx context.Context, in *ChaincodeInstanceTransactionStatusRequest, opts ...grpc.CallOption) (*TransactionStatus, error)
 	// Chaincode events stream
 	EventsStream(ctx context.Context, in *ChaincodeInstanceEventsStreamRequest, opts ...grpc.CallOption) (ChaincodeInstanceService_EventsStreamClient, error)
 	// Chaincode events
@@ -2113,6 +3312,24 @@ func (c *chaincodeInstanceServiceClient) Invoke(ctx context.Context, in *Chainco
 	return out, nil
 }
 
+func (c *chaincodeInstanceServiceClient) InvokeTx(ctx context.Context, in *ChaincodeInstanceInvokeRequest, opts ...grpc.CallOption) (*ChaincodeInvokeResponse, error) {
+	out := new(ChaincodeInvokeResponse)
+	err := c.cc.Invoke(ctx, "/cckit.gateway.ChaincodeInstanceService/InvokeTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaincodeInstanceServiceClient) GetTransactionStatus(ctx context.Context, in *ChaincodeInstanceTransactionStatusRequest, opts ...grpc.CallOption) (*TransactionStatus, error) {
+	out := new(TransactionStatus)
+	err := c.cc.Invoke(ctx, "/cckit.gateway.ChaincodeInstanceService/GetTransactionStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chaincodeInstanceServiceClient) EventsStream(ctx context.Context, in *ChaincodeInstanceEventsStreamRequest, opts ...grpc.CallOption) (ChaincodeInstanceService_EventsStreamClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_ChaincodeInstanceService_serviceDesc.Streams[0], "/cckit.gateway.ChaincodeInstanceService/EventsStream", opts...)
 	if err != nil {
@@ -2162,6 +3379,10 @@ type ChaincodeInstanceServiceServer interface {
 	Query(context.Context, *ChaincodeInstanceQueryRequest) (*peer.Response, error)
 	// Invoke chaincode on peers, according to endorsement policy and the SEND to orderer
 	Invoke(context.Context, *ChaincodeInstanceInvokeRequest) (*peer.Response, error)
+	// Invoke chaincode on peers, returns chaincode response with transaction commit status
+	InvokeTx(context.Context, *ChaincodeInstanceInvokeRequest) (*ChaincodeInvokeResponse, error)
+	// Transaction commit status: block number, validation code and timestamp
+	GetTransactionStatus(context.Context, *ChaincodeInstanceTransactionStatusRequest) (*TransactionStatus, error)
 	// Chaincode events stream
 	EventsStream(*ChaincodeInstanceEventsStreamRequest, ChaincodeInstanceService_EventsStreamServer) error
 	// Chaincode events
@@ -2181,6 +3402,12 @@ func (*UnimplementedChaincodeInstanceServiceServer) Query(context.Context, *Chai
 func (*UnimplementedChaincodeInstanceServiceServer) Invoke(context.Context, *ChaincodeInstanceInvokeRequest) (*peer.Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Invoke not implemented")
 }
+func (*UnimplementedChaincodeInstanceServiceServer) InvokeTx(context.Context, *ChaincodeInstanceInvokeRequest) (*ChaincodeInvokeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvokeTx not implemented")
+}
+func (*UnimplementedChaincodeInstanceServiceServer) GetTransactionStatus(context.Context, *ChaincodeInstanceTransactionStatusRequest) (*TransactionStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionStatus not implemented")
+}
 func (*UnimplementedChaincodeInstanceServiceServer) EventsStream(*ChaincodeInstanceEventsStreamRequest, ChaincodeInstanceService_EventsStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method EventsStream not implemented")
 }
@@ -2246,6 +3473,42 @@ func _ChaincodeInstanceService_Invoke_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChaincodeInstanceService_InvokeTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChaincodeInstanceInvokeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaincodeInstanceServiceServer).InvokeTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cckit.gateway.ChaincodeInstanceService/InvokeTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaincodeInstanceServiceServer).InvokeTx(ctx, req.(*ChaincodeInstanceInvokeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaincodeInstanceService_GetTransactionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChaincodeInstanceTransactionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaincodeInstanceServiceServer).GetTransactionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cckit.gateway.ChaincodeInstanceService/GetTransactionStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaincodeInstanceServiceServer).GetTransactionStatus(ctx, req.(*ChaincodeInstanceTransactionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ChaincodeInstanceService_EventsStream_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(ChaincodeInstanceEventsStreamRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -2301,6 +3564,14 @@ var _ChaincodeInstanceService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Invoke",
 			Handler:    _ChaincodeInstanceService_Invoke_Handler,
 		},
+		{
+			MethodName: "InvokeTx",
+			Handler:    _ChaincodeInstanceService_InvokeTx_Handler,
+		},
+		{
+			MethodName: "GetTransactionStatus",
+			Handler:    _ChaincodeInstanceService_GetTransactionStatus_Handler,
+		},
 		{
 			MethodName: "Events",
 			Handler:    _ChaincodeInstanceService_Events_Handler,
@@ -2455,3 +3726,153 @@ var _ChaincodeInstanceEventsService_serviceDesc = grpc.ServiceDesc{
 	},
 	Metadata: "chaincode.proto",
 }
+
+// ChaincodeTxServiceClient is the client API for ChaincodeTxService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ChaincodeTxServiceClient interface {
+	// Propose creates unsigned proposal of chaincode invocation for creator
+	Propose(ctx context.Context, in *ChaincodeProposeRequest, opts ...grpc.CallOption) (*UnsignedProposal, error)
+	// Endorse sends proposal, signed by creator, to endorsing peers and creates unsigned transaction
+	Endorse(ctx context.Context, in *ChaincodeEndorseRequest, opts ...grpc.CallOption) (*UnsignedTransaction, error)
+	// Submit sends transaction, signed by creator, to orderer
+	Submit(ctx context.Context, in *ChaincodeSubmitRequest, opts ...grpc.CallOption) (*ChaincodeSubmitResponse, error)
+}
+
+type chaincodeTxServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewChaincodeTxServiceClient(cc grpc.ClientConnInterface) ChaincodeTxServiceClient {
+	return &chaincodeTxServiceClient{cc}
+}
+
+func (c *chaincodeTxServiceClient) Propose(ctx context.Context, in *ChaincodeProposeRequest, opts ...grpc.CallOption) (*UnsignedProposal, error) {
+	out := new(UnsignedProposal)
+	err := c.cc.Invoke(ctx, "/cckit.gateway.ChaincodeTxService/Propose", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaincodeTxServiceClient) Endorse(ctx context.Context, in *ChaincodeEndorseRequest, opts ...grpc.CallOption) (*UnsignedTransaction, error) {
+	out := new(UnsignedTransaction)
+	err := c.cc.Invoke(ctx, "/cckit.gateway.ChaincodeTxService/Endorse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaincodeTxServiceClient) Submit(ctx context.Context, in *ChaincodeSubmitRequest, opts ...grpc.CallOption) (*ChaincodeSubmitResponse, error) {
+	out := new(ChaincodeSubmitResponse)
+	err := c.cc.Invoke(ctx, "/cckit.gateway.ChaincodeTxService/Submit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ChaincodeTxServiceServer is the server API for ChaincodeTxService service.
+type ChaincodeTxServiceServer interface {
+	// Propose creates unsigned proposal of chaincode invocation for creator
+	Propose(context.Context, *ChaincodeProposeRequest) (*UnsignedProposal, error)
+	// Endorse sends proposal, signed by creator, to endorsing peers and creates unsigned transaction
+	Endorse(context.Context, *ChaincodeEndorseRequest) (*UnsignedTransaction, error)
+	// Submit sends transaction, signed by creator, to orderer
+	Submit(context.Context, *ChaincodeSubmitRequest) (*ChaincodeSubmitResponse, error)
+}
+
+// UnimplementedChaincodeTxServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedChaincodeTxServiceServer struct {
+}
+
+func (*UnimplementedChaincodeTxServiceServer) Propose(context.Context, *ChaincodeProposeRequest) (*UnsignedProposal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Propose not implemented")
+}
+func (*UnimplementedChaincodeTxServiceServer) Endorse(context.Context, *ChaincodeEndorseRequest) (*UnsignedTransaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Endorse not implemented")
+}
+func (*UnimplementedChaincodeTxServiceServer) Submit(context.Context, *ChaincodeSubmitRequest) (*ChaincodeSubmitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Submit not implemented")
+}
+
+func RegisterChaincodeTxServiceServer(s *grpc.Server, srv ChaincodeTxServiceServer) {
+	s.RegisterService(&_ChaincodeTxService_serviceDesc, srv)
+}
+
+func _ChaincodeTxService_Propose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChaincodeProposeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaincodeTxServiceServer).Propose(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cckit.gateway.ChaincodeTxService/Propose",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaincodeTxServiceServer).Propose(ctx, req.(*ChaincodeProposeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaincodeTxService_Endorse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChaincodeEndorseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaincodeTxServiceServer).Endorse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cckit.gateway.ChaincodeTxService/Endorse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaincodeTxServiceServer).Endorse(ctx, req.(*ChaincodeEndorseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaincodeTxService_Submit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChaincodeSubmitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaincodeTxServiceServer).Submit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cckit.gateway.ChaincodeTxService/Submit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaincodeTxServiceServer).Submit(ctx, req.(*ChaincodeSubmitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ChaincodeTxService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cckit.gateway.ChaincodeTxService",
+	HandlerType: (*ChaincodeTxServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Propose",
+			Handler:    _ChaincodeTxService_Propose_Handler,
+		},
+		{
+			MethodName: "Endorse",
+			Handler:    _ChaincodeTxService_Endorse_Handler,
+		},
+		{
+			MethodName: "Submit",
+			Handler:    _ChaincodeTxService_Submit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "chaincode.proto",
+}
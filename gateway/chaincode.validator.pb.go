@@ -78,6 +78,49 @@ func (this *ChaincodeInvokeRequest) Validate() error {
 			return github_com_mwitkow_go_proto_validators.FieldError("Input", err)
 		}
 	}
+	if this.TxWaiter != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.TxWaiter); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("TxWaiter", err)
+		}
+	}
+	return nil
+}
+func (this *TxWaiter) Validate() error {
+	return nil
+}
+func (this *TransactionStatus) Validate() error {
+	if this.Timestamp != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Timestamp); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Timestamp", err)
+		}
+	}
+	return nil
+}
+func (this *ChaincodeInvokeResponse) Validate() error {
+	if this.Response != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Response); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Response", err)
+		}
+	}
+	if this.Tx != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Tx); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Tx", err)
+		}
+	}
+	return nil
+}
+func (this *ChaincodeTransactionStatusRequest) Validate() error {
+	if nil == this.Locator {
+		return github_com_mwitkow_go_proto_validators.FieldError("Locator", fmt.Errorf("message must exist"))
+	}
+	if this.Locator != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Locator); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Locator", err)
+		}
+	}
+	if this.TxId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("TxId", fmt.Errorf(`value '%v' must not be an empty string`, this.TxId))
+	}
 	return nil
 }
 func (this *BlockLimit) Validate() error {
@@ -147,6 +190,17 @@ func (this *ChaincodeInstanceInvokeRequest) Validate() error {
 			return github_com_mwitkow_go_proto_validators.FieldError("Input", err)
 		}
 	}
+	if this.TxWaiter != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.TxWaiter); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("TxWaiter", err)
+		}
+	}
+	return nil
+}
+func (this *ChaincodeInstanceTransactionStatusRequest) Validate() error {
+	if this.TxId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("TxId", fmt.Errorf(`value '%v' must not be an empty string`, this.TxId))
+	}
 	return nil
 }
 func (this *ChaincodeInstanceEventsStreamRequest) Validate() error {
@@ -224,3 +278,73 @@ func (this *ChaincodeEvent) Validate() error {
 	}
 	return nil
 }
+func (this *ChaincodeProposeRequest) Validate() error {
+	if nil == this.Locator {
+		return github_com_mwitkow_go_proto_validators.FieldError("Locator", fmt.Errorf("message must exist"))
+	}
+	if this.Locator != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Locator); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Locator", err)
+		}
+	}
+	if nil == this.Input {
+		return github_com_mwitkow_go_proto_validators.FieldError("Input", fmt.Errorf("message must exist"))
+	}
+	if this.Input != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Input); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Input", err)
+		}
+	}
+	if !(len(this.Creator) > 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Creator", fmt.Errorf(`value '%v' must have a length greater than '0'`, this.Creator))
+	}
+	return nil
+}
+func (this *UnsignedProposal) Validate() error {
+	return nil
+}
+func (this *ChaincodeEndorseRequest) Validate() error {
+	if nil == this.Locator {
+		return github_com_mwitkow_go_proto_validators.FieldError("Locator", fmt.Errorf("message must exist"))
+	}
+	if this.Locator != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Locator); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Locator", err)
+		}
+	}
+	if !(len(this.Proposal) > 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Proposal", fmt.Errorf(`value '%v' must have a length greater than '0'`, this.Proposal))
+	}
+	if !(len(this.Signature) > 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Signature", fmt.Errorf(`value '%v' must have a length greater than '0'`, this.Signature))
+	}
+	return nil
+}
+func (this *UnsignedTransaction) Validate() error {
+	if this.Response != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Response); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Response", err)
+		}
+	}
+	return nil
+}
+func (this *ChaincodeSubmitRequest) Validate() error {
+	if nil == this.Locator {
+		return github_com_mwitkow_go_proto_validators.FieldError("Locator", fmt.Errorf("message must exist"))
+	}
+	if this.Locator != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Locator); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Locator", err)
+		}
+	}
+	if !(len(this.Payload) > 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Payload", fmt.Errorf(`value '%v' must have a length greater than '0'`, this.Payload))
+	}
+	if !(len(this.Signature) > 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Signature", fmt.Errorf(`value '%v' must have a length greater than '0'`, this.Signature))
+	}
+	return nil
+}
+func (this *ChaincodeSubmitResponse) Validate() error {
+	return nil
+}
@@ -9,6 +9,8 @@ import (
 type (
 	ChaincodeEventService struct {
 		EventDelivery sdk.EventDelivery
+		// Opts applied to every chaincode instance event service
+		Opts []Opt
 	}
 
 	// ChaincodeEventsServer  gateway/chaincode.go needs access to grpc stream
@@ -20,6 +22,7 @@ var _ ChaincodeEventsServiceServer = &ChaincodeEventService{}
 // ServiceDef returns service definition
 func (ce *ChaincodeEventService) ServiceDef() ServiceDef {
 	return ServiceDef{
+		swagger:                     ChaincodeSwagger,
 		Desc:                        &_ChaincodeEventsService_serviceDesc,
 		Service:                     ce,
 		HandlerFromEndpointRegister: RegisterChaincodeEventsServiceHandlerFromEndpoint,
@@ -27,30 +30,33 @@ func (ce *ChaincodeEventService) ServiceDef() ServiceDef {
 }
 
 func (ce *ChaincodeEventService) Events(ctx context.Context, req *ChaincodeEventsRequest) (*ChaincodeEvents, error) {
-	return NewChaincodeInstanceEventService(ce.EventDelivery, req.Locator.Channel, req.Locator.Chaincode).
+	return NewChaincodeInstanceEventService(ce.EventDelivery, req.Locator.Channel, req.Locator.Chaincode, ce.Opts...).
 		Events(ctx, &ChaincodeInstanceEventsRequest{
-			FromBlock: req.FromBlock,
-			ToBlock:   req.ToBlock,
-			EventName: req.EventName,
-			Limit:     req.Limit,
+			FromBlock:     req.FromBlock,
+			ToBlock:       req.ToBlock,
+			EventName:     req.EventName,
+			Limit:         req.Limit,
+			PayloadFilter: req.PayloadFilter,
 		})
 }
 
 func (ce *ChaincodeEventService) EventsStream(req *ChaincodeEventsStreamRequest, stream ChaincodeEventsService_EventsStreamServer) error {
-	return NewChaincodeInstanceEventService(ce.EventDelivery, req.Locator.Channel, req.Locator.Chaincode).
+	return NewChaincodeInstanceEventService(ce.EventDelivery, req.Locator.Channel, req.Locator.Chaincode, ce.Opts...).
 		EventsStream(&ChaincodeInstanceEventsStreamRequest{
-			FromBlock: req.FromBlock,
-			ToBlock:   req.ToBlock,
-			EventName: req.EventName,
+			FromBlock:     req.FromBlock,
+			ToBlock:       req.ToBlock,
+			EventName:     req.EventName,
+			PayloadFilter: req.PayloadFilter,
 		}, stream)
 }
 
 func (ce *ChaincodeEventService) EventsChan(
 	ctx context.Context, req *ChaincodeEventsStreamRequest) (_ chan *ChaincodeEvent, closer func() error, _ error) {
-	return NewChaincodeInstanceEventService(ce.EventDelivery, req.Locator.Channel, req.Locator.Chaincode).
+	return NewChaincodeInstanceEventService(ce.EventDelivery, req.Locator.Channel, req.Locator.Chaincode, ce.Opts...).
 		EventsChan(ctx, &ChaincodeInstanceEventsStreamRequest{
-			FromBlock: req.FromBlock,
-			ToBlock:   req.ToBlock,
-			EventName: req.EventName,
+			FromBlock:     req.FromBlock,
+			ToBlock:       req.ToBlock,
+			EventName:     req.EventName,
+			PayloadFilter: req.PayloadFilter,
 		})
 }
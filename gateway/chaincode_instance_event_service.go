@@ -50,6 +50,7 @@ func NewChaincodeInstanceEventService(delivery sdk.EventDelivery, channel, chain
 
 func (ces *ChaincodeInstanceEventService) ServiceDef() ServiceDef {
 	return ServiceDef{
+		swagger:                     ChaincodeSwagger,
 		Desc:                        &_ChaincodeInstanceEventsService_serviceDesc,
 		Service:                     ces,
 		HandlerFromEndpointRegister: RegisterChaincodeInstanceEventsServiceHandlerFromEndpoint,
@@ -62,14 +63,28 @@ func (ces *ChaincodeInstanceEventService) EventsStream(
 		return err
 	}
 
-	ctx := stream.Context()
+	return ChainStream(func(ctx context.Context, invocation *EventsStreamInvocation) error {
+		return ces.eventsStream(ctx, invocation.Request, stream)
+	}, ces.Opts.Stream...)(stream.Context(), &EventsStreamInvocation{
+		Locator: ces.Locator,
+		Request: req,
+	})
+}
+
+func (ces *ChaincodeInstanceEventService) eventsStream(ctx context.Context,
+	req *ChaincodeInstanceEventsStreamRequest, stream ChaincodeInstanceEventsService_EventsStreamServer) error {
+	filter, err := payloadFilter(req.PayloadFilter, ces.Opts)
+	if err != nil {
+		return err
+	}
+
 	for _, c := range ces.Opts.Context {
 		ctx = c(ctx)
 	}
 
-	signer, _ := SignerFromContext(stream.Context())
+	signer, _ := SignerFromContext(ctx)
 	events, closer, err := ces.EventDelivery.Events(
-		stream.Context(),
+		ctx,
 		ces.Locator.Channel,
 		ces.Locator.Chaincode,
 		signer,
@@ -82,7 +97,7 @@ func (ces *ChaincodeInstanceEventService) EventsStream(
 	for {
 		select {
 
-		case <-stream.Context().Done():
+		case <-ctx.Done():
 			return closer()
 
 		case e, ok := <-events:
@@ -90,7 +105,7 @@ func (ces *ChaincodeInstanceEventService) EventsStream(
 				return nil
 			}
 
-			processedEvent, err := ProcessEvent(e, ces.Opts.Event, req.EventName)
+			processedEvent, err := ProcessEventWithFilter(e, ces.Opts.Event, req.EventName, filter)
 			if err != nil {
 				ces.Logger.Warn(`event processing`, zap.Error(err))
 			}
@@ -109,13 +124,18 @@ func (ces *ChaincodeInstanceEventService) Events(ctx context.Context, req *Chain
 		return nil, err
 	}
 
+	filter, err := payloadFilter(req.PayloadFilter, ces.Opts)
+	if err != nil {
+		return nil, err
+	}
+
 	// for event _list_ default block range is up to current channel height
 	if req.ToBlock == nil {
 		req.ToBlock = &BlockLimit{Num: 0}
 	}
 
-	for _, c := range ces.Opts.Context {
-		ctx = c(ctx)
+	if ctx, err = ces.Opts.signerContext(ctx); err != nil {
+		return nil, err
 	}
 	signer, _ := SignerFromContext(ctx)
 	eventStream, closer, err := ces.EventDelivery.Events(
@@ -159,7 +179,7 @@ func (ces *ChaincodeInstanceEventService) Events(ctx context.Context, req *Chain
 				return events, nil
 			}
 
-			processedEvent, err := ProcessEvent(e, ces.Opts.Event, req.EventName)
+			processedEvent, err := ProcessEventWithFilter(e, ces.Opts.Event, req.EventName, filter)
 			if err != nil {
 				ces.Logger.Warn(`event processing`, zap.Error(err))
 			}
@@ -183,8 +203,13 @@ func (ces *ChaincodeInstanceEventService) EventsChan(ctx context.Context, rr ...
 		}
 	}
 
-	for _, c := range ces.Opts.Context {
-		ctx = c(ctx)
+	filter, err := payloadFilter(req.PayloadFilter, ces.Opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if ctx, err = ces.Opts.signerContext(ctx); err != nil {
+		return nil, nil, err
 	}
 	signer, _ := SignerFromContext(ctx)
 	events, deliveryCloser, err := ces.EventDelivery.Events(
@@ -207,7 +232,7 @@ func (ces *ChaincodeInstanceEventService) EventsChan(ctx context.Context, rr ...
 
 	go func() {
 		for e := range events {
-			eventProcessed, err := ProcessEvent(e, ces.Opts.Event, req.EventName)
+			eventProcessed, err := ProcessEventWithFilter(e, ces.Opts.Event, req.EventName, filter)
 
 			if err != nil {
 				ces.Logger.Warn(`event processing`, zap.Error(err))
@@ -56,6 +56,7 @@ func (cis *ChaincodeInstanceService) EventService() *ChaincodeInstanceEventServi
 
 func (cis *ChaincodeInstanceService) ServiceDef() ServiceDef {
 	return ServiceDef{
+		swagger:                     ChaincodeSwagger,
 		Desc:                        &_ChaincodeInstanceService_serviceDesc,
 		Service:                     cis,
 		HandlerFromEndpointRegister: RegisterChaincodeInstanceServiceHandlerFromEndpoint,
@@ -78,14 +79,23 @@ func (cis *ChaincodeInstanceService) Query(ctx context.Context, req *ChaincodeIn
 		return nil, err
 	}
 
+	return ChainUnary(cis.query, cis.Opts.Unary...)(ctx, &Invocation{
+		Type:    InvocationType_INVOCATION_TYPE_QUERY,
+		Locator: cis.Locator,
+		Input:   req.Input,
+	})
+}
+
+func (cis *ChaincodeInstanceService) query(ctx context.Context, invocation *Invocation) (*peer.Response, error) {
 	for _, c := range cis.Opts.Context {
 		ctx = c(ctx)
 	}
 
 	signer, _ := SignerFromContext(ctx)
+	setQueriedSigner(ctx, signer)
 
 	for _, i := range cis.Opts.Input {
-		if err := i(req.Input); err != nil {
+		if err := i(invocation.Input); err != nil {
 			return nil, err
 		}
 	}
@@ -94,9 +104,9 @@ func (cis *ChaincodeInstanceService) Query(ctx context.Context, req *ChaincodeIn
 		ctx,
 		cis.Locator.Channel,
 		cis.Locator.Chaincode,
-		req.Input.Args,
+		invocation.Input.Args,
 		signer,
-		req.Input.Transient,
+		invocation.Input.Transient,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("query chaincode: %w", err)
@@ -108,7 +118,6 @@ func (cis *ChaincodeInstanceService) Query(ctx context.Context, req *ChaincodeIn
 	}
 
 	return response, nil
-
 }
 
 func (cis *ChaincodeInstanceService) Invoke(ctx context.Context, req *ChaincodeInstanceInvokeRequest) (*peer.Response, error) {
@@ -116,6 +125,69 @@ func (cis *ChaincodeInstanceService) Invoke(ctx context.Context, req *ChaincodeI
 		return nil, err
 	}
 
+	ctx, err := ContextWithTxWaitPolicy(ctx, req.TxWaiter)
+	if err != nil {
+		return nil, err
+	}
+
+	return ChainUnary(cis.invoke, cis.Opts.Unary...)(ctx, &Invocation{
+		Type:    InvocationType_INVOCATION_TYPE_INVOKE,
+		Locator: cis.Locator,
+		Input:   req.Input,
+	})
+}
+
+// InvokeTx invokes chaincode and returns chaincode response with transaction status.
+// Commit status is requested from SDK implementing sdk.TxStatusReader, if transaction waiting policy is not none
+func (cis *ChaincodeInstanceService) InvokeTx(
+	ctx context.Context, req *ChaincodeInstanceInvokeRequest) (*ChaincodeInvokeResponse, error) {
+	ctx, err := ContextWithTxWaitPolicy(ctx, req.TxWaiter)
+	if err != nil {
+		return nil, err
+	}
+	ctx, tx := contextWithInvokedTx(ctx)
+
+	response, err := cis.Invoke(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &TransactionStatus{TxId: tx.id}
+	if _, ok := cis.SDK.(sdk.TxStatusReader); ok && tx.id != `` && TxWaiterFromContext(ctx) != sdk.TxWaiterNone {
+		if status, err = cis.GetTransactionStatus(ctx, &ChaincodeInstanceTransactionStatusRequest{TxId: tx.id}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &ChaincodeInvokeResponse{Response: response, Tx: status}, nil
+}
+
+// GetTransactionStatus returns transaction commit status from SDK implementing sdk.TxStatusReader
+func (cis *ChaincodeInstanceService) GetTransactionStatus(
+	ctx context.Context, req *ChaincodeInstanceTransactionStatusRequest) (*TransactionStatus, error) {
+	if err := router.ValidateRequest(req); err != nil {
+		return nil, err
+	}
+
+	reader, ok := cis.SDK.(sdk.TxStatusReader)
+	if !ok {
+		return nil, ErrTxStatusNotSupported
+	}
+
+	ctx, err := cis.Opts.signerContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	signer, _ := SignerFromContext(ctx)
+	status, err := reader.TransactionStatus(ctx, cis.Locator.Channel, req.TxId, signer)
+	if err != nil {
+		return nil, fmt.Errorf(`transaction status: %w`, err)
+	}
+
+	return TransactionStatusFromSDK(status), nil
+}
+
+func (cis *ChaincodeInstanceService) invoke(ctx context.Context, invocation *Invocation) (*peer.Response, error) {
 	for _, c := range cis.Opts.Context {
 		ctx = c(ctx)
 	}
@@ -126,20 +198,34 @@ func (cis *ChaincodeInstanceService) Invoke(ctx context.Context, req *ChaincodeI
 	signer, _ := SignerFromContext(ctx)
 
 	for _, i := range cis.Opts.Input {
-		if err := i(req.Input); err != nil {
+		if err := i(invocation.Input); err != nil {
 			return nil, err
 		}
 	}
 
-	response, _, err := cis.SDK.Invoke(
-		ctx,
-		cis.Locator.Channel,
-		cis.Locator.Chaincode,
-		req.Input.Args,
-		signer,
-		req.Input.Transient,
-		TxWaiterFromContext(ctx),
+	invoke := func() (*peer.Response, error) {
+		response, txID, err := cis.SDK.Invoke(
+			ctx,
+			cis.Locator.Channel,
+			cis.Locator.Chaincode,
+			invocation.Input.Args,
+			signer,
+			invocation.Input.Transient,
+			TxWaiterFromContext(ctx),
+		)
+		setInvokedTxID(ctx, txID)
+		return response, err
+	}
+
+	var (
+		response *peer.Response
+		err      error
 	)
+	if cis.Opts.InvokeRetry != nil {
+		response, err = cis.Opts.InvokeRetry.invoke(ctx, invoke)
+	} else {
+		response, err = invoke()
+	}
 	if err != nil {
 		return nil, fmt.Errorf("invoke chaincode: %w", err)
 	}
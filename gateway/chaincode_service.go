@@ -17,6 +17,8 @@ var _ ChaincodeServiceServer = &ChaincodeService{}
 type ChaincodeService struct {
 	SDK          sdk.SDK
 	EventService *ChaincodeEventService
+	// Opts applied to every chaincode instance service, for example interceptors
+	Opts []Opt
 }
 
 // Deprecated: use NewChaincodeService instead
@@ -24,31 +26,35 @@ func New(sdk sdk.SDK) *ChaincodeService {
 	return NewChaincodeService(sdk)
 }
 
-func NewChaincodeService(sdk sdk.SDK) *ChaincodeService {
+func NewChaincodeService(sdk sdk.SDK, opts ...Opt) *ChaincodeService {
 	ccService := &ChaincodeService{
 		SDK:          sdk,
-		EventService: NewChaincodeEventService(sdk),
+		EventService: NewChaincodeEventService(sdk, opts...),
+		Opts:         opts,
 	}
 
 	return ccService
 }
 
-func NewChaincodeEventService(eventDelivery sdk.EventDelivery) *ChaincodeEventService {
+func NewChaincodeEventService(eventDelivery sdk.EventDelivery, opts ...Opt) *ChaincodeEventService {
 	eventService := &ChaincodeEventService{
 		EventDelivery: eventDelivery,
+		Opts:          opts,
 	}
 
 	return eventService
 }
 
-// InstanceService returns ChaincodeInstanceService for current Peer interface and provided channel and chaincode name
+// InstanceService returns ChaincodeInstanceService for current Peer interface and provided channel and chaincode name,
+// opts are applied after chaincode service opts
 func (cs *ChaincodeService) InstanceService(locator *ChaincodeLocator, opts ...Opt) *ChaincodeInstanceService {
-	return NewChaincodeInstanceService(cs.SDK, locator, opts...)
+	return NewChaincodeInstanceService(cs.SDK, locator, append(append([]Opt{}, cs.Opts...), opts...)...)
 }
 
 // ServiceDef returns service definition
 func (cs *ChaincodeService) ServiceDef() ServiceDef {
 	return ServiceDef{
+		swagger:                     ChaincodeSwagger,
 		Desc:                        &_ChaincodeService_serviceDesc,
 		Service:                     cs,
 		HandlerFromEndpointRegister: RegisterChaincodeServiceHandlerFromEndpoint,
@@ -69,7 +75,27 @@ func (cs *ChaincodeService) Invoke(ctx context.Context, req *ChaincodeInvokeRequ
 		return nil, err
 	}
 
-	return cs.InstanceService(req.Locator).Invoke(ctx, &ChaincodeInstanceInvokeRequest{Input: req.Input})
+	return cs.InstanceService(req.Locator).Invoke(ctx, &ChaincodeInstanceInvokeRequest{
+		Input: req.Input, TxWaiter: req.TxWaiter})
+}
+
+func (cs *ChaincodeService) InvokeTx(ctx context.Context, req *ChaincodeInvokeRequest) (*ChaincodeInvokeResponse, error) {
+	if err := router.ValidateRequest(req); err != nil {
+		return nil, err
+	}
+
+	return cs.InstanceService(req.Locator).InvokeTx(ctx, &ChaincodeInstanceInvokeRequest{
+		Input: req.Input, TxWaiter: req.TxWaiter})
+}
+
+func (cs *ChaincodeService) GetTransactionStatus(
+	ctx context.Context, req *ChaincodeTransactionStatusRequest) (*TransactionStatus, error) {
+	if err := router.ValidateRequest(req); err != nil {
+		return nil, err
+	}
+
+	return cs.InstanceService(req.Locator).GetTransactionStatus(ctx,
+		&ChaincodeInstanceTransactionStatusRequest{TxId: req.TxId})
 }
 
 func (cs *ChaincodeService) Query(ctx context.Context, req *ChaincodeQueryRequest) (*peer.Response, error) {
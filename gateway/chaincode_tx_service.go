@@ -0,0 +1,108 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric-protos-go/common"
+	"github.com/hyperledger/fabric/protoutil"
+
+	"github.com/s7techlab/cckit/router"
+	"github.com/s7techlab/cckit/sdk"
+)
+
+var _ ChaincodeTxServiceServer = &ChaincodeTxService{}
+
+// ChaincodeTxService invokes chaincode with offline signing: proposal and transaction are signed by client,
+// gateway doesn't hold client signing key
+type ChaincodeTxService struct {
+	Invoker sdk.OfflineInvoker
+}
+
+func NewChaincodeTxService(invoker sdk.OfflineInvoker) *ChaincodeTxService {
+	return &ChaincodeTxService{
+		Invoker: invoker,
+	}
+}
+
+// ServiceDef returns service definition
+func (cts *ChaincodeTxService) ServiceDef() ServiceDef {
+	return ServiceDef{
+		swagger:                     ChaincodeSwagger,
+		Desc:                        &_ChaincodeTxService_serviceDesc,
+		Service:                     cts,
+		HandlerFromEndpointRegister: RegisterChaincodeTxServiceHandlerFromEndpoint,
+	}
+}
+
+func (cts *ChaincodeTxService) Propose(_ context.Context, req *ChaincodeProposeRequest) (*UnsignedProposal, error) {
+	if err := router.ValidateRequest(req); err != nil {
+		return nil, err
+	}
+
+	proposal, txID, err := sdk.CreateProposal(
+		req.Locator.Channel, req.Locator.Chaincode, req.Input.Args, req.Creator, req.Input.Transient)
+	if err != nil {
+		return nil, fmt.Errorf(`create proposal: %w`, err)
+	}
+
+	proposalBytes, err := proto.Marshal(proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UnsignedProposal{TxId: txID, Proposal: proposalBytes}, nil
+}
+
+func (cts *ChaincodeTxService) Endorse(ctx context.Context, req *ChaincodeEndorseRequest) (*UnsignedTransaction, error) {
+	if err := router.ValidateRequest(req); err != nil {
+		return nil, err
+	}
+
+	proposal, err := sdk.ProposalFromBytes(req.Proposal)
+	if err != nil {
+		return nil, fmt.Errorf(`unmarshal proposal: %w`, err)
+	}
+
+	responses, err := cts.Invoker.Endorse(
+		ctx, req.Locator.Channel, req.Locator.Chaincode, sdk.SignedProposal(req.Proposal, req.Signature))
+	if err != nil {
+		return nil, fmt.Errorf(`endorse proposal: %w`, err)
+	}
+
+	envelope, err := sdk.CreateTransaction(proposal, responses...)
+	if err != nil {
+		return nil, fmt.Errorf(`create transaction: %w`, err)
+	}
+
+	header, err := protoutil.UnmarshalHeader(proposal.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	channelHeader, err := protoutil.UnmarshalChannelHeader(header.ChannelHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UnsignedTransaction{
+		TxId:     channelHeader.TxId,
+		Response: responses[0].Response,
+		Payload:  envelope.Payload,
+	}, nil
+}
+
+func (cts *ChaincodeTxService) Submit(ctx context.Context, req *ChaincodeSubmitRequest) (*ChaincodeSubmitResponse, error) {
+	if err := router.ValidateRequest(req); err != nil {
+		return nil, err
+	}
+
+	txID, err := cts.Invoker.Submit(ctx, req.Locator.Channel,
+		&common.Envelope{Payload: req.Payload, Signature: req.Signature}, TxWaiterFromContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf(`submit transaction: %w`, err)
+	}
+
+	return &ChaincodeSubmitResponse{TxId: txID}, nil
+}
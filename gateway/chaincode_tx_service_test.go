@@ -0,0 +1,157 @@
+package gateway_test
+
+import (
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/s7techlab/cckit/convert"
+	cpservice "github.com/s7techlab/cckit/examples/cpaper_asservice"
+	"github.com/s7techlab/cckit/examples/cpaper_asservice/testdata"
+	"github.com/s7techlab/cckit/gateway"
+	"github.com/s7techlab/cckit/identity"
+	idtestdata "github.com/s7techlab/cckit/identity/testdata"
+	testcc "github.com/s7techlab/cckit/testing"
+)
+
+var _ = Describe(`Chaincode tx service`, func() {
+
+	var (
+		txService *gateway.ChaincodeTxService
+		mockStub  *testcc.MockStub
+		signer    *identity.CertSigningIdentity
+		creator   []byte
+		locator   = &gateway.ChaincodeLocator{Channel: Channel, Chaincode: ChaincodeName}
+
+		propose = func() *gateway.UnsignedProposal {
+			issue, err := convert.ToBytes(testdata.Issue1)
+			Expect(err).NotTo(HaveOccurred())
+
+			proposal, err := txService.Propose(ctx, &gateway.ChaincodeProposeRequest{
+				Locator: locator,
+				Input: &gateway.ChaincodeInput{
+					Args: [][]byte{[]byte(cpservice.CPaperServiceChaincode_Issue), issue},
+				},
+				Creator: creator,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			return proposal
+		}
+
+		sign = func(msg []byte) []byte {
+			signature, err := signer.Sign(msg)
+			Expect(err).NotTo(HaveOccurred())
+			return signature
+		}
+
+		getIssued = func() int32 {
+			return mockStub.Query(cpservice.CPaperServiceChaincode_Get, testdata.Id1).Status
+		}
+	)
+
+	It("Init", func() {
+		ccImpl, err := cpservice.NewCC()
+		Expect(err).NotTo(HaveOccurred())
+
+		mockStub = testcc.NewMockStub(ChaincodeName, ccImpl)
+		txService = gateway.NewChaincodeTxService(testcc.NewPeer().WithChannel(Channel, mockStub))
+
+		cert := idtestdata.Certificates[0]
+		signer, err = identity.NewSigning(idtestdata.DefaultMSP, cert.MustCertBytes(), cert.MustPKeyBytes())
+		Expect(err).NotTo(HaveOccurred())
+
+		creator, err = signer.Serialize()
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Allow to propose, endorse and submit transaction, signed by client", func() {
+		proposal := propose()
+		Expect(proposal.TxId).NotTo(BeEmpty())
+
+		tx, err := txService.Endorse(ctx, &gateway.ChaincodeEndorseRequest{
+			Locator:   locator,
+			Proposal:  proposal.Proposal,
+			Signature: sign(proposal.Proposal),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tx.TxId).To(Equal(proposal.TxId))
+		Expect(tx.Response.Status).To(Equal(int32(shim.OK)))
+
+		// endorsement doesn't change state
+		Expect(getIssued()).To(Equal(int32(shim.ERROR)))
+
+		res, err := txService.Submit(ctx, &gateway.ChaincodeSubmitRequest{
+			Locator:   locator,
+			Payload:   tx.Payload,
+			Signature: sign(tx.Payload),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.TxId).To(Equal(proposal.TxId))
+
+		Expect(getIssued()).To(Equal(int32(shim.OK)))
+	})
+
+	It("Disallow to endorse proposal with invalid signature", func() {
+		proposal := propose()
+
+		_, err := txService.Endorse(ctx, &gateway.ChaincodeEndorseRequest{
+			Locator:   locator,
+			Proposal:  proposal.Proposal,
+			Signature: sign([]byte(`another message`)),
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(identity.ErrSignatureInvalid.Error()))
+	})
+
+	It("Disallow to submit transaction with invalid signature", func() {
+		Expect(mockStub.Invoke(cpservice.CPaperServiceChaincode_Delete, testdata.Id1).Status).To(Equal(int32(shim.OK)))
+		proposal := propose()
+
+		tx, err := txService.Endorse(ctx, &gateway.ChaincodeEndorseRequest{
+			Locator:   locator,
+			Proposal:  proposal.Proposal,
+			Signature: sign(proposal.Proposal),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = txService.Submit(ctx, &gateway.ChaincodeSubmitRequest{
+			Locator:   locator,
+			Payload:   tx.Payload,
+			Signature: sign(proposal.Proposal),
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(identity.ErrSignatureInvalid.Error()))
+		Expect(getIssued()).To(Equal(int32(shim.ERROR)))
+	})
+
+	It("Disallow to submit transaction after state change since endorsement", func() {
+		proposal := propose()
+
+		tx, err := txService.Endorse(ctx, &gateway.ChaincodeEndorseRequest{
+			Locator:   locator,
+			Proposal:  proposal.Proposal,
+			Signature: sign(proposal.Proposal),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(mockStub.Invoke(cpservice.CPaperServiceChaincode_Issue, testdata.Issue1).Status).To(Equal(int32(shim.OK)))
+		Expect(mockStub.Invoke(cpservice.CPaperServiceChaincode_Delete, testdata.Id1).Status).To(Equal(int32(shim.OK)))
+
+		_, err = txService.Submit(ctx, &gateway.ChaincodeSubmitRequest{
+			Locator:   locator,
+			Payload:   tx.Payload,
+			Signature: sign(tx.Payload),
+		})
+		Expect(gateway.IsReadConflict(err)).To(BeTrue())
+		Expect(getIssued()).To(Equal(int32(shim.ERROR)))
+	})
+
+	It("Disallow to submit not endorsed transaction", func() {
+		_, err := txService.Submit(ctx, &gateway.ChaincodeSubmitRequest{
+			Locator:   locator,
+			Payload:   []byte(`not endorsed`),
+			Signature: sign([]byte(`not endorsed`)),
+		})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,213 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+type (
+	// Checkpoint position of last acknowledged chaincode event: block number, index of chaincode event transaction
+	// within chaincode events of block, before subscription filters, and transaction id, used to find
+	// acknowledged transaction on resume
+	Checkpoint struct {
+		Block   uint64 `json:"block"`
+		TxIndex uint64 `json:"tx_index"`
+		TxId    string `json:"tx_id"`
+	}
+
+	// CheckpointStore stores checkpoints of event subscribers
+	CheckpointStore interface {
+		// Get returns ErrCheckpointNotFound if subscriber has no checkpoint
+		Get(ctx context.Context, subscriberID string) (*Checkpoint, error)
+		Put(ctx context.Context, subscriberID string, checkpoint *Checkpoint) error
+		// Delete removes checkpoint of subscriber, subscriber without checkpoint is not an error
+		Delete(ctx context.Context, subscriberID string) error
+	}
+
+	MemoryCheckpointStore struct {
+		checkpoints map[string]Checkpoint
+		mu          sync.RWMutex
+	}
+
+	// FileCheckpointStore stores checkpoint of every subscriber in {dir}/{subscriberID}.json
+	FileCheckpointStore struct {
+		dir string
+		mu  sync.Mutex
+	}
+)
+
+var (
+	_ CheckpointStore = &MemoryCheckpointStore{}
+	_ CheckpointStore = &FileCheckpointStore{}
+)
+
+// After returns true if checkpoint position is after other checkpoint, any checkpoint is after nil
+func (c *Checkpoint) After(other *Checkpoint) bool {
+	if other == nil {
+		return true
+	}
+
+	if c.Block != other.Block {
+		return c.Block > other.Block
+	}
+
+	return c.TxIndex > other.TxIndex
+}
+
+// Next returns position of next chaincode event. Chaincode events are delivered in order,
+// position within block is counted from first block event
+func (c *Checkpoint) Next(block uint64, txID string) *Checkpoint {
+	if c == nil || c.Block != block {
+		return &Checkpoint{Block: block, TxId: txID}
+	}
+
+	return &Checkpoint{Block: block, TxIndex: c.TxIndex + 1, TxId: txID}
+}
+
+// String returns checkpoint position as {block}:{txIndex}
+func (c *Checkpoint) String() string {
+	return fmt.Sprintf(`%d:%d`, c.Block, c.TxIndex)
+}
+
+// ParseCheckpoint parses checkpoint position from {block}:{txIndex} string
+func ParseCheckpoint(s string) (*Checkpoint, error) {
+	parts := strings.Split(s, `:`)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf(`%w: %s`, ErrCheckpointInvalid, s)
+	}
+
+	block, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf(`%w: %s`, ErrCheckpointInvalid, s)
+	}
+
+	txIndex, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf(`%w: %s`, ErrCheckpointInvalid, s)
+	}
+
+	return &Checkpoint{Block: block, TxIndex: txIndex}, nil
+}
+
+func NewMemoryCheckpointStore() *MemoryCheckpointStore {
+	return &MemoryCheckpointStore{
+		checkpoints: make(map[string]Checkpoint),
+	}
+}
+
+func (s *MemoryCheckpointStore) Get(_ context.Context, subscriberID string) (*Checkpoint, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	checkpoint, ok := s.checkpoints[subscriberID]
+	if !ok {
+		return nil, fmt.Errorf(`%w: %s`, ErrCheckpointNotFound, subscriberID)
+	}
+
+	return &checkpoint, nil
+}
+
+func (s *MemoryCheckpointStore) Put(_ context.Context, subscriberID string, checkpoint *Checkpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.checkpoints[subscriberID] = *checkpoint
+	return nil
+}
+
+func (s *MemoryCheckpointStore) Delete(_ context.Context, subscriberID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.checkpoints, subscriberID)
+	return nil
+}
+
+// NewFileCheckpointStore creates checkpoint store in dir, dir is created if not exists
+func NewFileCheckpointStore(dir string) (*FileCheckpointStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf(`create checkpoint dir: %w`, err)
+	}
+
+	return &FileCheckpointStore{dir: dir}, nil
+}
+
+func (s *FileCheckpointStore) Get(_ context.Context, subscriberID string) (*Checkpoint, error) {
+	path, err := s.path(subscriberID)
+	if err != nil {
+		return nil, err
+	}
+
+	bb, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf(`%w: %s`, ErrCheckpointNotFound, subscriberID)
+		}
+		return nil, fmt.Errorf(`read checkpoint: %w`, err)
+	}
+
+	checkpoint := &Checkpoint{}
+	if err = json.Unmarshal(bb, checkpoint); err != nil {
+		return nil, fmt.Errorf(`unmarshal checkpoint: %w`, err)
+	}
+
+	return checkpoint, nil
+}
+
+// Put writes checkpoint to temp file and renames it, so stored checkpoint is never partially written
+func (s *FileCheckpointStore) Put(_ context.Context, subscriberID string, checkpoint *Checkpoint) error {
+	path, err := s.path(subscriberID)
+	if err != nil {
+		return err
+	}
+
+	bb, err := json.Marshal(checkpoint)
+	if err != nil {
+		return fmt.Errorf(`marshal checkpoint: %w`, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tmp := path + `.tmp`
+	if err = ioutil.WriteFile(tmp, bb, 0600); err != nil {
+		return fmt.Errorf(`write checkpoint: %w`, err)
+	}
+
+	if err = os.Rename(tmp, path); err != nil {
+		return fmt.Errorf(`write checkpoint: %w`, err)
+	}
+
+	return nil
+}
+
+func (s *FileCheckpointStore) Delete(_ context.Context, subscriberID string) error {
+	path, err := s.path(subscriberID)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err = os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf(`delete checkpoint: %w`, err)
+	}
+
+	return nil
+}
+
+func (s *FileCheckpointStore) path(subscriberID string) (string, error) {
+	if subscriberID == `` || strings.ContainsAny(subscriberID, `/\`) || strings.HasPrefix(subscriberID, `.`) {
+		return ``, fmt.Errorf(`%w: %s`, ErrSubscriberIDInvalid, subscriberID)
+	}
+
+	return filepath.Join(s.dir, subscriberID+`.json`), nil
+}
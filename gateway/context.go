@@ -68,6 +68,7 @@ func ContextWithTxWaiter(ctx context.Context, txWaiterType string) context.Conte
 // for example, in hlf-sdk:
 // available: 'self'(wait for one peer of endorser org), 'all'(wait for each organization from endorsement policy)
 // default is 'self'(even if you pass empty string)
+// typed waiting policy TxWaiter is converted to tx waiter type with sdk.TxWaiter* constants
 func TxWaiterFromContext(ctx context.Context) string {
 	txWaiter, _ := ctx.Value(CtxTxWaiterKey).(string)
 	return txWaiter
@@ -0,0 +1,275 @@
+// Package dynamic serves chaincode services, described with proto descriptors, over gRPC and JSON/HTTP
+// without generated gateway code
+package dynamic
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/s7techlab/cckit/extensions/catalog"
+	"github.com/s7techlab/cckit/gateway"
+	"github.com/s7techlab/cckit/state/schema"
+)
+
+type (
+	// Gateway calls chaincode methods of services from proto descriptors,
+	// request and response messages are dynamic proto messages
+	Gateway struct {
+		Invoker  gateway.ChaincodeInstanceInvoker
+		Files    *protoregistry.Files
+		Services []*Service
+
+		methods map[protoreflect.FullName]*Method
+	}
+
+	Service struct {
+		Desc    protoreflect.ServiceDescriptor
+		Methods []*Method
+	}
+
+	Method struct {
+		Desc protoreflect.MethodDescriptor
+		// ChaincodeMethod name of chaincode router method, i.e. `CatalogService.GetCatalog`
+		ChaincodeMethod string
+		// Query is true if method has GET http binding (same rule as in chaincode gateway generator),
+		// otherwise method is invoked
+		Query    bool
+		Bindings []*Binding
+	}
+
+	// Binding of method to http method and path template from google.api.http annotation
+	Binding struct {
+		HTTPMethod string
+		Path       string
+		// Body request field, bound to http request body, `*` - whole request
+		Body string
+	}
+
+	Opt func(*gatewayOpts)
+
+	gatewayOpts struct {
+		serviceNameMethodPrefix bool
+		services                map[protoreflect.FullName]bool
+	}
+)
+
+// WithServiceNameMethodPrefix sets chaincode method names prefixed with service name, i.e. `CatalogService.GetCatalog`,
+// like chaincode gateway generator option `service_name_method_prefix`
+func WithServiceNameMethodPrefix() Opt {
+	return func(o *gatewayOpts) {
+		o.serviceNameMethodPrefix = true
+	}
+}
+
+// WithServices restricts gateway to services with full names, by default all services from descriptors are served
+func WithServices(names ...string) Opt {
+	return func(o *gatewayOpts) {
+		if o.services == nil {
+			o.services = make(map[protoreflect.FullName]bool)
+		}
+		for _, name := range names {
+			o.services[protoreflect.FullName(name)] = true
+		}
+	}
+}
+
+// New creates gateway for unary methods of services from file descriptor set, set must include dependencies.
+// Chaincode methods are called with chaincode instance service
+func New(ccInstance gateway.ChaincodeInstanceServiceServer, fileSet *descriptorpb.FileDescriptorSet, opts ...Opt) (
+	*Gateway, error) {
+	o := &gatewayOpts{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	files, err := protodesc.NewFiles(fileSet)
+	if err != nil {
+		return nil, fmt.Errorf(`file descriptor set: %w`, err)
+	}
+
+	g := &Gateway{
+		Invoker: gateway.NewChaincodeInstanceServiceInvoker(ccInstance),
+		Files:   files,
+		methods: make(map[protoreflect.FullName]*Method),
+	}
+
+	files.RangeFiles(func(file protoreflect.FileDescriptor) bool {
+		services := file.Services()
+		for i := 0; i < services.Len(); i++ {
+			if o.services != nil && !o.services[services.Get(i).FullName()] {
+				continue
+			}
+			g.addService(services.Get(i), o)
+		}
+		return true
+	})
+
+	for name := range o.services {
+		if g.service(name) == nil {
+			return nil, fmt.Errorf(`%w: %s`, ErrServiceNotFound, name)
+		}
+	}
+
+	return g, nil
+}
+
+// FromCatalog creates gateway with proto descriptors from chaincode catalog,
+// chaincode must serve catalog extension service
+func FromCatalog(ctx context.Context, ccInstance gateway.ChaincodeInstanceServiceServer, opts ...Opt) (*Gateway, error) {
+	res, err := gateway.NewChaincodeInstanceServiceInvoker(ccInstance).Query(
+		ctx, catalog.CatalogServiceChaincode_GetCatalog, []interface{}{&emptypb.Empty{}}, &schema.Catalog{})
+	if err != nil {
+		return nil, fmt.Errorf(`get catalog: %w`, err)
+	}
+
+	return New(ccInstance, res.(*schema.Catalog).GetFiles(), opts...)
+}
+
+// LoadFileDescriptorSet reads serialized file descriptor set, i.e. created with
+// `protoc --include_imports --descriptor_set_out` or `buf build -o`
+func LoadFileDescriptorSet(path string) (*descriptorpb.FileDescriptorSet, error) {
+	bb, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	fileSet := &descriptorpb.FileDescriptorSet{}
+	if err = proto.Unmarshal(bb, fileSet); err != nil {
+		return nil, fmt.Errorf(`unmarshal file descriptor set: %w`, err)
+	}
+	return fileSet, nil
+}
+
+func (g *Gateway) addService(desc protoreflect.ServiceDescriptor, o *gatewayOpts) {
+	service := &Service{Desc: desc}
+
+	prefix := ``
+	if o.serviceNameMethodPrefix {
+		prefix = string(desc.Name()) + `.`
+	}
+
+	methods := desc.Methods()
+	for i := 0; i < methods.Len(); i++ {
+		md := methods.Get(i)
+		// chaincode methods can be only unary
+		if md.IsStreamingClient() || md.IsStreamingServer() {
+			continue
+		}
+
+		method := &Method{
+			Desc:            md,
+			ChaincodeMethod: prefix + string(md.Name()),
+			Bindings:        bindings(md),
+		}
+		method.Query = hasGetBinding(method)
+
+		service.Methods = append(service.Methods, method)
+		g.methods[md.FullName()] = method
+	}
+
+	g.Services = append(g.Services, service)
+}
+
+func (g *Gateway) service(name protoreflect.FullName) *Service {
+	for _, s := range g.Services {
+		if s.Desc.FullName() == name {
+			return s
+		}
+	}
+	return nil
+}
+
+// Method returns method by full name, i.e. `examples.cpaper_asservice.CPaperService.Get`
+func (g *Gateway) Method(name string) (*Method, error) {
+	method, ok := g.methods[protoreflect.FullName(name)]
+	if !ok {
+		return nil, fmt.Errorf(`%w: %s`, ErrMethodNotFound, name)
+	}
+	return method, nil
+}
+
+// Invoke queries or invokes chaincode method, response payload is unmarshalled to dynamic message of method output type
+func (g *Gateway) Invoke(ctx context.Context, method *Method, in proto.Message) (*dynamicpb.Message, error) {
+	if in.ProtoReflect().Descriptor().FullName() != method.Desc.Input().FullName() {
+		return nil, fmt.Errorf(`%w: %s, expected %s`, ErrInputTypeMismatch,
+			in.ProtoReflect().Descriptor().FullName(), method.Desc.Input().FullName())
+	}
+
+	bb, err := proto.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+
+	call := g.Invoker.Invoke
+	if method.Query {
+		call = g.Invoker.Query
+	}
+
+	payload, err := call(ctx, method.ChaincodeMethod, []interface{}{bb}, []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	out := dynamicpb.NewMessage(method.Desc.Output())
+	if err = proto.Unmarshal(payload.([]byte), out); err != nil {
+		return nil, fmt.Errorf(`unmarshal %s: %w`, method.Desc.Output().FullName(), err)
+	}
+	return out, nil
+}
+
+// bindings returns http bindings of method from google.api.http annotation, including additional bindings
+func bindings(method protoreflect.MethodDescriptor) []*Binding {
+	options, ok := method.Options().(*descriptorpb.MethodOptions)
+	if !ok || options == nil || !proto.HasExtension(options, annotations.E_Http) {
+		return nil
+	}
+
+	rule := proto.GetExtension(options, annotations.E_Http).(*annotations.HttpRule)
+	var bb []*Binding
+	for _, r := range append([]*annotations.HttpRule{rule}, rule.GetAdditionalBindings()...) {
+		if b := binding(r); b != nil {
+			bb = append(bb, b)
+		}
+	}
+	return bb
+}
+
+func binding(rule *annotations.HttpRule) *Binding {
+	b := &Binding{Body: rule.GetBody()}
+	switch pattern := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		b.HTTPMethod, b.Path = `GET`, pattern.Get
+	case *annotations.HttpRule_Put:
+		b.HTTPMethod, b.Path = `PUT`, pattern.Put
+	case *annotations.HttpRule_Post:
+		b.HTTPMethod, b.Path = `POST`, pattern.Post
+	case *annotations.HttpRule_Delete:
+		b.HTTPMethod, b.Path = `DELETE`, pattern.Delete
+	case *annotations.HttpRule_Patch:
+		b.HTTPMethod, b.Path = `PATCH`, pattern.Patch
+	case *annotations.HttpRule_Custom:
+		b.HTTPMethod, b.Path = pattern.Custom.GetKind(), pattern.Custom.GetPath()
+	default:
+		return nil
+	}
+	return b
+}
+
+func hasGetBinding(method *Method) bool {
+	for _, b := range method.Bindings {
+		if b.HTTPMethod == `GET` {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,216 @@
+package dynamic_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	cpservice "github.com/s7techlab/cckit/examples/cpaper_asservice"
+	"github.com/s7techlab/cckit/examples/cpaper_asservice/testdata"
+	"github.com/s7techlab/cckit/extensions/catalog"
+	"github.com/s7techlab/cckit/gateway"
+	"github.com/s7techlab/cckit/gateway/dynamic"
+	idtestdata "github.com/s7techlab/cckit/identity/testdata"
+	"github.com/s7techlab/cckit/router"
+	"github.com/s7techlab/cckit/state/mapping"
+	testcc "github.com/s7techlab/cckit/testing"
+)
+
+func TestDynamic(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Dynamic gateway suite")
+}
+
+const (
+	Channel       = `my_channel`
+	ChaincodeName = `commercial_paper`
+	CPaperService = `examples.cpaper_asservice.CPaperService`
+)
+
+var _ = Describe(`Dynamic gateway`, func() {
+
+	var (
+		ctx        = context.Background()
+		ccInstance *gateway.ChaincodeInstanceService
+		dyn        *dynamic.Gateway
+
+		// toPaper converts dynamic message to generated type
+		toPaper = func(msg proto.Message) *cpservice.CommercialPaper {
+			bb, err := proto.Marshal(msg)
+			Expect(err).NotTo(HaveOccurred())
+			paper := &cpservice.CommercialPaper{}
+			Expect(proto.Unmarshal(bb, paper)).NotTo(HaveOccurred())
+			return paper
+		}
+
+		serveHTTP = func(handler http.Handler, method, path string, body []byte) (int, map[string]interface{}) {
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, httptest.NewRequest(method, path, bytes.NewReader(body)))
+
+			res := make(map[string]interface{})
+			Expect(json.Unmarshal(w.Body.Bytes(), &res)).NotTo(HaveOccurred())
+			return w.Code, res
+		}
+	)
+
+	It("Init", func() {
+		r, err := cpservice.CCRouter(`CommercialPaper`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(catalog.RegisterCatalogServiceChaincode(r,
+			catalog.NewService(cpservice.StateMappings, cpservice.EventMappings))).NotTo(HaveOccurred())
+
+		mockedPeer := testcc.NewPeer().WithChannel(Channel, testcc.NewMockStub(ChaincodeName, router.NewChaincode(r)))
+		ccInstance = gateway.NewChaincodeInstanceService(mockedPeer,
+			&gateway.ChaincodeLocator{Channel: Channel, Chaincode: ChaincodeName},
+			gateway.WithDefaultSigner(idtestdata.Certificates[0].MustIdentity(idtestdata.DefaultMSP)))
+	})
+
+	It("Allow to create gateway with descriptors from chaincode catalog", func() {
+		var err error
+		dyn, err = dynamic.FromCatalog(ctx, ccInstance)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(dyn.Services).To(HaveLen(1))
+		Expect(dyn.Services[0].Methods).To(HaveLen(7))
+
+		get, err := dyn.Method(CPaperService + `.Get`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(get.ChaincodeMethod).To(Equal(cpservice.CPaperServiceChaincode_Get))
+		Expect(get.Query).To(BeTrue())
+		Expect(get.Bindings).To(HaveLen(1))
+		Expect(get.Bindings[0].Path).To(Equal(`/cpaper/{issuer}/{paper_number}`))
+
+		issue, err := dyn.Method(CPaperService + `.Issue`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(issue.Query).To(BeFalse())
+
+		_, err = dyn.Method(CPaperService + `.Unknown`)
+		Expect(errors.Is(err, dynamic.ErrMethodNotFound)).To(BeTrue())
+	})
+
+	It("Allow to create gateway with descriptors from file", func() {
+		bb, err := proto.Marshal(mapping.Catalog(cpservice.StateMappings, cpservice.EventMappings).GetFiles())
+		Expect(err).NotTo(HaveOccurred())
+
+		dir, err := ioutil.TempDir(``, `dynamic`)
+		Expect(err).NotTo(HaveOccurred())
+		defer func() { _ = os.RemoveAll(dir) }()
+		Expect(ioutil.WriteFile(filepath.Join(dir, `cpaper.pb`), bb, 0600)).NotTo(HaveOccurred())
+
+		fileSet, err := dynamic.LoadFileDescriptorSet(filepath.Join(dir, `cpaper.pb`))
+		Expect(err).NotTo(HaveOccurred())
+
+		fromFile, err := dynamic.New(ccInstance, fileSet, dynamic.WithServices(CPaperService))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fromFile.Services).To(HaveLen(1))
+
+		_, err = dynamic.New(ccInstance, fileSet, dynamic.WithServices(`unknown.Service`))
+		Expect(errors.Is(err, dynamic.ErrServiceNotFound)).To(BeTrue())
+
+		prefixed, err := dynamic.New(ccInstance, fileSet, dynamic.WithServiceNameMethodPrefix())
+		Expect(err).NotTo(HaveOccurred())
+		get, err := prefixed.Method(CPaperService + `.Get`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(get.ChaincodeMethod).To(Equal(`CPaperService.Get`))
+	})
+
+	It("Allow to invoke and query chaincode with dynamic messages", func() {
+		issue, err := dyn.Method(CPaperService + `.Issue`)
+		Expect(err).NotTo(HaveOccurred())
+
+		issued, err := dyn.Invoke(ctx, issue, testdata.Issue1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(toPaper(issued).Owner).To(Equal(testdata.Id1.Issuer))
+
+		get, err := dyn.Method(CPaperService + `.Get`)
+		Expect(err).NotTo(HaveOccurred())
+
+		id := dynamicpb.NewMessage(get.Desc.Input())
+		Expect(protojson.Unmarshal([]byte(`{"issuer":"SomeIssuer","paper_number":"0001"}`), id)).NotTo(HaveOccurred())
+		paper, err := dyn.Invoke(ctx, get, id)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(toPaper(paper).FaceValue).To(Equal(testdata.Issue1.FaceValue))
+
+		_, err = dyn.Invoke(ctx, get, testdata.Issue1)
+		Expect(errors.Is(err, dynamic.ErrInputTypeMismatch)).To(BeTrue())
+	})
+
+	It("Allow to serve methods over JSON/HTTP with google.api.http bindings", func() {
+		mux := runtime.NewServeMux()
+		Expect(dyn.RegisterHandlerServer(mux)).NotTo(HaveOccurred())
+
+		code, paper := serveHTTP(mux, http.MethodGet, `/cpaper/SomeIssuer/0001`, nil)
+		Expect(code).To(Equal(http.StatusOK))
+		Expect(paper).To(HaveKeyWithValue(`owner`, testdata.Id1.Issuer))
+
+		buy, err := protojson.Marshal(testdata.Buy1)
+		Expect(err).NotTo(HaveOccurred())
+		code, paper = serveHTTP(mux, http.MethodPost, `/cpaper/buy`, buy)
+		Expect(code).To(Equal(http.StatusOK))
+		Expect(paper).To(HaveKeyWithValue(`owner`, testdata.Buy1.NewOwner))
+
+		// unknown query parameters are ignored
+		code, list := serveHTTP(mux, http.MethodGet, `/cpaper?page=1`, nil)
+		Expect(code).To(Equal(http.StatusOK))
+		Expect(list[`items`]).To(HaveLen(1))
+
+		code, _ = serveHTTP(mux, http.MethodPost, `/cpaper/buy`, []byte(`{"price":"not a number"}`))
+		Expect(code).To(Equal(http.StatusBadRequest))
+
+		code, _ = serveHTTP(mux, http.MethodGet, `/cpaper/SomeIssuer/0002`, nil)
+		Expect(code).NotTo(Equal(http.StatusOK))
+	})
+
+	It("Allow to serve methods over gRPC and HTTP with gateway server", func() {
+		server, err := gateway.NewServer(gateway.WithServices(dyn.ServiceDefs()...))
+		Expect(err).NotTo(HaveOccurred())
+
+		grpcListener, err := net.Listen(`tcp`, `127.0.0.1:0`)
+		Expect(err).NotTo(HaveOccurred())
+		httpListener, err := net.Listen(`tcp`, `127.0.0.1:0`)
+		Expect(err).NotTo(HaveOccurred())
+
+		serveCtx, cancel := context.WithCancel(ctx)
+		served := make(chan error, 1)
+		go func() {
+			served <- server.Serve(serveCtx, grpcListener, httpListener)
+		}()
+		defer func() {
+			cancel()
+			Eventually(served).Should(Receive(BeNil()))
+		}()
+
+		conn, err := grpc.Dial(grpcListener.Addr().String(), grpc.WithInsecure())
+		Expect(err).NotTo(HaveOccurred())
+		defer func() { _ = conn.Close() }()
+
+		// generated client calls dynamic service
+		paper, err := cpservice.NewCPaperServiceClient(conn).Get(ctx, testdata.Id1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(paper.Owner).To(Equal(testdata.Buy1.NewOwner))
+
+		Eventually(func() int {
+			res, err := http.Get(`http://` + httpListener.Addr().String() + `/cpaper/SomeIssuer/0001`)
+			if err != nil {
+				return 0
+			}
+			_ = res.Body.Close()
+			return res.StatusCode
+		}).Should(Equal(http.StatusOK))
+	})
+})
@@ -0,0 +1,22 @@
+package dynamic
+
+import (
+	"errors"
+)
+
+var (
+	// ErrServiceNotFound occurs when service, required with WithServices option, not exists in descriptors
+	ErrServiceNotFound = errors.New(`service not found`)
+
+	// ErrMethodNotFound occurs when gateway has no method with requested name
+	ErrMethodNotFound = errors.New(`method not found`)
+
+	// ErrInputTypeMismatch occurs when request message type differs from method input type
+	ErrInputTypeMismatch = errors.New(`input type mismatch`)
+
+	// ErrUnsupportedBinding occurs when http binding refers to field, that can't be bound to path, query or body
+	ErrUnsupportedBinding = errors.New(`unsupported http binding`)
+
+	// ErrInvalidParam occurs when path or query parameter value can't be converted to request field type
+	ErrInvalidParam = errors.New(`invalid parameter`)
+)
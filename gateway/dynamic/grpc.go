@@ -0,0 +1,116 @@
+package dynamic
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/s7techlab/cckit/gateway"
+)
+
+// ServiceDefs returns service definitions for gateway server: gRPC services with dynamic messages
+// and grpc-gateway handlers, proxying JSON/HTTP requests to gRPC endpoint
+func (g *Gateway) ServiceDefs() []gateway.Service {
+	var defs []gateway.Service
+	for _, service := range g.Services {
+		defs = append(defs, gateway.NewServiceDef(
+			string(service.Desc.FullName()), nil, g.grpcDesc(service), g, g.registerHandlerFromEndpoint(service)))
+	}
+	return defs
+}
+
+// ServiceDescs returns gRPC service descriptions, services must be registered with gateway as implementation
+func (g *Gateway) ServiceDescs() []*grpc.ServiceDesc {
+	var descs []*grpc.ServiceDesc
+	for _, service := range g.Services {
+		descs = append(descs, g.grpcDesc(service))
+	}
+	return descs
+}
+
+func (g *Gateway) grpcDesc(service *Service) *grpc.ServiceDesc {
+	desc := &grpc.ServiceDesc{
+		ServiceName: string(service.Desc.FullName()),
+		// dynamic service has no Go interface
+		HandlerType: (*interface{})(nil),
+		Streams:     []grpc.StreamDesc{},
+		Metadata:    service.Desc.ParentFile().Path(),
+	}
+
+	for _, method := range service.Methods {
+		desc.Methods = append(desc.Methods, grpc.MethodDesc{
+			MethodName: string(method.Desc.Name()),
+			Handler:    g.grpcHandler(method),
+		})
+	}
+	return desc
+}
+
+func (g *Gateway) grpcHandler(method *Method) func(interface{}, context.Context, func(interface{}) error,
+	grpc.UnaryServerInterceptor) (interface{}, error) {
+	fullMethod := fullMethodName(method)
+
+	return func(_ interface{}, ctx context.Context, dec func(interface{}) error,
+		interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		in := dynamicpb.NewMessage(method.Desc.Input())
+		if err := dec(in); err != nil {
+			return nil, err
+		}
+
+		if interceptor == nil {
+			return g.Invoke(ctx, method, in)
+		}
+
+		info := &grpc.UnaryServerInfo{
+			Server:     g,
+			FullMethod: fullMethod,
+		}
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return g.Invoke(ctx, method, req.(*dynamicpb.Message))
+		}
+		return interceptor(ctx, in, info, handler)
+	}
+}
+
+// registerHandlerFromEndpoint returns grpc-gateway handlers register of service methods,
+// requests are proxied to gRPC endpoint
+func (g *Gateway) registerHandlerFromEndpoint(service *Service) gateway.RegisterHandlerFromEndpoint {
+	return func(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+		conn, err := grpc.Dial(endpoint, opts...)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err != nil {
+				_ = conn.Close()
+				return
+			}
+			go func() {
+				<-ctx.Done()
+				_ = conn.Close()
+			}()
+		}()
+
+		for _, method := range service.Methods {
+			m := method
+			if err = g.registerHandlers(mux, m, func(ctx context.Context, in *dynamicpb.Message) (*dynamicpb.Message, error) {
+				out := dynamicpb.NewMessage(m.Desc.Output())
+				if err := conn.Invoke(ctx, fullMethodName(m), in, out); err != nil {
+					return nil, err
+				}
+				return out, nil
+			}, runtime.AnnotateContext); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// fullMethodName returns gRPC method name, i.e. `/examples.cpaper_asservice.CPaperService/Get`
+func fullMethodName(method *Method) string {
+	return fmt.Sprintf(`/%s/%s`, method.Desc.Parent().FullName(), method.Desc.Name())
+}
@@ -0,0 +1,302 @@
+package dynamic
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/protoc-gen-grpc-gateway/httprule"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+type (
+	// call of method with dynamic messages, directly or via gRPC endpoint
+	call func(ctx context.Context, in *dynamicpb.Message) (*dynamicpb.Message, error)
+
+	// annotate adds http request metadata to context: incoming for direct call and outgoing for gRPC endpoint
+	annotate func(ctx context.Context, mux *runtime.ServeMux, req *http.Request) (context.Context, error)
+)
+
+// RegisterHandlerServer registers JSON/HTTP handlers of methods with google.api.http bindings,
+// chaincode is called directly, without gRPC endpoint
+func (g *Gateway) RegisterHandlerServer(mux *runtime.ServeMux) error {
+	for _, service := range g.Services {
+		for _, method := range service.Methods {
+			m := method
+			if err := g.registerHandlers(mux, m, func(ctx context.Context, in *dynamicpb.Message) (*dynamicpb.Message, error) {
+				return g.Invoke(ctx, m, in)
+			}, runtime.AnnotateIncomingContext); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (g *Gateway) registerHandlers(mux *runtime.ServeMux, method *Method, call call, annotate annotate) error {
+	for _, b := range method.Bindings {
+		pattern, err := b.pattern(method.Desc.Input())
+		if err != nil {
+			return fmt.Errorf(`%w: %s %s %s: %s`, ErrUnsupportedBinding,
+				method.Desc.FullName(), b.HTTPMethod, b.Path, err)
+		}
+
+		mux.Handle(b.HTTPMethod, pattern, handler(mux, method, b, call, annotate))
+	}
+	return nil
+}
+
+func handler(mux *runtime.ServeMux, method *Method, binding *Binding, call call, annotate annotate) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := annotate(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		in, err := binding.request(method.Desc.Input(), inboundMarshaler, req, pathParams)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+
+		out, err := call(rctx, in)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		runtime.ForwardResponseMessage(ctx, mux, outboundMarshaler, w, req, out, mux.GetForwardResponseOptions()...)
+	}
+}
+
+// pattern compiles path template and checks that path and body fields exist in request message
+func (b *Binding) pattern(input protoreflect.MessageDescriptor) (runtime.Pattern, error) {
+	compiler, err := httprule.Parse(b.Path)
+	if err != nil {
+		return runtime.Pattern{}, err
+	}
+	tmpl := compiler.Compile()
+
+	for _, path := range tmpl.Fields {
+		if _, err = fieldByPath(input, path); err != nil {
+			return runtime.Pattern{}, err
+		}
+	}
+
+	if b.Body != `` && b.Body != `*` {
+		field, err := fieldByPath(input, b.Body)
+		if err != nil {
+			return runtime.Pattern{}, err
+		}
+		if field.Message() == nil || field.IsList() || field.IsMap() {
+			return runtime.Pattern{}, fmt.Errorf(`body field %s is not message`, b.Body)
+		}
+	}
+
+	return runtime.NewPattern(1, tmpl.OpCodes, tmpl.Pool, tmpl.Verb)
+}
+
+// request creates method input message from http request body, path and query parameters,
+// query parameters are ignored if whole request is bound to body
+func (b *Binding) request(input protoreflect.MessageDescriptor, marshaler runtime.Marshaler, req *http.Request,
+	pathParams map[string]string) (*dynamicpb.Message, error) {
+	in := dynamicpb.NewMessage(input)
+
+	if b.Body != `` {
+		target := in
+		if b.Body != `*` {
+			field, _ := fieldByPath(input, b.Body)
+			target = in.Mutable(field).Message().Interface().(*dynamicpb.Message)
+		}
+
+		if err := marshaler.NewDecoder(req.Body).Decode(target); err != nil && err != io.EOF {
+			return nil, err
+		}
+	}
+
+	for path, value := range pathParams {
+		if err := setField(in, path, value); err != nil {
+			return nil, err
+		}
+	}
+
+	if b.Body == `*` {
+		return in, nil
+	}
+
+	for path, values := range req.URL.Query() {
+		if b.boundField(path, pathParams) {
+			continue
+		}
+		if _, err := fieldByPath(input, path); err != nil {
+			// unknown query parameters are ignored, like in grpc-gateway
+			continue
+		}
+		if err := setField(in, path, values...); err != nil {
+			return nil, err
+		}
+	}
+
+	return in, nil
+}
+
+// boundField returns true if query parameter refers to field, bound to path or body
+func (b *Binding) boundField(path string, pathParams map[string]string) bool {
+	bound := []string{b.Body}
+	for p := range pathParams {
+		bound = append(bound, p)
+	}
+
+	for _, f := range bound {
+		if f != `` && (path == f || strings.HasPrefix(path, f+`.`)) {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldByPath returns field by dot separated path of proto or json field names, i.e. `paper.issuer`
+func fieldByPath(msg protoreflect.MessageDescriptor, path string) (protoreflect.FieldDescriptor, error) {
+	var field protoreflect.FieldDescriptor
+	for _, name := range strings.Split(path, `.`) {
+		if msg == nil {
+			return nil, fmt.Errorf(`field %s: %s is not message`, path, field.Name())
+		}
+
+		fields := msg.Fields()
+		if field = fields.ByName(protoreflect.Name(name)); field == nil {
+			if field = fields.ByJSONName(name); field == nil {
+				return nil, fmt.Errorf(`field %s not found in %s`, path, msg.FullName())
+			}
+		}
+
+		if field.IsList() || field.IsMap() {
+			msg = nil
+		} else {
+			msg = field.Message()
+		}
+	}
+	return field, nil
+}
+
+// setField sets field from string values, repeated field can have multiple values
+func setField(msg *dynamicpb.Message, path string, values ...string) error {
+	names := strings.Split(path, `.`)
+	var m protoreflect.Message = msg
+
+	for i, name := range names {
+		fields := m.Descriptor().Fields()
+		field := fields.ByName(protoreflect.Name(name))
+		if field == nil {
+			field = fields.ByJSONName(name)
+		}
+		if field == nil || field.IsMap() {
+			return fmt.Errorf(`%w: %s`, ErrInvalidParam, path)
+		}
+
+		if i < len(names)-1 {
+			if field.Message() == nil || field.IsList() {
+				return fmt.Errorf(`%w: %s`, ErrInvalidParam, path)
+			}
+			m = m.Mutable(field).Message()
+			continue
+		}
+
+		if !field.IsList() {
+			if len(values) != 1 {
+				return fmt.Errorf(`%w: %s has %d values`, ErrInvalidParam, path, len(values))
+			}
+			value, err := fieldValue(m, field, values[0])
+			if err != nil {
+				return fmt.Errorf(`%w: %s: %s`, ErrInvalidParam, path, err)
+			}
+			m.Set(field, value)
+			return nil
+		}
+
+		list := m.Mutable(field).List()
+		for _, v := range values {
+			value, err := fieldValue(m, field, v)
+			if err != nil {
+				return fmt.Errorf(`%w: %s: %s`, ErrInvalidParam, path, err)
+			}
+			list.Append(value)
+		}
+	}
+	return nil
+}
+
+// fieldValue converts string to value of field kind,
+// message values (i.e. google.protobuf.Timestamp) are parsed as JSON string
+func fieldValue(m protoreflect.Message, field protoreflect.FieldDescriptor, s string) (protoreflect.Value, error) {
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(s), nil
+
+	case protoreflect.BoolKind:
+		v, err := strconv.ParseBool(s)
+		return protoreflect.ValueOfBool(v), err
+
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		v, err := strconv.ParseInt(s, 10, 32)
+		return protoreflect.ValueOfInt32(int32(v)), err
+
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		v, err := strconv.ParseInt(s, 10, 64)
+		return protoreflect.ValueOfInt64(v), err
+
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		v, err := strconv.ParseUint(s, 10, 32)
+		return protoreflect.ValueOfUint32(uint32(v)), err
+
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		v, err := strconv.ParseUint(s, 10, 64)
+		return protoreflect.ValueOfUint64(v), err
+
+	case protoreflect.FloatKind:
+		v, err := strconv.ParseFloat(s, 32)
+		return protoreflect.ValueOfFloat32(float32(v)), err
+
+	case protoreflect.DoubleKind:
+		v, err := strconv.ParseFloat(s, 64)
+		return protoreflect.ValueOfFloat64(v), err
+
+	case protoreflect.BytesKind:
+		v, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			v, err = base64.URLEncoding.DecodeString(s)
+		}
+		return protoreflect.ValueOfBytes(v), err
+
+	case protoreflect.EnumKind:
+		if v := field.Enum().Values().ByName(protoreflect.Name(s)); v != nil {
+			return protoreflect.ValueOfEnum(v.Number()), nil
+		}
+		v, err := strconv.ParseInt(s, 10, 32)
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(v)), err
+
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		v := m.NewField(field)
+		if field.IsList() {
+			v = protoreflect.ValueOfMessage(dynamicpb.NewMessage(field.Message()))
+		}
+		err := protojson.Unmarshal([]byte(strconv.Quote(s)), v.Message().Interface())
+		return v, err
+	}
+
+	return protoreflect.Value{}, fmt.Errorf(`unsupported kind %s`, field.Kind())
+}
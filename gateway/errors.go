@@ -13,4 +13,40 @@ var (
 
 	// ErrUnknownInvocationType query or invoke
 	ErrUnknownInvocationType = errors.New(`unknown invocation type`)
+
+	// ErrPrincipalNotFound occurs when request has no credentials, principal can be extracted from
+	ErrPrincipalNotFound = errors.New(`principal not found`)
+
+	// ErrUnknownPrincipal occurs when identity store has no signing identity for principal
+	ErrUnknownPrincipal = errors.New(`unknown principal`)
+
+	// ErrCheckpointNotFound occurs when event subscriber has no stored checkpoint
+	ErrCheckpointNotFound = errors.New(`checkpoint not found`)
+
+	// ErrCheckpointInvalid occurs when checkpoint position can't be parsed
+	ErrCheckpointInvalid = errors.New(`checkpoint invalid`)
+
+	// ErrSubscriberIDInvalid occurs when subscriber id is empty or can't be used as checkpoint key
+	ErrSubscriberIDInvalid = errors.New(`subscriber id invalid`)
+
+	// ErrPayloadFilterInvalid occurs when event payload filter expression can't be parsed
+	ErrPayloadFilterInvalid = errors.New(`payload filter invalid`)
+
+	// ErrPayloadFilterNotSupported occurs when payload filter is requested from service without event resolver
+	ErrPayloadFilterNotSupported = errors.New(`payload filter not supported without event resolver`)
+
+	// ErrWebhookResponse occurs when webhook responds with non 2xx status
+	ErrWebhookResponse = errors.New(`webhook response`)
+
+	// ErrTxWaitPolicyNotSupported occurs when transaction wait policy has no sdk tx waiter type
+	ErrTxWaitPolicyNotSupported = errors.New(`transaction wait policy not supported`)
+
+	// ErrTxWaitPolicyInvalid occurs when transaction wait policy params are invalid, i.e. N of M policy with n = 0
+	ErrTxWaitPolicyInvalid = errors.New(`transaction wait policy invalid`)
+
+	// ErrTxStatusNotSupported occurs when SDK doesn't implement sdk.TxStatusReader
+	ErrTxStatusNotSupported = errors.New(`transaction status not supported by sdk`)
+
+	// ErrSwaggerConflict occurs when merged swagger documents contain different values with the same key
+	ErrSwaggerConflict = errors.New(`swagger conflict`)
 )
@@ -0,0 +1,592 @@
+package gateway
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type (
+	// PayloadFilter matches chaincode events by decoded payload fields.
+	// Filter expression consists of comparisons of payload field paths with literals,
+	// combined with &&, || , ! and parentheses:
+	//
+	//	owner == "X" && (amount > 100 || $.meta.tags[0] != null)
+	//
+	// Field paths are relative to payload root, optionally prefixed with `$.`.
+	// Comparison operators: ==, !=, >, >=, <, <=. Literals: "string", number, true, false, null.
+	// Numeric strings (int64 fields in JSON representation of protobuf) are compared as numbers,
+	// integers are compared exactly, other numbers as float64
+	PayloadFilter struct {
+		Expr string
+		root filterExpr
+	}
+
+	// invalidArgumentError error with InvalidArgument gRPC status
+	invalidArgumentError struct {
+		err error
+	}
+
+	filterExpr interface {
+		match(payload interface{}) bool
+	}
+
+	filterOperand interface {
+		value(payload interface{}) interface{}
+	}
+
+	orExpr     []filterExpr
+	andExpr    []filterExpr
+	notExpr    struct{ expr filterExpr }
+	comparison struct {
+		left, right filterOperand
+		op          string
+	}
+
+	pathStep struct {
+		key   string
+		index int
+	}
+	pathOperand    []pathStep
+	literalOperand struct{ v interface{} }
+
+	filterToken struct {
+		kind string // ident, string, number, op
+		text string
+		pos  int
+	}
+
+	filterParser struct {
+		tokens []filterToken
+		pos    int
+	}
+)
+
+// ParsePayloadFilter parses payload filter expression, returns nil filter for empty expression
+func ParsePayloadFilter(expr string) (*PayloadFilter, error) {
+	if strings.TrimSpace(expr) == `` {
+		return nil, nil
+	}
+
+	tokens, err := tokenizeFilter(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &filterParser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.pos < len(p.tokens) {
+		return nil, p.errorf(`unexpected %s`, p.tokens[p.pos].text)
+	}
+
+	return &PayloadFilter{Expr: expr, root: root}, nil
+}
+
+// Match returns true if event payload matches filter. Payload should be decoded to JSON by event resolver
+// (WithEventResolver). Nil filter matches all events
+func (f *PayloadFilter) Match(event *ChaincodeEvent) (bool, error) {
+	if f == nil {
+		return true, nil
+	}
+
+	raw := event.GetPayload().GetValue()
+	if raw == nil {
+		return false, fmt.Errorf(`%w: event payload is not resolved`, ErrPayloadFilterNotSupported)
+	}
+
+	// numbers are decoded as json.Number, so integers can be compared exactly
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	var payload interface{}
+	if err := decoder.Decode(&payload); err != nil {
+		return false, fmt.Errorf(`decode event payload: %w`, err)
+	}
+
+	return f.root.match(payload), nil
+}
+
+// ProcessEventWithFilter processes event and matches it by name and payload filter.
+// If event can't be processed and payload filter is set, nil event is returned with error:
+// filter can't be applied to event and event should not be delivered
+func ProcessEventWithFilter(event interface {
+	Event() *peer.ChaincodeEvent
+	Block() uint64
+	TxTimestamp() *timestamp.Timestamp
+}, opts []EventOpt, matchName []string, filter *PayloadFilter) (*ChaincodeEvent, error) {
+	processedEvent, err := ProcessEvent(event, opts, matchName)
+	if processedEvent == nil {
+		return nil, err
+	}
+
+	if err != nil {
+		if filter != nil {
+			return nil, err
+		}
+		return processedEvent, err
+	}
+
+	match, err := filter.Match(processedEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	if !match {
+		return nil, nil
+	}
+
+	return processedEvent, nil
+}
+
+// payloadFilter parses payload filter of events request. Filter requires event resolver
+// for decoding payload, errors have InvalidArgument gRPC status
+func payloadFilter(expr string, opts *Opts) (*PayloadFilter, error) {
+	filter, err := ParsePayloadFilter(expr)
+	if err == nil && filter != nil && opts.EventResolver == nil {
+		err = ErrPayloadFilterNotSupported
+	}
+
+	if err != nil {
+		return nil, &invalidArgumentError{err: err}
+	}
+
+	return filter, nil
+}
+
+func (e *invalidArgumentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *invalidArgumentError) Unwrap() error {
+	return e.err
+}
+
+// GRPCStatus returns InvalidArgument status, used by gRPC server and grpc-gateway
+func (e *invalidArgumentError) GRPCStatus() *status.Status {
+	return status.New(codes.InvalidArgument, e.err.Error())
+}
+
+func (e orExpr) match(payload interface{}) bool {
+	for _, expr := range e {
+		if expr.match(payload) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e andExpr) match(payload interface{}) bool {
+	for _, expr := range e {
+		if !expr.match(payload) {
+			return false
+		}
+	}
+	return true
+}
+
+func (e notExpr) match(payload interface{}) bool {
+	return !e.expr.match(payload)
+}
+
+func (c comparison) match(payload interface{}) bool {
+	left := c.left.value(payload)
+	if c.op == `` {
+		return truthy(left)
+	}
+
+	right := c.right.value(payload)
+	switch c.op {
+	case `==`:
+		return equal(left, right)
+	case `!=`:
+		return !equal(left, right)
+	}
+
+	if cmp, ok := compareNumbers(left, right); ok {
+		switch c.op {
+		case `>`:
+			return cmp > 0
+		case `>=`:
+			return cmp >= 0
+		case `<`:
+			return cmp < 0
+		case `<=`:
+			return cmp <= 0
+		}
+	}
+
+	l, lok := left.(string)
+	r, rok := right.(string)
+	if !lok || !rok {
+		return false
+	}
+
+	switch c.op {
+	case `>`:
+		return l > r
+	case `>=`:
+		return l >= r
+	case `<`:
+		return l < r
+	case `<=`:
+		return l <= r
+	}
+	return false
+}
+
+func (p pathOperand) value(payload interface{}) interface{} {
+	cur := payload
+	for _, step := range p {
+		switch v := cur.(type) {
+		case map[string]interface{}:
+			if step.key == `` {
+				return nil
+			}
+			cur = v[step.key]
+		case []interface{}:
+			if step.key != `` || step.index < 0 || step.index >= len(v) {
+				return nil
+			}
+			cur = v[step.index]
+		default:
+			return nil
+		}
+	}
+	return cur
+}
+
+func (l literalOperand) value(interface{}) interface{} {
+	return l.v
+}
+
+func truthy(v interface{}) bool {
+	switch val := v.(type) {
+	case nil:
+		return false
+	case bool:
+		return val
+	case json.Number:
+		f, err := val.Float64()
+		return err != nil || f != 0
+	case string:
+		return val != ``
+	}
+	return true
+}
+
+func equal(left, right interface{}) bool {
+	if cmp, ok := compareNumbers(left, right); ok {
+		return cmp == 0
+	}
+	return reflect.DeepEqual(left, right)
+}
+
+// compareNumbers compares operands as numbers if at least one of them is number and other is number
+// or numeric string. Integers are compared exactly, other numbers as float64
+func compareNumbers(left, right interface{}) (int, bool) {
+	_, lNum := left.(json.Number)
+	_, rNum := right.(json.Number)
+	if !lNum && !rNum {
+		return 0, false
+	}
+
+	l, lok := numberText(left)
+	r, rok := numberText(right)
+	if !lok || !rok {
+		return 0, false
+	}
+
+	if li, err := strconv.ParseInt(l, 10, 64); err == nil {
+		if ri, err := strconv.ParseInt(r, 10, 64); err == nil {
+			return compareInt(li < ri, li > ri), true
+		}
+	}
+
+	if lu, err := strconv.ParseUint(l, 10, 64); err == nil {
+		if ru, err := strconv.ParseUint(r, 10, 64); err == nil {
+			return compareInt(lu < ru, lu > ru), true
+		}
+	}
+
+	lf, lErr := strconv.ParseFloat(l, 64)
+	rf, rErr := strconv.ParseFloat(r, 64)
+	if lErr != nil || rErr != nil {
+		return 0, false
+	}
+	return compareInt(lf < rf, lf > rf), true
+}
+
+func numberText(v interface{}) (string, bool) {
+	switch val := v.(type) {
+	case json.Number:
+		return val.String(), true
+	case string:
+		_, err := strconv.ParseFloat(val, 64)
+		return val, err == nil
+	}
+	return ``, false
+}
+
+func compareInt(less, greater bool) int {
+	switch {
+	case less:
+		return -1
+	case greater:
+		return 1
+	}
+	return 0
+}
+
+func tokenizeFilter(expr string) ([]filterToken, error) {
+	var tokens []filterToken
+	runes := []rune(expr)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+		case r == '"':
+			start := i
+			var sb strings.Builder
+			i++
+			for ; i < len(runes) && runes[i] != '"'; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+				}
+				sb.WriteRune(runes[i])
+			}
+			if i >= len(runes) {
+				return nil, fmt.Errorf(`%w: unterminated string at %d`, ErrPayloadFilterInvalid, start)
+			}
+			i++
+			tokens = append(tokens, filterToken{kind: `string`, text: sb.String(), pos: start})
+
+		case unicode.IsDigit(r) || (r == '-' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			start := i
+			i++
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.' || runes[i] == 'e' || runes[i] == 'E') {
+				i++
+			}
+			tokens = append(tokens, filterToken{kind: `number`, text: string(runes[start:i]), pos: start})
+
+		case unicode.IsLetter(r) || r == '_' || r == '$':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_' || runes[i] == '$') {
+				i++
+			}
+			tokens = append(tokens, filterToken{kind: `ident`, text: string(runes[start:i]), pos: start})
+
+		default:
+			start := i
+			op := ``
+			for _, candidate := range []string{`==`, `!=`, `>=`, `<=`, `&&`, `||`, `>`, `<`, `!`, `(`, `)`, `.`, `[`, `]`} {
+				if strings.HasPrefix(string(runes[i:]), candidate) {
+					op = candidate
+					break
+				}
+			}
+			if op == `` {
+				return nil, fmt.Errorf(`%w: unexpected %q at %d`, ErrPayloadFilterInvalid, r, start)
+			}
+			i += len(op)
+			tokens = append(tokens, filterToken{kind: `op`, text: op, pos: start})
+		}
+	}
+
+	return tokens, nil
+}
+
+func (p *filterParser) errorf(format string, args ...interface{}) error {
+	pos := -1
+	if p.pos < len(p.tokens) {
+		pos = p.tokens[p.pos].pos
+	}
+	return fmt.Errorf(`%w: %s at %d`, ErrPayloadFilterInvalid, fmt.Sprintf(format, args...), pos)
+}
+
+func (p *filterParser) peekOp(ops ...string) bool {
+	if p.pos >= len(p.tokens) || p.tokens[p.pos].kind != `op` {
+		return false
+	}
+	for _, op := range ops {
+		if p.tokens[p.pos].text == op {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *filterParser) parseOr() (filterExpr, error) {
+	expr, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	or := orExpr{expr}
+	for p.peekOp(`||`) {
+		p.pos++
+		if expr, err = p.parseAnd(); err != nil {
+			return nil, err
+		}
+		or = append(or, expr)
+	}
+
+	if len(or) == 1 {
+		return or[0], nil
+	}
+	return or, nil
+}
+
+func (p *filterParser) parseAnd() (filterExpr, error) {
+	expr, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	and := andExpr{expr}
+	for p.peekOp(`&&`) {
+		p.pos++
+		if expr, err = p.parseUnary(); err != nil {
+			return nil, err
+		}
+		and = append(and, expr)
+	}
+
+	if len(and) == 1 {
+		return and[0], nil
+	}
+	return and, nil
+}
+
+func (p *filterParser) parseUnary() (filterExpr, error) {
+	switch {
+	case p.peekOp(`!`):
+		p.pos++
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notExpr{expr: expr}, nil
+
+	case p.peekOp(`(`):
+		p.pos++
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.peekOp(`)`) {
+			return nil, p.errorf(`expected )`)
+		}
+		p.pos++
+		return expr, nil
+	}
+
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.peekOp(`==`, `!=`, `>`, `>=`, `<`, `<=`) {
+		return comparison{left: left}, nil
+	}
+
+	op := p.tokens[p.pos].text
+	p.pos++
+	right, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	return comparison{left: left, right: right, op: op}, nil
+}
+
+func (p *filterParser) parseOperand() (filterOperand, error) {
+	if p.pos >= len(p.tokens) {
+		return nil, p.errorf(`unexpected end of expression`)
+	}
+
+	token := p.tokens[p.pos]
+	switch token.kind {
+	case `string`:
+		p.pos++
+		return literalOperand{v: token.text}, nil
+
+	case `number`:
+		if _, err := strconv.ParseFloat(token.text, 64); err != nil {
+			return nil, p.errorf(`invalid number %s`, token.text)
+		}
+		p.pos++
+		return literalOperand{v: json.Number(token.text)}, nil
+
+	case `ident`:
+		switch token.text {
+		case `true`, `false`:
+			p.pos++
+			return literalOperand{v: token.text == `true`}, nil
+		case `null`:
+			p.pos++
+			return literalOperand{v: nil}, nil
+		}
+		return p.parsePath()
+	}
+
+	return nil, p.errorf(`unexpected %s`, token.text)
+}
+
+func (p *filterParser) parsePath() (filterOperand, error) {
+	var path pathOperand
+
+	// optional JSONPath root
+	if p.tokens[p.pos].text == `$` {
+		p.pos++
+		if !p.peekOp(`.`) {
+			return nil, p.errorf(`expected . after $`)
+		}
+		p.pos++
+	}
+
+	for {
+		if p.pos >= len(p.tokens) || p.tokens[p.pos].kind != `ident` {
+			return nil, p.errorf(`expected field name`)
+		}
+		path = append(path, pathStep{key: p.tokens[p.pos].text})
+		p.pos++
+
+		for p.peekOp(`[`) {
+			p.pos++
+			if p.pos >= len(p.tokens) || p.tokens[p.pos].kind != `number` {
+				return nil, p.errorf(`expected array index`)
+			}
+			index, err := strconv.Atoi(p.tokens[p.pos].text)
+			if err != nil {
+				return nil, p.errorf(`invalid array index %s`, p.tokens[p.pos].text)
+			}
+			p.pos++
+			if !p.peekOp(`]`) {
+				return nil, p.errorf(`expected ]`)
+			}
+			p.pos++
+			path = append(path, pathStep{index: index})
+		}
+
+		if !p.peekOp(`.`) {
+			return path, nil
+		}
+		p.pos++
+	}
+}
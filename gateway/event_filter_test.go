@@ -0,0 +1,206 @@
+package gateway_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/hyperledger/fabric-protos-go/peer"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	cpservice "github.com/s7techlab/cckit/examples/cpaper_asservice"
+	"github.com/s7techlab/cckit/examples/cpaper_asservice/testdata"
+	"github.com/s7techlab/cckit/gateway"
+	mappingtestdata "github.com/s7techlab/cckit/state/mapping/testdata"
+	"github.com/s7techlab/cckit/state/mapping/testdata/schema"
+	testcc "github.com/s7techlab/cckit/testing"
+)
+
+type blockEvent struct {
+	event *peer.ChaincodeEvent
+}
+
+func (e *blockEvent) Event() *peer.ChaincodeEvent {
+	return e.event
+}
+
+func (e *blockEvent) Block() uint64 {
+	return 1
+}
+
+func (e *blockEvent) TxTimestamp() *timestamp.Timestamp {
+	return nil
+}
+
+var _ = Describe(`Event payload filter`, func() {
+
+	event := &gateway.ChaincodeEvent{
+		Payload: &gateway.RawJson{Value: []byte(
+			`{"owner":"X","amount":"150","price":99.5,"active":true,"tags":["a","b"],"meta":{"region":"EU"},` +
+				`"big":"9007199254740993","bignum":9007199254740993,"huge":"18446744073709551615"}`)},
+	}
+
+	DescribeTable(`Match decoded payload`,
+		func(expr string, expected bool) {
+			filter, err := gateway.ParsePayloadFilter(expr)
+			Expect(err).NotTo(HaveOccurred())
+
+			match, err := filter.Match(event)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(match).To(Equal(expected))
+		},
+		Entry(`empty filter`, ``, true),
+		Entry(`string equal`, `owner == "X"`, true),
+		Entry(`string not equal`, `owner != "X"`, false),
+		Entry(`int64 as string compared as number`, `amount > 100`, true),
+		Entry(`number`, `price <= 99`, false),
+		Entry(`bool field`, `active`, true),
+		Entry(`negation`, `!active`, false),
+		Entry(`nested field with JSONPath root`, `$.meta.region == "EU"`, true),
+		Entry(`array index`, `tags[1] == "b"`, true),
+		Entry(`missing field is null`, `missing == null`, true),
+		Entry(`and`, `owner == "X" && amount > 200`, false),
+		Entry(`or with parentheses`, `(owner == "Y" || amount >= 150) && active == true`, true),
+		// 2^53 + 1 is not representable as float64
+		Entry(`int64 as string above 2^53 compared exactly`, `big > 9007199254740992`, true),
+		Entry(`int64 as string above 2^53 equal`, `big == 9007199254740993`, true),
+		Entry(`number above 2^53 compared exactly`, `bignum != 9007199254740992`, true),
+		Entry(`number above 2^53 less`, `bignum < 9007199254740993`, false),
+		Entry(`uint64 compared exactly`, `huge > 18446744073709551614`, true),
+		Entry(`integer compared with float`, `amount < 150.5`, true),
+	)
+
+	DescribeTable(`Disallow invalid expression`,
+		func(expr string) {
+			_, err := gateway.ParsePayloadFilter(expr)
+			Expect(errors.Is(err, gateway.ErrPayloadFilterInvalid)).To(BeTrue())
+		},
+		Entry(`unterminated string`, `owner == "X`),
+		Entry(`missing operand`, `owner ==`),
+		Entry(`unbalanced parentheses`, `(owner == "X"`),
+		Entry(`unknown operator`, `owner ~ "X"`),
+		Entry(`trailing tokens`, `owner == "X" "Y"`),
+	)
+
+	Context(`Event services`, func() {
+
+		var (
+			ccService         *gateway.ChaincodeService
+			ccInstanceService *gateway.ChaincodeInstanceService
+			issue2            *cpservice.IssueCommercialPaper
+		)
+
+		It("Init", func() {
+			ccImpl, err := cpservice.NewCC()
+			Expect(err).NotTo(HaveOccurred())
+
+			mockedPeer := testcc.NewPeer().WithChannel(Channel, testcc.NewMockStub(ChaincodeName, ccImpl))
+			ccService = gateway.NewChaincodeService(mockedPeer, gateway.WithEventResolver(cpservice.EventMappings))
+			ccInstanceService = ccService.InstanceService(
+				&gateway.ChaincodeLocator{Channel: Channel, Chaincode: ChaincodeName})
+
+			cPaperGateway := cpservice.NewCPaperServiceGateway(mockedPeer, Channel, ChaincodeName)
+
+			issue2 = proto.Clone(testdata.Issue1).(*cpservice.IssueCommercialPaper)
+			issue2.PaperNumber = `0002`
+			issue2.ExternalId = `EXT0002`
+			issue2.FaceValue = 50000
+
+			for _, issue := range []*cpservice.IssueCommercialPaper{testdata.Issue1, issue2} {
+				_, err = cPaperGateway.Issue(ctx, issue)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			_, err = cPaperGateway.Buy(ctx, testdata.Buy1)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Allow to filter event list by payload", func() {
+			events, err := ccService.Events(ctx, &gateway.ChaincodeEventsRequest{
+				Locator:       &gateway.ChaincodeLocator{Channel: Channel, Chaincode: ChaincodeName},
+				FromBlock:     &gateway.BlockLimit{Num: 0},
+				EventName:     []string{`IssueCommercialPaper`},
+				PayloadFilter: `face_value < 60000`,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events.Items).To(HaveLen(1))
+			Expect(string(events.Items[0].Payload.Value)).To(ContainSubstring(`"paper_number":"0002"`))
+		})
+
+		It("Allow to filter event stream by payload", func() {
+			events, closer, err := ccInstanceService.EventsChan(ctx, &gateway.ChaincodeInstanceEventsStreamRequest{
+				FromBlock:     &gateway.BlockLimit{Num: 0},
+				PayloadFilter: `new_owner == "SomeBuyer" || paper_number == "0002"`,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			defer func() { _ = closer() }()
+
+			var e *gateway.ChaincodeEvent
+			Eventually(events, time.Second).Should(Receive(&e))
+			Expect(e.Event.EventName).To(Equal(`IssueCommercialPaper`))
+
+			Eventually(events, time.Second).Should(Receive(&e))
+			Expect(e.Event.EventName).To(Equal(`BuyCommercialPaper`))
+		})
+
+		It("Allow to match previous version of event by original name", func() {
+			opts := &gateway.Opts{}
+			gateway.WithEventResolver(mappingtestdata.VersionedEventMappings)(opts)
+
+			payload, err := proto.Marshal(&schema.EntityRegisteredV1{Id: `1`, Name: `John Doe`})
+			Expect(err).NotTo(HaveOccurred())
+
+			filter, err := gateway.ParsePayloadFilter(`last_name == "Doe"`)
+			Expect(err).NotTo(HaveOccurred())
+
+			e, err := gateway.ProcessEventWithFilter(&blockEvent{event: &peer.ChaincodeEvent{
+				EventName: mappingtestdata.EntityRegisteredEvent, Payload: payload}},
+				opts.Event, []string{mappingtestdata.EntityRegisteredEvent}, filter)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(e).NotTo(BeNil())
+			Expect(e.Event.EventName).To(Equal(mappingtestdata.EntityRegisteredEvent))
+			Expect(e.ResolvedName).To(Equal(mappingtestdata.EntityRegisteredEvent + `.v3`))
+		})
+
+		It("Disallow to deliver event, payload filter can't be applied to", func() {
+			opts := &gateway.Opts{}
+			gateway.WithEventResolver(cpservice.EventMappings)(opts)
+
+			filter, err := gateway.ParsePayloadFilter(`face_value < 60000`)
+			Expect(err).NotTo(HaveOccurred())
+
+			// payload can't be decoded by resolver
+			e, err := gateway.ProcessEventWithFilter(&blockEvent{event: &peer.ChaincodeEvent{
+				EventName: `IssueCommercialPaper`, Payload: []byte(`not proto`)}}, opts.Event, nil, filter)
+			Expect(err).To(HaveOccurred())
+			Expect(e).To(BeNil())
+		})
+
+		It("Disallow to request events with payload filter from service without event resolver", func() {
+			ccImpl, err := cpservice.NewCC()
+			Expect(err).NotTo(HaveOccurred())
+
+			withoutResolver := gateway.NewChaincodeInstanceEventService(
+				testcc.NewPeer().WithChannel(Channel, testcc.NewMockStub(ChaincodeName, ccImpl)), Channel, ChaincodeName)
+
+			_, err = withoutResolver.Events(ctx, &gateway.ChaincodeInstanceEventsRequest{
+				PayloadFilter: `face_value < 60000`,
+			})
+			Expect(errors.Is(err, gateway.ErrPayloadFilterNotSupported)).To(BeTrue())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+
+		It("Disallow to request events with invalid payload filter", func() {
+			_, err := ccInstanceService.Events(ctx, &gateway.ChaincodeInstanceEventsRequest{
+				PayloadFilter: `face_value >`,
+			})
+			Expect(errors.Is(err, gateway.ErrPayloadFilterInvalid)).To(BeTrue())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+	})
+})
@@ -0,0 +1,214 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/utilities"
+	"go.uber.org/zap"
+	"golang.org/x/net/websocket"
+
+	"github.com/s7techlab/cckit/router"
+)
+
+const (
+	// LastEventIDHeader SSE header with id of last received event, sent by EventSource on reconnect
+	LastEventIDHeader = `Last-Event-ID`
+	// LastEventIDParam query param with id of last received event, for WebSocket and first SSE request
+	LastEventIDParam = `last_event_id`
+)
+
+type (
+	// EventsHTTPHandler serves chaincode events stream over HTTP as Server-Sent Events or as WebSocket
+	// (if request is WebSocket upgrade request). Stream request is populated from query params,
+	// the same as grpc-gateway mapping of EventsStream:
+	//
+	//	/events?locator.channel=my_channel&locator.chaincode=cpaper&event_name=IssueCommercialPaper&payload_filter=...
+	//
+	// Event name and payload filters are applied by events service. Event id is event position {block}:{txIndex}
+	// within filtered stream, stream is resumed after position from Last-Event-ID header or last_event_id query param,
+	// so stream should be resumed with the same filters (EventSource reconnects with the same url)
+	EventsHTTPHandler struct {
+		Events ChaincodeEventsServiceServer
+		Logger *zap.Logger
+	}
+
+	// WebSocketEvent message, sent to WebSocket
+	WebSocketEvent struct {
+		Id    string          `json:"id"`
+		Event json.RawMessage `json:"event"`
+	}
+
+	eventsHTTPStream struct {
+		req   *ChaincodeEventsStreamRequest
+		after *Checkpoint
+	}
+)
+
+var eventJSONMarshaler = &jsonpb.Marshaler{OrigName: true}
+
+func NewEventsHTTPHandler(events ChaincodeEventsServiceServer, logger *zap.Logger) *EventsHTTPHandler {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	return &EventsHTTPHandler{
+		Events: events,
+		Logger: logger,
+	}
+}
+
+func (h *EventsHTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	stream, err := h.streamRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if strings.EqualFold(r.Header.Get(`Upgrade`), `websocket`) {
+		websocket.Server{Handler: func(conn *websocket.Conn) {
+			h.serveWebSocket(conn, stream)
+		}}.ServeHTTP(w, r)
+		return
+	}
+
+	h.serveSSE(w, r, stream)
+}
+
+func (h *EventsHTTPHandler) streamRequest(r *http.Request) (*eventsHTTPStream, error) {
+	values := r.URL.Query()
+	lastEventID := r.Header.Get(LastEventIDHeader)
+	if lastEventID == `` {
+		lastEventID = values.Get(LastEventIDParam)
+	}
+	values.Del(LastEventIDParam)
+
+	req := &ChaincodeEventsStreamRequest{}
+	if err := runtime.PopulateQueryParameters(req, values, utilities.NewDoubleArray(nil)); err != nil {
+		return nil, err
+	}
+
+	if err := router.ValidateRequest(req); err != nil {
+		return nil, err
+	}
+
+	// filter is applied by events service, syntax is checked before streaming
+	if _, err := ParsePayloadFilter(req.PayloadFilter); err != nil {
+		return nil, err
+	}
+
+	stream := &eventsHTTPStream{req: req}
+	if lastEventID != `` {
+		after, err := ParseCheckpoint(lastEventID)
+		if err != nil {
+			return nil, err
+		}
+		stream.after = after
+
+		// stream is resumed from block of last event, if it's after requested from block
+		if req.FromBlock == nil || (req.FromBlock.Num >= 0 && uint64(req.FromBlock.Num) < after.Block) {
+			req.FromBlock = &BlockLimit{Num: int64(after.Block)}
+		}
+	}
+
+	return stream, nil
+}
+
+// events streams events, matched by event name and payload filter, with positions after last event id
+func (h *EventsHTTPHandler) events(ctx context.Context, stream *eventsHTTPStream,
+	send func(position *Checkpoint, event *ChaincodeEvent) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	serverStream := NewChaincodeEventServerStream(ctx)
+	streamErr := make(chan error, 1)
+	go func() {
+		streamErr <- h.Events.EventsStream(stream.req, &ChaincodeEventsServer{ServerStream: serverStream})
+	}()
+
+	var position *Checkpoint
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case err := <-streamErr:
+			return err
+
+		case e := <-serverStream.Events():
+			position = position.Next(e.Block, e.Event.GetTxId())
+			if !position.After(stream.after) {
+				continue
+			}
+
+			if err := send(position, e); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (h *EventsHTTPHandler) serveSSE(w http.ResponseWriter, r *http.Request, stream *eventsHTTPStream) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, `streaming unsupported`, http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(`Content-Type`, `text/event-stream`)
+	w.Header().Set(`Cache-Control`, `no-cache`)
+	w.Header().Set(`Connection`, `keep-alive`)
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	err := h.events(r.Context(), stream, func(position *Checkpoint, e *ChaincodeEvent) error {
+		data, err := eventJSONMarshaler.MarshalToString(e)
+		if err != nil {
+			return err
+		}
+
+		if _, err = fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", position, e.Event.GetEventName(), data); err != nil {
+			return err
+		}
+		flusher.Flush()
+		return nil
+	})
+
+	if err != nil {
+		h.Logger.Warn(`events stream`, zap.Error(err))
+		_, _ = fmt.Fprintf(w, "event: error\ndata: %s\n\n", err)
+		flusher.Flush()
+	}
+}
+
+func (h *EventsHTTPHandler) serveWebSocket(conn *websocket.Conn, stream *eventsHTTPStream) {
+	defer func() { _ = conn.Close() }()
+
+	// connection is closed by client
+	ctx, cancel := context.WithCancel(conn.Request().Context())
+	defer cancel()
+	go func() {
+		var msg []byte
+		for websocket.Message.Receive(conn, &msg) == nil {
+		}
+		cancel()
+	}()
+
+	err := h.events(ctx, stream, func(position *Checkpoint, e *ChaincodeEvent) error {
+		data, err := eventJSONMarshaler.MarshalToString(e)
+		if err != nil {
+			return err
+		}
+
+		return websocket.JSON.Send(conn, &WebSocketEvent{Id: position.String(), Event: json.RawMessage(data)})
+	})
+
+	if err != nil {
+		h.Logger.Warn(`events stream`, zap.Error(err))
+	}
+}
@@ -0,0 +1,205 @@
+package gateway_test
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/websocket"
+
+	cpservice "github.com/s7techlab/cckit/examples/cpaper_asservice"
+	"github.com/s7techlab/cckit/examples/cpaper_asservice/testdata"
+	"github.com/s7techlab/cckit/gateway"
+	testcc "github.com/s7techlab/cckit/testing"
+)
+
+type sseEvent struct {
+	Id, Event, Data string
+}
+
+// readSSE reads n events from Server-Sent Events stream
+func readSSE(res *http.Response, n int) []sseEvent {
+	var (
+		events  []sseEvent
+		current sseEvent
+		scanner = bufio.NewScanner(res.Body)
+	)
+
+	for len(events) < n && scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == ``:
+			events = append(events, current)
+			current = sseEvent{}
+		case strings.HasPrefix(line, `id: `):
+			current.Id = strings.TrimPrefix(line, `id: `)
+		case strings.HasPrefix(line, `event: `):
+			current.Event = strings.TrimPrefix(line, `event: `)
+		case strings.HasPrefix(line, `data: `):
+			current.Data = strings.TrimPrefix(line, `data: `)
+		}
+	}
+	return events
+}
+
+// streamRequestRecorder records events stream request
+type streamRequestRecorder struct {
+	gateway.ChaincodeEventsServiceServer
+	req *gateway.ChaincodeEventsStreamRequest
+}
+
+func (r *streamRequestRecorder) EventsStream(
+	req *gateway.ChaincodeEventsStreamRequest, _ gateway.ChaincodeEventsService_EventsStreamServer) error {
+	r.req = req
+	return nil
+}
+
+var _ = Describe(`Events over HTTP`, func() {
+
+	var (
+		server *httptest.Server
+		query  = func(params ...string) string {
+			values := url.Values{
+				`locator.channel`:   {Channel},
+				`locator.chaincode`: {ChaincodeName},
+				`from_block.num`:    {`0`},
+			}
+			for i := 0; i < len(params); i += 2 {
+				values.Add(params[i], params[i+1])
+			}
+			return `/?` + values.Encode()
+		}
+
+		getSSE = func(path string, header http.Header) *http.Response {
+			req, err := http.NewRequest(http.MethodGet, server.URL+path, nil)
+			Expect(err).NotTo(HaveOccurred())
+			for name, values := range header {
+				req.Header[name] = values
+			}
+
+			res, err := http.DefaultClient.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+			return res
+		}
+	)
+
+	It("Init", func() {
+		ccImpl, err := cpservice.NewCC()
+		Expect(err).NotTo(HaveOccurred())
+
+		mockedPeer := testcc.NewPeer().WithChannel(Channel, testcc.NewMockStub(ChaincodeName, ccImpl))
+		ccService := gateway.NewChaincodeService(mockedPeer, gateway.WithEventResolver(cpservice.EventMappings))
+		server = httptest.NewServer(gateway.NewEventsHTTPHandler(ccService.EventService, nil))
+
+		cPaperGateway := cpservice.NewCPaperServiceGateway(mockedPeer, Channel, ChaincodeName)
+		_, err = cPaperGateway.Issue(ctx, testdata.Issue1)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = cPaperGateway.Buy(ctx, testdata.Buy1)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Allow to stream events as Server-Sent Events", func() {
+		res := getSSE(query(), nil)
+		defer func() { _ = res.Body.Close() }()
+
+		Expect(res.StatusCode).To(Equal(http.StatusOK))
+		Expect(res.Header.Get(`Content-Type`)).To(Equal(`text/event-stream`))
+
+		events := readSSE(res, 2)
+		Expect(events).To(HaveLen(2))
+		Expect(events[0].Id).To(Equal(`55:0`))
+		Expect(events[0].Event).To(Equal(`IssueCommercialPaper`))
+		Expect(events[0].Data).To(ContainSubstring(`"paper_number":"0001"`))
+		Expect(events[1].Id).To(Equal(`55:1`))
+		Expect(events[1].Event).To(Equal(`BuyCommercialPaper`))
+	})
+
+	It("Allow to resume Server-Sent Events stream after Last-Event-ID", func() {
+		res := getSSE(query(), http.Header{gateway.LastEventIDHeader: {`55:0`}})
+		defer func() { _ = res.Body.Close() }()
+
+		events := readSSE(res, 1)
+		Expect(events).To(HaveLen(1))
+		Expect(events[0].Id).To(Equal(`55:1`))
+	})
+
+	It("Allow to filter Server-Sent Events by name and payload", func() {
+		res := getSSE(query(`event_name`, `BuyCommercialPaper`, `payload_filter`, `price > 90000`), nil)
+		defer func() { _ = res.Body.Close() }()
+
+		events := readSSE(res, 1)
+		Expect(events).To(HaveLen(1))
+		Expect(events[0].Event).To(Equal(`BuyCommercialPaper`))
+		// position is counted in filtered stream
+		Expect(events[0].Id).To(Equal(`55:0`))
+	})
+
+	It("Allow to resume filtered Server-Sent Events stream after Last-Event-ID", func() {
+		res := getSSE(query(`event_name`, `BuyCommercialPaper`, `event_name`, `IssueCommercialPaper`),
+			http.Header{gateway.LastEventIDHeader: {`55:0`}})
+		defer func() { _ = res.Body.Close() }()
+
+		events := readSSE(res, 1)
+		Expect(events).To(HaveLen(1))
+		Expect(events[0].Id).To(Equal(`55:1`))
+		Expect(events[0].Event).To(Equal(`BuyCommercialPaper`))
+	})
+
+	It("Allow to resume Server-Sent Events stream from requested block after Last-Event-ID block", func() {
+		events := &streamRequestRecorder{}
+		recorder := httptest.NewServer(gateway.NewEventsHTTPHandler(events, nil))
+		defer recorder.Close()
+
+		for lastEventID, fromBlock := range map[string]int64{`10:0`: 55, `60:1`: 60} {
+			values := url.Values{
+				`locator.channel`:   {Channel},
+				`locator.chaincode`: {ChaincodeName},
+				`from_block.num`:    {`55`},
+			}
+			req, err := http.NewRequest(http.MethodGet, recorder.URL+`/?`+values.Encode(), nil)
+			Expect(err).NotTo(HaveOccurred())
+			req.Header.Set(gateway.LastEventIDHeader, lastEventID)
+
+			res, err := http.DefaultClient.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+			_ = res.Body.Close()
+			Expect(events.req.FromBlock.Num).To(Equal(fromBlock))
+		}
+	})
+
+	It("Allow to stream events over WebSocket", func() {
+		conn, err := websocket.Dial(`ws`+strings.TrimPrefix(server.URL, `http`)+
+			query(gateway.LastEventIDParam, `55:0`), ``, server.URL)
+		Expect(err).NotTo(HaveOccurred())
+		defer func() { _ = conn.Close() }()
+
+		Expect(conn.SetReadDeadline(time.Now().Add(time.Second))).NotTo(HaveOccurred())
+
+		event := &gateway.WebSocketEvent{}
+		Expect(websocket.JSON.Receive(conn, event)).NotTo(HaveOccurred())
+		Expect(event.Id).To(Equal(`55:1`))
+		Expect(string(event.Event)).To(ContainSubstring(`"new_owner":"SomeBuyer"`))
+	})
+
+	It("Disallow invalid stream request", func() {
+		for _, path := range []string{
+			`/?from_block.num=0`,
+			query(`payload_filter`, `price >`),
+			query(gateway.LastEventIDParam, `55`),
+		} {
+			res := getSSE(path, nil)
+			Expect(res.StatusCode).To(Equal(http.StatusBadRequest))
+			_ = res.Body.Close()
+		}
+	})
+
+	It("Close", func() {
+		server.Close()
+	})
+})
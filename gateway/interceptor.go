@@ -0,0 +1,96 @@
+package gateway
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyperledger/fabric-protos-go/peer"
+)
+
+type (
+	// Invocation query or invoke of chaincode method.
+	// Input opts (for example args encryption) are applied to input after all interceptors
+	Invocation struct {
+		Type    InvocationType
+		Locator *ChaincodeLocator
+		Input   *ChaincodeInput
+	}
+
+	// UnaryHandler performs invocation
+	UnaryHandler func(ctx context.Context, invocation *Invocation) (*peer.Response, error)
+
+	// UnaryInterceptor intercepts Query, Invoke and Exec of chaincode services, should call handler to continue
+	UnaryInterceptor func(ctx context.Context, invocation *Invocation, handler UnaryHandler) (*peer.Response, error)
+
+	// EventsStreamInvocation subscription to chaincode events stream
+	EventsStreamInvocation struct {
+		Locator *ChaincodeLocator
+		Request *ChaincodeInstanceEventsStreamRequest
+	}
+
+	// StreamHandler streams events until context done or events channel closed
+	StreamHandler func(ctx context.Context, invocation *EventsStreamInvocation) error
+
+	// StreamInterceptor intercepts EventsStream of chaincode services, should call handler to continue
+	StreamInterceptor func(ctx context.Context, invocation *EventsStreamInvocation, handler StreamHandler) error
+
+	// InvocationObserver receives invocation result with duration
+	InvocationObserver func(ctx context.Context, invocation *Invocation, response *peer.Response,
+		err error, duration time.Duration)
+)
+
+// Method returns chaincode method name - first arg of chaincode input
+func (i *Invocation) Method() string {
+	if len(i.Input.GetArgs()) == 0 {
+		return ``
+	}
+	return string(i.Input.Args[0])
+}
+
+// WithUnaryInterceptor adds interceptors of Query, Invoke and Exec. First added interceptor is outermost
+func WithUnaryInterceptor(interceptors ...UnaryInterceptor) Opt {
+	return func(o *Opts) {
+		o.Unary = append(o.Unary, interceptors...)
+	}
+}
+
+// WithStreamInterceptor adds interceptors of EventsStream. First added interceptor is outermost
+func WithStreamInterceptor(interceptors ...StreamInterceptor) Opt {
+	return func(o *Opts) {
+		o.Stream = append(o.Stream, interceptors...)
+	}
+}
+
+// WithInvocationObserver adds unary interceptor, passing invocation result and duration to observer,
+// for example for logging or metrics
+func WithInvocationObserver(observer InvocationObserver) Opt {
+	return WithUnaryInterceptor(func(ctx context.Context, invocation *Invocation, handler UnaryHandler) (
+		*peer.Response, error) {
+		started := time.Now()
+		response, err := handler(ctx, invocation)
+		observer(ctx, invocation, response, err, time.Since(started))
+		return response, err
+	})
+}
+
+// ChainUnary returns handler, wrapped with interceptors
+func ChainUnary(handler UnaryHandler, interceptors ...UnaryInterceptor) UnaryHandler {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], handler
+		handler = func(ctx context.Context, invocation *Invocation) (*peer.Response, error) {
+			return interceptor(ctx, invocation, next)
+		}
+	}
+	return handler
+}
+
+// ChainStream returns handler, wrapped with interceptors
+func ChainStream(handler StreamHandler, interceptors ...StreamInterceptor) StreamHandler {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], handler
+		handler = func(ctx context.Context, invocation *EventsStreamInvocation) error {
+			return interceptor(ctx, invocation, next)
+		}
+	}
+	return handler
+}
@@ -0,0 +1,135 @@
+package gateway_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/hyperledger/fabric-protos-go/peer"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	cpservice "github.com/s7techlab/cckit/examples/cpaper_asservice"
+	"github.com/s7techlab/cckit/examples/cpaper_asservice/testdata"
+	"github.com/s7techlab/cckit/gateway"
+	testcc "github.com/s7techlab/cckit/testing"
+)
+
+var _ = Describe(`Interceptors`, func() {
+
+	var (
+		ccService     *gateway.ChaincodeService
+		cPaperGateway *cpservice.CPaperServiceGateway
+
+		calls        []string
+		observed     []*gateway.Invocation
+		observedErrs []error
+
+		errDenied = errors.New(`denied`)
+
+		tracer = func(name string) gateway.UnaryInterceptor {
+			return func(ctx context.Context, invocation *gateway.Invocation, handler gateway.UnaryHandler) (
+				*peer.Response, error) {
+				calls = append(calls, name+`:`+invocation.Method())
+				return handler(ctx, invocation)
+			}
+		}
+
+		denyDelete = func(ctx context.Context, invocation *gateway.Invocation, handler gateway.UnaryHandler) (
+			*peer.Response, error) {
+			if invocation.Method() == cpservice.CPaperServiceChaincode_Delete {
+				return nil, errDenied
+			}
+			return handler(ctx, invocation)
+		}
+	)
+
+	It("Init", func() {
+		calls, observed, observedErrs = nil, nil, nil
+
+		ccImpl, err := cpservice.NewCC()
+		Expect(err).NotTo(HaveOccurred())
+
+		mockedPeer := testcc.NewPeer().WithChannel(Channel, testcc.NewMockStub(ChaincodeName, ccImpl))
+		ccService = gateway.NewChaincodeService(mockedPeer, gateway.WithUnaryInterceptor(tracer(`service`)))
+
+		cPaperGateway = cpservice.NewCPaperServiceGateway(mockedPeer, Channel, ChaincodeName,
+			gateway.WithUnaryInterceptor(tracer(`first`), tracer(`second`), denyDelete),
+			gateway.WithInvocationObserver(func(ctx context.Context, invocation *gateway.Invocation,
+				response *peer.Response, err error, duration time.Duration) {
+				Expect(duration).To(BeNumerically(`>`, 0))
+				observed = append(observed, invocation)
+				observedErrs = append(observedErrs, err)
+			}))
+	})
+
+	It("Allow to intercept query and invoke of generated gateway in order", func() {
+		_, err := cPaperGateway.List(ctx, &empty.Empty{})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = cPaperGateway.Issue(ctx, testdata.Issue1)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(calls).To(Equal([]string{
+			`first:` + cpservice.CPaperServiceChaincode_List,
+			`second:` + cpservice.CPaperServiceChaincode_List,
+			`first:` + cpservice.CPaperServiceChaincode_Issue,
+			`second:` + cpservice.CPaperServiceChaincode_Issue,
+		}))
+
+		Expect(observed).To(HaveLen(2))
+		Expect(observed[0].Type).To(Equal(gateway.InvocationType_INVOCATION_TYPE_QUERY))
+		Expect(observed[1].Type).To(Equal(gateway.InvocationType_INVOCATION_TYPE_INVOKE))
+		Expect(observed[1].Locator.Chaincode).To(Equal(ChaincodeName))
+	})
+
+	It("Allow to stop invocation in interceptor", func() {
+		_, err := cPaperGateway.Delete(ctx, testdata.Id1)
+		Expect(errors.Is(err, errDenied)).To(BeTrue())
+
+		// observer added after interceptor, that stops invocation
+		Expect(observed).To(HaveLen(2))
+	})
+
+	It("Allow to set interceptors for chaincode service", func() {
+		calls = nil
+		_, err := ccService.Query(ctx, &gateway.ChaincodeQueryRequest{
+			Locator: &gateway.ChaincodeLocator{Channel: Channel, Chaincode: ChaincodeName},
+			Input:   &gateway.ChaincodeInput{Args: [][]byte{[]byte(cpservice.CPaperServiceChaincode_List), {}}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(calls).To(Equal([]string{`service:` + cpservice.CPaperServiceChaincode_List}))
+	})
+
+	It("Allow to intercept events stream", func(done Done) {
+		var streamed []*gateway.EventsStreamInvocation
+		ccInstance := ccService.InstanceService(
+			&gateway.ChaincodeLocator{Channel: Channel, Chaincode: ChaincodeName},
+			gateway.WithStreamInterceptor(func(ctx context.Context, invocation *gateway.EventsStreamInvocation,
+				handler gateway.StreamHandler) error {
+				streamed = append(streamed, invocation)
+				return handler(ctx, invocation)
+			}))
+
+		ctxWithCancel, cancel := context.WithCancel(ctx)
+		stream := gateway.NewChaincodeEventServerStream(ctxWithCancel)
+
+		go func() {
+			defer GinkgoRecover()
+			err := ccInstance.EventsStream(&gateway.ChaincodeInstanceEventsStreamRequest{
+				FromBlock: &gateway.BlockLimit{Num: 0},
+				ToBlock:   &gateway.BlockLimit{Num: 0},
+			}, &gateway.ChaincodeEventsServer{ServerStream: stream})
+			Expect(err).NotTo(HaveOccurred())
+		}()
+
+		var e *gateway.ChaincodeEvent
+		Expect(stream.Recv(e)).NotTo(HaveOccurred())
+		cancel()
+
+		Expect(streamed).To(HaveLen(1))
+		Expect(streamed[0].Locator.Chaincode).To(Equal(ChaincodeName))
+		close(done)
+	}, 1)
+})
@@ -19,6 +19,16 @@ type (
 		Input   []InputOpt
 		Output  []OutputOpt
 		Event   []EventOpt
+		// EventResolver decodes event payloads, set by WithEventResolver. Payload filter requires resolver
+		EventResolver mapping.EventResolver
+		// SignerResolver resolves signer of request, set by WithSignerResolver
+		SignerResolver SignerResolver
+		// InvokeRetry retries of invoke, failed with retryable error
+		InvokeRetry *InvokeRetryPolicy
+		// Unary interceptors of Query, Invoke and Exec
+		Unary []UnaryInterceptor
+		// Stream interceptors of EventsStream
+		Stream []StreamInterceptor
 	}
 
 	InstanceOpts struct {
@@ -103,11 +113,26 @@ func WithEventDecryption(encKey []byte) Opt {
 	}
 }
 
+// WithEventResolver resolves event payload to json. If resolver supports event versions,
+// event of previous version is rendered as latest version of event, name of latest version is set to ResolvedName.
+// Event name is not changed, so event name filters and cache invalidation rules match original event name
 func WithEventResolver(resolver mapping.EventResolver) Opt {
 	return func(o *Opts) {
+		o.EventResolver = resolver
 		o.Event = append(o.Event, func(e *ChaincodeEvent) error {
-			eventPayload, err := resolver.Resolve(e.Event.EventName, e.Event.Payload)
-			if err != nil {
+			var (
+				eventPayload interface{}
+				err          error
+			)
+
+			if versioned, ok := resolver.(mapping.VersionedEventResolver); ok {
+				var event *mapping.Event
+				if event, err = versioned.ResolveLatest(e.Event.EventName, e.Event.Payload); err != nil {
+					return err
+				}
+				e.ResolvedName = event.Name
+				eventPayload = event.Payload
+			} else if eventPayload, err = resolver.Resolve(e.Event.EventName, e.Event.Payload); err != nil {
 				return err
 			}
 
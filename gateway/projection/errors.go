@@ -0,0 +1,13 @@
+package projection
+
+import (
+	"errors"
+)
+
+var (
+	// ErrEntryNotFound occurs when read model entry not exists in store
+	ErrEntryNotFound = errors.New(`entry not found`)
+
+	// ErrHandlerExists occurs when attempting to register second handler for event
+	ErrHandlerExists = errors.New(`event handler already exists`)
+)
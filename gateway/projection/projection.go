@@ -0,0 +1,273 @@
+// Package projection builds off-chain read models from chaincode events
+package projection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/s7techlab/cckit/gateway"
+	"github.com/s7techlab/cckit/state/mapping"
+)
+
+type (
+	// Projection applies chaincode events to read model store with handlers, registered per event type.
+	// Events are delivered with checkpointed subscription: projection resumes after last applied event
+	Projection struct {
+		Name        string
+		Events      *gateway.ChaincodeInstanceEventService
+		Mappings    mapping.EventMappings
+		Store       Store
+		Checkpoints gateway.CheckpointStore
+		Logger      *zap.Logger
+
+		handlers map[string]Handler
+		// handled base names of events (without version suffix), for events with handler
+		handled       map[string]bool
+		channelHeight ChannelHeight
+		applied       *applied
+	}
+
+	Opt func(*Projection)
+
+	// Handler applies event to read model store
+	Handler func(ctx context.Context, store Store, event *Event) error
+
+	// ChannelHeight returns current channel height (number of blocks)
+	ChannelHeight func(ctx context.Context) (uint64, error)
+
+	// Event chaincode event, resolved with event mappings to latest version of event
+	Event struct {
+		Name string
+		// Payload event payload with mapped schema type, i.e. *IssueCommercialPaper
+		Payload        interface{}
+		Checkpoint     *gateway.Checkpoint
+		ChaincodeEvent *gateway.ChaincodeEvent
+	}
+
+	// Lag of projection from chaincode events
+	Lag struct {
+		// Checkpoint of last applied event, nil if no events applied
+		Checkpoint *gateway.Checkpoint
+		// Height of channel, 0 if projection has no channel height source
+		Height uint64
+		// BlocksSinceApplied blocks since last applied event block, 0 if projection has no channel height source.
+		// Subscription receives only chaincode events, so value grows with channel height
+		// even if there are no new events to apply, it's not a backlog of projection
+		BlocksSinceApplied uint64
+		// Time delay of last applied event: time from event transaction timestamp to event applying,
+		// 0 if no events applied or timestamp unknown
+		Time time.Duration
+	}
+
+	applied struct {
+		delay time.Duration
+		mu    sync.RWMutex
+	}
+)
+
+// New creates projection, name is used as subscriber id of checkpoints store
+func New(name string, events *gateway.ChaincodeInstanceEventService, mappings mapping.EventMappings,
+	store Store, checkpoints gateway.CheckpointStore, opts ...Opt) *Projection {
+	p := &Projection{
+		Name:        name,
+		Events:      events,
+		Mappings:    mappings,
+		Store:       store,
+		Checkpoints: checkpoints,
+		Logger:      zap.NewNop(),
+		handlers:    make(map[string]Handler),
+		handled:     make(map[string]bool),
+		applied:     &applied{},
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
+}
+
+// WithChannelHeight sets channel height source for projection lag in blocks
+func WithChannelHeight(height ChannelHeight) Opt {
+	return func(p *Projection) {
+		p.channelHeight = height
+	}
+}
+
+func WithLogger(logger *zap.Logger) Opt {
+	return func(p *Projection) {
+		p.Logger = logger
+	}
+}
+
+// On registers handler for events with schema type, schema must be mapped in projection event mappings
+func (p *Projection) On(schema interface{}, handler Handler) error {
+	eventMapper, err := p.Mappings.Get(schema)
+	if err != nil {
+		return err
+	}
+
+	name, err := eventMapper.Name(schema)
+	if err != nil {
+		return err
+	}
+
+	if _, exists := p.handlers[name]; exists {
+		return fmt.Errorf(`%w: %s`, ErrHandlerExists, name)
+	}
+
+	p.handlers[name] = handler
+	p.handled[eventBaseName(name)] = true
+	return nil
+}
+
+// Run applies events after stored checkpoint until context done.
+// Returns error if event can't be applied or handled event can't be decoded,
+// event is not acknowledged and will be applied again after restart
+func (p *Projection) Run(ctx context.Context) error {
+	sub, err := p.Events.Subscribe(ctx, p.Name, p.Checkpoints,
+		&gateway.ChaincodeInstanceEventsStreamRequest{FromBlock: &gateway.BlockLimit{Num: 0}})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = sub.Close() }()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case e, ok := <-sub.Events():
+			if !ok {
+				return nil
+			}
+
+			if err = p.apply(ctx, e); err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return fmt.Errorf(`apply event %s: %w`, e.Checkpoint, err)
+			}
+
+			if err = sub.Ack(ctx, e); err != nil {
+				return fmt.Errorf(`ack event: %w`, err)
+			}
+			p.applied.set(e.Event)
+		}
+	}
+}
+
+// Rebuild deletes stored checkpoint, resets store and applies events from block 0.
+// Checkpoint is deleted before store reset, so reset store is never resumed from checkpoint of previous build
+func (p *Projection) Rebuild(ctx context.Context) error {
+	if err := p.Checkpoints.Delete(ctx, p.Name); err != nil {
+		return fmt.Errorf(`delete checkpoint: %w`, err)
+	}
+	p.applied.reset()
+
+	if err := p.Store.Reset(ctx); err != nil {
+		return fmt.Errorf(`reset store: %w`, err)
+	}
+
+	return p.Run(ctx)
+}
+
+// apply resolves event and applies it with event handler, events without handler are skipped.
+// Event with handler, which can't be resolved, is not skipped
+func (p *Projection) apply(ctx context.Context, e *gateway.CheckpointedEvent) error {
+	eventName := e.Event.Event.GetEventName()
+	event, err := p.Mappings.ResolveLatest(eventName, e.Event.Event.GetPayload())
+	if err != nil {
+		if p.handled[eventBaseName(eventName)] {
+			return fmt.Errorf(`resolve event %s: %w`, eventName, err)
+		}
+
+		p.Logger.Warn(`event skipped`, zap.String(`event`, eventName), zap.Error(err))
+		return nil
+	}
+
+	handler, ok := p.handlers[event.Name]
+	if !ok {
+		return nil
+	}
+
+	return handler(ctx, p.Store, &Event{
+		Name:           event.Name,
+		Payload:        event.Payload,
+		Checkpoint:     e.Checkpoint,
+		ChaincodeEvent: e.Event,
+	})
+}
+
+// Lag returns position of projection relative to channel height and time of last applied event
+func (p *Projection) Lag(ctx context.Context) (*Lag, error) {
+	lag := &Lag{}
+
+	checkpoint, err := p.Checkpoints.Get(ctx, p.Name)
+	switch {
+	case err == nil:
+		lag.Checkpoint = checkpoint
+	case !errors.Is(err, gateway.ErrCheckpointNotFound):
+		return nil, err
+	}
+
+	if p.channelHeight != nil {
+		if lag.Height, err = p.channelHeight(ctx); err != nil {
+			return nil, fmt.Errorf(`channel height: %w`, err)
+		}
+
+		applied := uint64(0)
+		if lag.Checkpoint != nil {
+			applied = lag.Checkpoint.Block + 1
+		}
+		if lag.Height > applied {
+			lag.BlocksSinceApplied = lag.Height - applied
+		}
+	}
+
+	lag.Time = p.applied.get()
+
+	return lag, nil
+}
+
+// eventBaseName returns event name without version suffix
+func eventBaseName(name string) string {
+	idx := strings.LastIndex(name, mapping.EventVersionSeparator)
+	if idx < 0 {
+		return name
+	}
+
+	if _, err := strconv.ParseUint(name[idx+len(mapping.EventVersionSeparator):], 10, 32); err != nil {
+		return name
+	}
+	return name[:idx]
+}
+
+func (a *applied) set(e *gateway.ChaincodeEvent) {
+	if e.TxTimestamp == nil {
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.delay = time.Since(e.TxTimestamp.AsTime())
+}
+
+func (a *applied) get() time.Duration {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.delay
+}
+
+func (a *applied) reset() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.delay = 0
+}
@@ -0,0 +1,236 @@
+package projection_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	cpservice "github.com/s7techlab/cckit/examples/cpaper_asservice"
+	"github.com/s7techlab/cckit/examples/cpaper_asservice/testdata"
+	"github.com/s7techlab/cckit/gateway"
+	"github.com/s7techlab/cckit/gateway/projection"
+	idtestdata "github.com/s7techlab/cckit/identity/testdata"
+	"github.com/s7techlab/cckit/router"
+	"github.com/s7techlab/cckit/router/param"
+	testcc "github.com/s7techlab/cckit/testing"
+)
+
+func TestProjection(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Projection suite")
+}
+
+const (
+	Channel       = `my_channel`
+	ChaincodeName = `commercial_paper`
+	Papers        = `papers`
+)
+
+var (
+	ctx = gateway.ContextWithSigner(
+		context.Background(),
+		idtestdata.Certificates[0].MustIdentity(idtestdata.DefaultMSP),
+	)
+)
+
+var _ = Describe(`Projection`, func() {
+
+	var (
+		cPaperGateway *cpservice.CPaperServiceGateway
+		papers        *projection.Projection
+		store         *projection.MemoryStore
+		applied       int32
+
+		paperKey = func(issuer, paperNumber string) string {
+			return issuer + `/` + paperNumber
+		}
+
+		// start runs projection until returned stop func called
+		start = func(run func(context.Context) error) (stop func()) {
+			runCtx, cancel := context.WithCancel(ctx)
+			done := make(chan error, 1)
+			go func() {
+				done <- run(runCtx)
+			}()
+
+			return func() {
+				cancel()
+				Eventually(done, time.Second).Should(Receive(BeNil()))
+			}
+		}
+
+		paperOwner = func() string {
+			paper := &cpservice.CommercialPaper{}
+			if err := store.Get(ctx, Papers, paperKey(testdata.Id1.Issuer, testdata.Id1.PaperNumber), paper); err != nil {
+				return ``
+			}
+			return paper.Owner
+		}
+	)
+
+	It("Init", func() {
+		ccImpl, err := cpservice.NewCC()
+		Expect(err).NotTo(HaveOccurred())
+
+		mockedPeer := testcc.NewPeer().WithChannel(Channel, testcc.NewMockStub(ChaincodeName, ccImpl))
+		cPaperGateway = cpservice.NewCPaperServiceGateway(mockedPeer, Channel, ChaincodeName)
+
+		store = projection.NewMemoryStore()
+		applied = 0
+		papers = projection.New(`papers`,
+			gateway.NewChaincodeInstanceEventService(mockedPeer, Channel, ChaincodeName),
+			cpservice.EventMappings, store, gateway.NewMemoryCheckpointStore(),
+			projection.WithChannelHeight(func(context.Context) (uint64, error) {
+				return testcc.MockedEventsBlock + 1, nil
+			}))
+
+		Expect(papers.On(&cpservice.IssueCommercialPaper{},
+			func(ctx context.Context, store projection.Store, event *projection.Event) error {
+				atomic.AddInt32(&applied, 1)
+				issue := event.Payload.(*cpservice.IssueCommercialPaper)
+				return store.Put(ctx, Papers, paperKey(issue.Issuer, issue.PaperNumber), &cpservice.CommercialPaper{
+					Issuer:      issue.Issuer,
+					PaperNumber: issue.PaperNumber,
+					Owner:       issue.Issuer,
+					FaceValue:   issue.FaceValue,
+					State:       cpservice.CommercialPaper_STATE_ISSUED,
+				})
+			})).NotTo(HaveOccurred())
+
+		Expect(papers.On(&cpservice.BuyCommercialPaper{},
+			func(ctx context.Context, store projection.Store, event *projection.Event) error {
+				atomic.AddInt32(&applied, 1)
+				buy := event.Payload.(*cpservice.BuyCommercialPaper)
+				paper := &cpservice.CommercialPaper{}
+				if err := store.Get(ctx, Papers, paperKey(buy.Issuer, buy.PaperNumber), paper); err != nil {
+					return err
+				}
+				paper.Owner = buy.NewOwner
+				paper.State = cpservice.CommercialPaper_STATE_TRADING
+				return store.Put(ctx, Papers, paperKey(buy.Issuer, buy.PaperNumber), paper)
+			})).NotTo(HaveOccurred())
+
+		_, err = cPaperGateway.Issue(ctx, testdata.Issue1)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Disallow to register handler for unmapped or already handled event", func() {
+		Expect(papers.On(&cpservice.CommercialPaper{}, nil)).To(HaveOccurred())
+		Expect(papers.On(&cpservice.IssueCommercialPaper{}, nil)).To(MatchError(projection.ErrHandlerExists))
+	})
+
+	It("Allow to apply events to read model", func() {
+		lag, err := papers.Lag(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(lag.Checkpoint).To(BeNil())
+		Expect(lag.BlocksSinceApplied).To(BeEquivalentTo(testcc.MockedEventsBlock + 1))
+
+		stop := start(papers.Run)
+		defer stop()
+
+		Eventually(paperOwner, time.Second).Should(Equal(testdata.Id1.Issuer))
+
+		_, err = cPaperGateway.Buy(ctx, testdata.Buy1)
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(paperOwner, time.Second).Should(Equal(testdata.Buy1.NewOwner))
+
+		Eventually(func() uint64 {
+			lag, err = papers.Lag(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			return lag.Checkpoint.TxIndex
+		}, time.Second).Should(BeEquivalentTo(1))
+		Expect(lag.Checkpoint.Block).To(BeEquivalentTo(testcc.MockedEventsBlock))
+		Expect(lag.BlocksSinceApplied).To(BeZero())
+
+		entries, err := store.List(ctx, Papers, &cpservice.CommercialPaper{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+		Expect(atomic.LoadInt32(&applied)).To(BeEquivalentTo(2))
+	})
+
+	It("Allow to resume projection after last applied event", func() {
+		stop := start(papers.Run)
+		Consistently(func() int32 { return atomic.LoadInt32(&applied) }, 100*time.Millisecond).Should(BeEquivalentTo(2))
+		stop()
+	})
+
+	It("Allow to rebuild projection from block 0", func() {
+		Expect(store.Put(ctx, Papers, `stale`, &cpservice.CommercialPaper{})).NotTo(HaveOccurred())
+
+		stop := start(papers.Rebuild)
+		defer stop()
+
+		Eventually(func() int32 { return atomic.LoadInt32(&applied) }, time.Second).Should(BeEquivalentTo(4))
+		Expect(paperOwner()).To(Equal(testdata.Buy1.NewOwner))
+
+		entries, err := store.List(ctx, Papers, &cpservice.CommercialPaper{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+
+		lag, err := papers.Lag(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(lag.Checkpoint.TxIndex).To(BeEquivalentTo(1))
+	})
+
+	It("Disallow to keep checkpoint of previous build on rebuild", func() {
+		checkpoints := gateway.NewMemoryCheckpointStore()
+		Expect(checkpoints.Put(ctx, Papers, &gateway.Checkpoint{Block: testcc.MockedEventsBlock})).NotTo(HaveOccurred())
+
+		failed := projection.New(Papers, papers.Events, cpservice.EventMappings, &resetFailedStore{store}, checkpoints)
+		Expect(failed.Rebuild(ctx)).To(MatchError(ContainSubstring(`reset store`)))
+
+		// store is not reset, but stale checkpoint is deleted
+		lag, err := failed.Lag(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(lag.Checkpoint).To(BeNil())
+	})
+
+	It("Disallow to skip handled event, which can't be decoded", func() {
+		emitter := testcc.NewMockStub(`emitter`, eventEmitterCC())
+		events := gateway.NewChaincodeInstanceEventService(
+			testcc.NewPeer().WithChannel(Channel, emitter), Channel, `emitter`)
+
+		issued := projection.New(Papers, events, cpservice.EventMappings,
+			projection.NewMemoryStore(), gateway.NewMemoryCheckpointStore())
+		Expect(issued.On(&cpservice.IssueCommercialPaper{},
+			func(context.Context, projection.Store, *projection.Event) error { return nil })).NotTo(HaveOccurred())
+
+		// event without handler is skipped
+		Expect(emitter.Invoke(`emit`, `RedeemCommercialPaper`).Status).To(BeEquivalentTo(shim.OK))
+		Expect(emitter.Invoke(`emit`, `IssueCommercialPaper`).Status).To(BeEquivalentTo(shim.OK))
+
+		runCtx, cancel := context.WithTimeout(ctx, time.Second)
+		defer cancel()
+		err := issued.Run(runCtx)
+		Expect(err).To(MatchError(ContainSubstring(`resolve event IssueCommercialPaper`)))
+
+		lag, err := issued.Lag(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(lag.Checkpoint.TxIndex).To(BeEquivalentTo(0))
+	})
+})
+
+// resetFailedStore fails to reset store
+type resetFailedStore struct {
+	*projection.MemoryStore
+}
+
+func (s *resetFailedStore) Reset(context.Context) error {
+	return errors.New(`reset failed`)
+}
+
+// eventEmitterCC emits event with name from arg and payload, which can't be decoded
+func eventEmitterCC() *router.Chaincode {
+	r := router.New(`emitter`)
+	r.Invoke(`emit`, func(c router.Context) (interface{}, error) {
+		return nil, c.Stub().SetEvent(c.ParamString(`name`), []byte(`bad`))
+	}, param.String(`name`))
+
+	return router.NewChaincode(r)
+}
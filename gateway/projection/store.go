@@ -0,0 +1,105 @@
+package projection
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+)
+
+type (
+	// Store of read model entries, written by projection handlers.
+	// Entries are grouped in collections and identified by key within collection
+	Store interface {
+		// Get unmarshals entry to target, returns ErrEntryNotFound if entry not exists
+		Get(ctx context.Context, collection, key string, target proto.Message) error
+		Put(ctx context.Context, collection, key string, entry proto.Message) error
+		Delete(ctx context.Context, collection, key string) error
+		// List returns entries of collection ordered by key, entries are unmarshalled to clones of target
+		List(ctx context.Context, collection string, target proto.Message) ([]proto.Message, error)
+		// Reset deletes all entries, before projection rebuild
+		Reset(ctx context.Context) error
+	}
+
+	// MemoryStore in-memory Store, entries are stored as serialized proto messages
+	MemoryStore struct {
+		collections map[string]map[string][]byte
+		mu          sync.RWMutex
+	}
+)
+
+var _ Store = &MemoryStore{}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		collections: make(map[string]map[string][]byte),
+	}
+}
+
+func (s *MemoryStore) Get(_ context.Context, collection, key string, target proto.Message) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	bb, ok := s.collections[collection][key]
+	if !ok {
+		return fmt.Errorf(`%w: %s/%s`, ErrEntryNotFound, collection, key)
+	}
+
+	return proto.Unmarshal(bb, target)
+}
+
+func (s *MemoryStore) Put(_ context.Context, collection, key string, entry proto.Message) error {
+	bb, err := proto.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.collections[collection]; !ok {
+		s.collections[collection] = make(map[string][]byte)
+	}
+	s.collections[collection][key] = bb
+	return nil
+}
+
+func (s *MemoryStore) Delete(_ context.Context, collection, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.collections[collection], key)
+	return nil
+}
+
+func (s *MemoryStore) List(_ context.Context, collection string, target proto.Message) ([]proto.Message, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	keys := make([]string, 0, len(s.collections[collection]))
+	for key := range s.collections[collection] {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	entries := make([]proto.Message, 0, len(keys))
+	for _, key := range keys {
+		entry := proto.Clone(target)
+		if err := proto.Unmarshal(s.collections[collection][key], entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func (s *MemoryStore) Reset(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.collections = make(map[string]map[string][]byte)
+	return nil
+}
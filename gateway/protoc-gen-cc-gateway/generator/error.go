@@ -3,5 +3,8 @@ package generator
 import "errors"
 
 var (
-	ErrNoTargetService = errors.New("no target service defined in the file")
+	ErrNoTargetService        = errors.New("no target service defined in the file")
+	ErrPKeyFieldsAndSchema    = errors.New("pkey_fields and pkey_schema cannot be used together")
+	ErrMappingMsgOtherPackage = errors.New("message referred in mapping options should be in same go package")
+	ErrFieldNotFound          = errors.New("field not found")
 )
@@ -34,6 +34,16 @@ func New(reg *descriptor.Registry) *Generator {
 func (g *Generator) Generate(targets []*descriptor.File) ([]*plugin.CodeGeneratorResponse_File, error) {
 	var files []*plugin.CodeGeneratorResponse_File
 	for _, file := range targets {
+		if g.Opts.StateMappings {
+			code, err := g.generateMapping(file)
+			if err != nil {
+				return nil, err
+			}
+			if code != nil {
+				files = append(files, code)
+			}
+		}
+
 		if len(file.Services) == 0 {
 			continue
 		}
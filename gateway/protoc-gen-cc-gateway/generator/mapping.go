@@ -0,0 +1,266 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"path"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/generator"
+	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
+	"github.com/grpc-ecosystem/grpc-gateway/protoc-gen-grpc-gateway/descriptor"
+
+	"github.com/s7techlab/cckit/state/schema"
+)
+
+type (
+	MappingTemplateParams struct {
+		*descriptor.File
+		States []*StateMappingParams
+		Events []string
+	}
+
+	// StateMappingParams go type of mapped message and mapping opts expressions
+	StateMappingParams struct {
+		Type string
+		Opts []string
+	}
+)
+
+var mappingTemplate = template.Must(template.New("mapping").Parse(`
+// Code generated by protoc-gen-cc-gateway. DO NOT EDIT.
+// source: {{ .GetName }}
+
+package {{ .GoPkg.Name }}
+
+import (
+	cckit_mapping "github.com/s7techlab/cckit/state/mapping"
+)
+
+{{ if .States }}
+// StateMappings state mappings, declared with (state.schema.state) message options
+var StateMappings = cckit_mapping.StateMappings{}.
+{{ range $i, $s := .States }}{{ if $i }}.
+{{ end }}Add(&{{ $s.Type }}{}, {{ range $s.Opts }}
+	{{ . }},{{ end }}
+){{ end }}
+{{ end }}
+
+{{ if .Events }}
+// EventMappings event mappings, declared with (state.schema.event) message options
+var EventMappings = cckit_mapping.EventMappings{}.
+{{ range $i, $e := .Events }}{{ if $i }}.
+{{ end }}Add(&{{ $e }}{}){{ end }}
+{{ end }}
+`))
+
+// generateMapping generates StateMappings and EventMappings vars from message options,
+// returns nil if file has no mapped messages
+func (g *Generator) generateMapping(file *descriptor.File) (*plugin.CodeGeneratorResponse_File, error) {
+	p, err := g.mappingParams(file)
+	if err != nil || (len(p.States) == 0 && len(p.Events) == 0) {
+		return nil, err
+	}
+
+	w := bytes.NewBuffer(nil)
+	if err = mappingTemplate.Execute(w, p); err != nil {
+		return nil, err
+	}
+
+	formatted, err := format.Source(w.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	name := filepath.Base(file.GetName())
+	base := strings.TrimSuffix(name, filepath.Ext(name))
+
+	basePath := path.Dir(*file.FileDescriptorProto.Name)
+	if !g.Opts.PathsSourceRelative {
+		basePath = file.GoPkg.Path
+	}
+
+	return &plugin.CodeGeneratorResponse_File{
+		Name:    proto.String(filepath.Clean(filepath.Join(basePath, base+`.pb.mapping.go`))),
+		Content: proto.String(string(formatted)),
+	}, nil
+}
+
+func (g *Generator) mappingParams(file *descriptor.File) (*MappingTemplateParams, error) {
+	p := &MappingTemplateParams{File: file}
+
+	for _, msg := range file.Messages {
+		opts := msg.GetOptions()
+		if opts == nil {
+			continue
+		}
+
+		goType := msg.GoType(file.GoPkg.Path)
+
+		if proto.HasExtension(opts, schema.E_State) {
+			ext, err := proto.GetExtension(opts, schema.E_State)
+			if err != nil {
+				return nil, fmt.Errorf(`message %s: %w`, msg.FQMN(), err)
+			}
+
+			mappingOpts, err := g.stateMappingOpts(msg, ext.(*schema.StateMapping))
+			if err != nil {
+				return nil, fmt.Errorf(`message %s: %w`, msg.FQMN(), err)
+			}
+
+			p.States = append(p.States, &StateMappingParams{Type: goType, Opts: mappingOpts})
+		}
+
+		if proto.HasExtension(opts, schema.E_Event) {
+			ext, err := proto.GetExtension(opts, schema.E_Event)
+			if err != nil {
+				return nil, fmt.Errorf(`message %s: %w`, msg.FQMN(), err)
+			}
+
+			if *ext.(*bool) {
+				p.Events = append(p.Events, goType)
+			}
+		}
+	}
+
+	return p, nil
+}
+
+// stateMappingOpts returns cckit_mapping.StateMappingOpt expressions
+func (g *Generator) stateMappingOpts(msg *descriptor.Message, sm *schema.StateMapping) ([]string, error) {
+	var opts []string
+
+	// namespace should be set before primary key schema, primary key schema mapping inherits it
+	if len(sm.Namespace) > 0 {
+		opts = append(opts, fmt.Sprintf(`cckit_mapping.WithNamespace(%s)`, stringsExpr(sm.Namespace)))
+	}
+
+	switch {
+	case sm.PkeySchema != `` && len(sm.PkeyFields) > 0:
+		return nil, ErrPKeyFieldsAndSchema
+
+	case sm.PkeySchema != ``:
+		pkeySchema, err := g.lookupMappingMsg(msg, sm.PkeySchema)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, fmt.Sprintf(`cckit_mapping.PKeySchema(&%s{})`, pkeySchema))
+
+	case len(sm.PkeyFields) > 0:
+		fields, err := goFieldNames(msg, sm.PkeyFields)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, fmt.Sprintf(`cckit_mapping.PKeyAttr(%s)`, strings.Join(quote(fields), `, `)))
+	}
+
+	if sm.List != `` {
+		list, err := g.lookupMappingMsg(msg, sm.List)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, fmt.Sprintf(`cckit_mapping.List(&%s{})`, list))
+	}
+
+	for _, idx := range sm.Indexes {
+		fields, err := goFieldNames(msg, idx.Fields)
+		if err != nil {
+			return nil, fmt.Errorf(`index %s: %w`, idx.Name, err)
+		}
+
+		name := idx.Name
+		if name == `` {
+			name = strings.Join(fields, `-`)
+		}
+
+		opts = append(opts, fmt.Sprintf(
+			`cckit_mapping.WithIndex(&cckit_mapping.StateIndexDef{Name: %q, Fields: %s, Required: %t, Multi: %t, NonUniq: %t})`,
+			name, stringsExpr(fields), idx.Required, idx.Multi, idx.NonUniq))
+	}
+
+	for _, ref := range sm.References {
+		fields, err := goFieldNames(msg, ref.Fields)
+		if err != nil {
+			return nil, fmt.Errorf(`reference %s: %w`, ref.Name, err)
+		}
+
+		to, err := g.lookupMappingMsg(msg, ref.To)
+		if err != nil {
+			return nil, fmt.Errorf(`reference %s: %w`, ref.Name, err)
+		}
+
+		opts = append(opts, fmt.Sprintf(
+			`cckit_mapping.WithReference(&cckit_mapping.StateReferenceDef{Name: %q, Fields: %s, To: &%s{}, OnDelete: %s})`,
+			ref.Name, stringsExpr(fields), to, deletePolicyExpr(ref.OnDelete)))
+	}
+
+	return opts, nil
+}
+
+// lookupMappingMsg returns go type of message, referred in mapping options. Message should be in same go package
+func (g *Generator) lookupMappingMsg(msg *descriptor.Message, name string) (string, error) {
+	location := `.` + msg.File.GetPackage()
+	if !strings.HasPrefix(name, `.`) && strings.Contains(name, `.`) {
+		name = `.` + name
+	}
+
+	m, err := g.reg.LookupMsg(location, name)
+	if err != nil {
+		return ``, err
+	}
+
+	if m.File.GoPkg.Path != msg.File.GoPkg.Path {
+		return ``, fmt.Errorf(`%s: %w`, name, ErrMappingMsgOtherPackage)
+	}
+
+	return m.GoType(msg.File.GoPkg.Path), nil
+}
+
+// goFieldNames converts proto field names to go struct field names
+func goFieldNames(msg *descriptor.Message, names []string) ([]string, error) {
+	var goNames []string
+	for _, name := range names {
+		found := false
+		for _, f := range msg.GetField() {
+			if f.GetName() == name {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf(`%s: %w`, name, ErrFieldNotFound)
+		}
+
+		goNames = append(goNames, generator.CamelCase(name))
+	}
+
+	return goNames, nil
+}
+
+func deletePolicyExpr(policy schema.ReferenceDeletePolicy) string {
+	switch policy {
+	case schema.ReferenceDeletePolicy_REFERENCE_DELETE_POLICY_CASCADE:
+		return `cckit_mapping.RefCascade`
+	case schema.ReferenceDeletePolicy_REFERENCE_DELETE_POLICY_NULLIFY:
+		return `cckit_mapping.RefNullify`
+	default:
+		return `cckit_mapping.RefRestrict`
+	}
+}
+
+func stringsExpr(ss []string) string {
+	return `[]string{` + strings.Join(quote(ss), `, `) + `}`
+}
+
+func quote(ss []string) []string {
+	var quoted []string
+	for _, s := range ss {
+		quoted = append(quoted, fmt.Sprintf(`%q`, s))
+	}
+	return quoted
+}
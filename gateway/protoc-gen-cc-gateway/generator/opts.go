@@ -10,6 +10,7 @@ const (
 	ParamEmbedSwagger                 = `embed_swagger`
 	ParamChaincodeMethodServicePrefix = `service_name_method_prefix`
 	ParamServiceChaincodeResolver     = `service_resolver`
+	ParamStateMappings                = `mappings`
 )
 
 // Opts by default all opts are disabled
@@ -18,6 +19,7 @@ type Opts struct {
 	EmbedSwagger                 bool // generate var with embed annotation to include generated swagger fie
 	ChaincodeMethodServicePrefix bool // add prefix with service name to chaincode method
 	ServiceChaincodeResolver     bool
+	StateMappings                bool // generate StateMappings and EventMappings vars from message options
 }
 
 func isOptEnabled(paramValue string) bool {
@@ -51,6 +53,9 @@ func OptsFromParams(params string) Opts {
 
 		case ParamServiceChaincodeResolver:
 			opts.ServiceChaincodeResolver = isOptEnabled(value)
+
+		case ParamStateMappings:
+			opts.StateMappings = isOptEnabled(value)
 		}
 
 	}
@@ -0,0 +1,387 @@
+package gateway
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"hash"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/hyperledger/fabric/msp"
+)
+
+type (
+	// QueryCache caches chaincode query responses by locator, chaincode method, args, transient data and signer.
+	// Queries without signer are not cached, signer is resolved from context with context opts of service
+	// Entries expire after TTL, least recently used entries are evicted when cache is full,
+	// chaincode events invalidate entries with invalidation rules
+	QueryCache struct {
+		mu      sync.Mutex
+		entries map[string]*list.Element
+		lru     *list.List
+		stats   QueryCacheStats
+		// generations of chaincodes, incremented on each chaincode event, response of query,
+		// started before event, can be stale and is not cached
+		generations map[string]uint64
+
+		ttl        time.Duration
+		maxEntries int
+		rules      []QueryInvalidationRule
+		now        func() time.Time
+	}
+
+	// CachedQuery query, which response is cached
+	CachedQuery struct {
+		Locator *ChaincodeLocator
+		Method  string
+		Args    [][]byte
+	}
+
+	// QueryInvalidationRule returns true if chaincode event invalidates cached query
+	QueryInvalidationRule func(event *ChaincodeEvent, query *CachedQuery) bool
+
+	// QueryCacheStats cache usage statistics
+	QueryCacheStats struct {
+		Hits          uint64
+		Misses        uint64
+		Invalidations uint64
+		Evictions     uint64
+		Entries       int
+	}
+
+	QueryCacheOpt func(*QueryCache)
+
+	queryCacheEntry struct {
+		key      string
+		query    *CachedQuery
+		response *peer.Response
+		expires  time.Time
+	}
+
+	// queriedSigner holds serialized signer of query, set by ChaincodeInstanceService before SDK query,
+	// so cache can check that interceptors, called after cache, don't change signer
+	queriedSigner struct {
+		serialized []byte
+	}
+)
+
+const CtxQueriedSignerKey = contextKey(`QueriedSigner`)
+
+const (
+	DefaultQueryCacheTTL        = time.Minute
+	DefaultQueryCacheMaxEntries = 1000
+)
+
+// NewQueryCache creates query cache, by default with 1 minute TTL and 1000 max entries
+func NewQueryCache(opts ...QueryCacheOpt) *QueryCache {
+	c := &QueryCache{
+		entries:     make(map[string]*list.Element),
+		lru:         list.New(),
+		generations: make(map[string]uint64),
+		ttl:         DefaultQueryCacheTTL,
+		maxEntries:  DefaultQueryCacheMaxEntries,
+		now:         time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// QueryCacheTTL sets time to live of cached responses
+func QueryCacheTTL(ttl time.Duration) QueryCacheOpt {
+	return func(c *QueryCache) {
+		c.ttl = ttl
+	}
+}
+
+// QueryCacheMaxEntries sets max number of cached responses
+func QueryCacheMaxEntries(maxEntries int) QueryCacheOpt {
+	return func(c *QueryCache) {
+		c.maxEntries = maxEntries
+	}
+}
+
+// QueryCacheInvalidation adds rules of invalidation by chaincode events
+func QueryCacheInvalidation(rules ...QueryInvalidationRule) QueryCacheOpt {
+	return func(c *QueryCache) {
+		c.rules = append(c.rules, rules...)
+	}
+}
+
+// InvalidateOn returns rule: event with name invalidates cached queries of chaincode methods,
+// all cached queries of chaincode if methods are not set
+func InvalidateOn(eventName string, methods ...string) QueryInvalidationRule {
+	return func(event *ChaincodeEvent, query *CachedQuery) bool {
+		if event.Event.GetEventName() != eventName {
+			return false
+		}
+
+		if len(methods) == 0 {
+			return true
+		}
+
+		for _, method := range methods {
+			if query.Method == method {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// WithQueryCache caches responses of chaincode queries.
+// Signer for cache key is resolved from context with context opts (for example WithDefaultSigner) of service
+func WithQueryCache(cache *QueryCache) Opt {
+	return func(o *Opts) {
+		o.Unary = append(o.Unary, func(ctx context.Context, invocation *Invocation, handler UnaryHandler) (
+			*peer.Response, error) {
+			signerCtx := ctx
+			for _, c := range o.Context {
+				signerCtx = c(signerCtx)
+			}
+			return cache.intercept(signerCtx, ctx, invocation, handler)
+		})
+	}
+}
+
+// Intercept returns cached response for query or caches successful query response.
+// Query is not cached if signer is not set in context
+func (c *QueryCache) Intercept(ctx context.Context, invocation *Invocation, handler UnaryHandler) (*peer.Response, error) {
+	return c.intercept(ctx, ctx, invocation, handler)
+}
+
+func (c *QueryCache) intercept(signerCtx, ctx context.Context, invocation *Invocation, handler UnaryHandler) (
+	*peer.Response, error) {
+	if invocation.Type != InvocationType_INVOCATION_TYPE_QUERY {
+		return handler(ctx, invocation)
+	}
+
+	signer, err := serializedSigner(signerCtx)
+	if err != nil {
+		// response can depend on signer, so query without signer is not cached
+		return handler(ctx, invocation)
+	}
+
+	key := queryCacheKey(invocation, signer)
+	if response := c.get(key); response != nil {
+		return response, nil
+	}
+
+	// generation is captured before query, event, received during query, can invalidate its response
+	generation := c.generation(invocation.Locator)
+
+	queried := &queriedSigner{}
+	response, err := handler(context.WithValue(ctx, CtxQueriedSignerKey, queried), invocation)
+
+	// signer, actually used for query, can be changed by interceptors, called after cache
+	if queried.serialized != nil && !bytes.Equal(queried.serialized, signer) {
+		return response, err
+	}
+
+	if err == nil && response.GetStatus() == shim.OK {
+		c.put(key, &CachedQuery{
+			Locator: invocation.Locator,
+			Method:  invocation.Method(),
+			Args:    invocation.Input.GetArgs(),
+		}, response, generation)
+	}
+
+	return response, err
+}
+
+// Invalidate removes cached queries of chaincode, invalidated by event with invalidation rules,
+// returns number of removed entries. Responses of chaincode queries, started before event, are not cached
+func (c *QueryCache) Invalidate(locator *ChaincodeLocator, event *ChaincodeEvent) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generations[generationKey(locator)]++
+
+	invalidated := 0
+	for _, el := range c.entries {
+		entry := el.Value.(*queryCacheEntry)
+		if !proto.Equal(entry.query.Locator, locator) {
+			continue
+		}
+
+		for _, rule := range c.rules {
+			if rule(event, entry.query) {
+				c.remove(el)
+				invalidated++
+				break
+			}
+		}
+	}
+
+	c.stats.Invalidations += uint64(invalidated)
+	return invalidated
+}
+
+// InvalidateByEvents invalidates cached queries of chaincode by new events from event service,
+// until context done or closer called
+func (c *QueryCache) InvalidateByEvents(ctx context.Context, events *ChaincodeInstanceEventService) (
+	closer func() error, err error) {
+	eventsChan, closer, err := events.EventsChan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				_ = closer()
+				return
+			case e, ok := <-eventsChan:
+				if !ok {
+					return
+				}
+				c.Invalidate(events.Locator, e)
+			}
+		}
+	}()
+
+	return closer, nil
+}
+
+// Stats returns cache usage statistics
+func (c *QueryCache) Stats() QueryCacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := c.stats
+	stats.Entries = len(c.entries)
+	return stats
+}
+
+func (c *QueryCache) get(key string) *peer.Response {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if ok && c.now().After(el.Value.(*queryCacheEntry).expires) {
+		c.remove(el)
+		ok = false
+	}
+
+	if !ok {
+		c.stats.Misses++
+		return nil
+	}
+
+	c.stats.Hits++
+	c.lru.MoveToFront(el)
+	return proto.Clone(el.Value.(*queryCacheEntry).response).(*peer.Response)
+}
+
+func (c *QueryCache) generation(locator *ChaincodeLocator) uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.generations[generationKey(locator)]
+}
+
+// put caches response, if chaincode generation is not changed since query start
+func (c *QueryCache) put(key string, query *CachedQuery, response *peer.Response, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.generations[generationKey(query.Locator)] != generation {
+		return
+	}
+
+	if el, ok := c.entries[key]; ok {
+		c.remove(el)
+	}
+
+	c.entries[key] = c.lru.PushFront(&queryCacheEntry{
+		key:      key,
+		query:    query,
+		response: proto.Clone(response).(*peer.Response),
+		expires:  c.now().Add(c.ttl),
+	})
+
+	for c.maxEntries > 0 && c.lru.Len() > c.maxEntries {
+		c.remove(c.lru.Back())
+		c.stats.Evictions++
+	}
+}
+
+func (c *QueryCache) remove(el *list.Element) {
+	c.lru.Remove(el)
+	delete(c.entries, el.Value.(*queryCacheEntry).key)
+}
+
+func generationKey(locator *ChaincodeLocator) string {
+	return locator.GetChannel() + `/` + locator.GetChaincode()
+}
+
+func serializedSigner(ctx context.Context) ([]byte, error) {
+	signer, err := SignerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return signer.Serialize()
+}
+
+func setQueriedSigner(ctx context.Context, signer msp.SigningIdentity) {
+	queried, ok := ctx.Value(CtxQueriedSignerKey).(*queriedSigner)
+	if !ok || signer == nil {
+		return
+	}
+
+	if serialized, err := signer.Serialize(); err == nil {
+		queried.serialized = serialized
+	}
+}
+
+// queryCacheKey returns hash of locator, args, transient data and signer, each part is prefixed with length
+func queryCacheKey(invocation *Invocation, signer []byte) string {
+	h := sha256.New()
+	writePart(h, []byte(invocation.Locator.GetChannel()))
+	writePart(h, []byte(invocation.Locator.GetChaincode()))
+	writePart(h, signer)
+
+	args := invocation.Input.GetArgs()
+	writeLen(h, len(args))
+	for _, arg := range args {
+		writePart(h, arg)
+	}
+
+	transient := invocation.Input.GetTransient()
+	keys := make([]string, 0, len(transient))
+	for k := range transient {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	writeLen(h, len(keys))
+	for _, k := range keys {
+		writePart(h, []byte(k))
+		writePart(h, transient[k])
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func writePart(h hash.Hash, part []byte) {
+	writeLen(h, len(part))
+	h.Write(part)
+}
+
+func writeLen(h hash.Hash, l int) {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(l))
+	h.Write(b[:])
+}
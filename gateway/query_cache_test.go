@@ -0,0 +1,273 @@
+package gateway_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/hyperledger/fabric-protos-go/peer"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	cpservice "github.com/s7techlab/cckit/examples/cpaper_asservice"
+	"github.com/s7techlab/cckit/examples/cpaper_asservice/testdata"
+	"github.com/s7techlab/cckit/gateway"
+	idtestdata "github.com/s7techlab/cckit/identity/testdata"
+	testcc "github.com/s7techlab/cckit/testing"
+)
+
+var _ = Describe(`Query cache`, func() {
+
+	var (
+		queryCache    *gateway.QueryCache
+		cPaperGateway *cpservice.CPaperServiceGateway
+		closer        func() error
+
+		queried int
+
+		countQueries = func(ctx context.Context, invocation *gateway.Invocation, handler gateway.UnaryHandler) (
+			*peer.Response, error) {
+			if invocation.Type == gateway.InvocationType_INVOCATION_TYPE_QUERY {
+				queried++
+			}
+			return handler(ctx, invocation)
+		}
+	)
+
+	It("Init", func() {
+		queried = 0
+
+		ccImpl, err := cpservice.NewCC()
+		Expect(err).NotTo(HaveOccurred())
+
+		mockedPeer := testcc.NewPeer().WithChannel(Channel, testcc.NewMockStub(ChaincodeName, ccImpl))
+
+		queryCache = gateway.NewQueryCache(
+			gateway.QueryCacheMaxEntries(2),
+			gateway.QueryCacheInvalidation(
+				gateway.InvalidateOn(`IssueCommercialPaper`, cpservice.CPaperServiceChaincode_List)))
+
+		cPaperGateway = cpservice.NewCPaperServiceGateway(mockedPeer, Channel, ChaincodeName,
+			gateway.WithQueryCache(queryCache),
+			gateway.WithUnaryInterceptor(countQueries))
+
+		closer, err = queryCache.InvalidateByEvents(ctx,
+			gateway.NewChaincodeInstanceEventService(mockedPeer, Channel, ChaincodeName))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Allow to return cached query response", func() {
+		list1, err := cPaperGateway.List(ctx, &empty.Empty{})
+		Expect(err).NotTo(HaveOccurred())
+
+		list2, err := cPaperGateway.List(ctx, &empty.Empty{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(list2).To(Equal(list1))
+		Expect(queried).To(Equal(1))
+
+		stats := queryCache.Stats()
+		Expect(stats.Hits).To(BeEquivalentTo(1))
+		Expect(stats.Misses).To(BeEquivalentTo(1))
+		Expect(stats.Entries).To(Equal(1))
+	})
+
+	It("Disallow to share cached response between signers", func() {
+		otherCtx := gateway.ContextWithSigner(context.Background(),
+			idtestdata.Certificates[1].MustIdentity(idtestdata.DefaultMSP))
+
+		_, err := cPaperGateway.List(otherCtx, &empty.Empty{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(queried).To(Equal(2))
+		Expect(queryCache.Stats().Entries).To(Equal(2))
+	})
+
+	It("Allow to invalidate cached response by chaincode event", func() {
+		_, err := cPaperGateway.Issue(ctx, testdata.Issue1)
+		Expect(err).NotTo(HaveOccurred())
+
+		Eventually(func() int {
+			return queryCache.Stats().Entries
+		}, time.Second).Should(Equal(0))
+		Expect(queryCache.Stats().Invalidations).To(BeEquivalentTo(2))
+
+		list, err := cPaperGateway.List(ctx, &empty.Empty{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.Items).To(HaveLen(1))
+		Expect(queried).To(Equal(3))
+	})
+
+	It("Allow to evict least recently used response when cache is full", func() {
+		_, err := cPaperGateway.Get(ctx, &cpservice.CommercialPaperId{
+			Issuer: testdata.Issue1.Issuer, PaperNumber: testdata.Issue1.PaperNumber})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = cPaperGateway.GetByExternalId(ctx, &cpservice.ExternalId{Id: testdata.Issue1.ExternalId})
+		Expect(err).NotTo(HaveOccurred())
+
+		stats := queryCache.Stats()
+		Expect(stats.Entries).To(Equal(2))
+		Expect(stats.Evictions).To(BeEquivalentTo(1))
+
+		// List response was evicted
+		_, err = cPaperGateway.List(ctx, &empty.Empty{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(queried).To(Equal(6))
+	})
+
+	It("Disallow to return expired response", func() {
+		shortLived := gateway.NewQueryCache(gateway.QueryCacheTTL(time.Millisecond))
+		invocation := &gateway.Invocation{
+			Type:    gateway.InvocationType_INVOCATION_TYPE_QUERY,
+			Locator: &gateway.ChaincodeLocator{Channel: Channel, Chaincode: ChaincodeName},
+			Input:   &gateway.ChaincodeInput{Args: [][]byte{[]byte(cpservice.CPaperServiceChaincode_List)}},
+		}
+		handler := func(context.Context, *gateway.Invocation) (*peer.Response, error) {
+			return &peer.Response{Status: 200}, nil
+		}
+
+		_, err := shortLived.Intercept(ctx, invocation, handler)
+		Expect(err).NotTo(HaveOccurred())
+		time.Sleep(5 * time.Millisecond)
+
+		_, err = shortLived.Intercept(ctx, invocation, handler)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(shortLived.Stats().Misses).To(BeEquivalentTo(2))
+		Expect(shortLived.Stats().Hits).To(BeEquivalentTo(0))
+	})
+
+	It("Disallow to cache response of query, started before invalidating event", func() {
+		cache := gateway.NewQueryCache(gateway.QueryCacheInvalidation(gateway.InvalidateOn(`IssueCommercialPaper`)))
+		locator := &gateway.ChaincodeLocator{Channel: Channel, Chaincode: ChaincodeName}
+		invocation := &gateway.Invocation{
+			Type:    gateway.InvocationType_INVOCATION_TYPE_QUERY,
+			Locator: locator,
+			Input:   &gateway.ChaincodeInput{Args: [][]byte{[]byte(cpservice.CPaperServiceChaincode_List)}},
+		}
+
+		// event is received while query is executed, response can be stale
+		_, err := cache.Intercept(ctx, invocation, func(context.Context, *gateway.Invocation) (*peer.Response, error) {
+			cache.Invalidate(locator, &gateway.ChaincodeEvent{Event: &peer.ChaincodeEvent{EventName: `IssueCommercialPaper`}})
+			return &peer.Response{Status: 200}, nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cache.Stats().Entries).To(Equal(0))
+
+		_, err = cache.Intercept(ctx, invocation, func(context.Context, *gateway.Invocation) (*peer.Response, error) {
+			return &peer.Response{Status: 200}, nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cache.Stats().Entries).To(Equal(1))
+	})
+
+	Context(`Cache key`, func() {
+
+		var (
+			keyCache   *gateway.QueryCache
+			keyGateway *cpservice.CPaperServiceGateway
+
+			signer1 = idtestdata.Certificates[1].MustIdentity(idtestdata.DefaultMSP)
+			signer2 = idtestdata.Certificates[2].MustIdentity(idtestdata.DefaultMSP)
+
+			newGateway = func(opts ...gateway.Opt) {
+				ccImpl, err := cpservice.NewCC()
+				Expect(err).NotTo(HaveOccurred())
+
+				keyCache = gateway.NewQueryCache()
+				keyGateway = cpservice.NewCPaperServiceGateway(
+					testcc.NewPeer().WithChannel(Channel, testcc.NewMockStub(ChaincodeName, ccImpl)),
+					Channel, ChaincodeName,
+					append([]gateway.Opt{gateway.WithQueryCache(keyCache)}, opts...)...)
+				queried = 0
+			}
+
+			list = func(ctx context.Context) {
+				_, err := keyGateway.List(ctx, &empty.Empty{})
+				Expect(err).NotTo(HaveOccurred())
+			}
+		)
+
+		It("Disallow to share cached response between default signer and signers from context", func() {
+			newGateway(gateway.WithDefaultSigner(idtestdata.Certificates[0].MustIdentity(idtestdata.DefaultMSP)),
+				gateway.WithUnaryInterceptor(countQueries))
+
+			list(context.Background())
+			list(gateway.ContextWithSigner(context.Background(), signer1))
+			list(gateway.ContextWithSigner(context.Background(), signer2))
+			Expect(queried).To(Equal(3))
+			Expect(keyCache.Stats().Entries).To(Equal(3))
+
+			list(context.Background())
+			list(gateway.ContextWithSigner(context.Background(), signer1))
+			Expect(queried).To(Equal(3))
+		})
+
+		It("Disallow to cache response if signer is changed by next interceptor", func() {
+			newGateway(gateway.WithUnaryInterceptor(countQueries),
+				gateway.WithUnaryInterceptor(func(ctx context.Context, invocation *gateway.Invocation,
+					handler gateway.UnaryHandler) (*peer.Response, error) {
+					return handler(gateway.ContextWithSigner(ctx, signer2), invocation)
+				}))
+
+			list(gateway.ContextWithSigner(context.Background(), signer1))
+			list(gateway.ContextWithSigner(context.Background(), signer1))
+			Expect(queried).To(Equal(2))
+			Expect(keyCache.Stats().Entries).To(Equal(0))
+		})
+
+		It("Disallow to cache response without signer", func() {
+			cache := gateway.NewQueryCache()
+			invocation := &gateway.Invocation{
+				Type:    gateway.InvocationType_INVOCATION_TYPE_QUERY,
+				Locator: &gateway.ChaincodeLocator{Channel: Channel, Chaincode: ChaincodeName},
+				Input:   &gateway.ChaincodeInput{Args: [][]byte{[]byte(cpservice.CPaperServiceChaincode_List)}},
+			}
+
+			_, err := cache.Intercept(context.Background(), invocation,
+				func(context.Context, *gateway.Invocation) (*peer.Response, error) {
+					return &peer.Response{Status: 200}, nil
+				})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cache.Stats().Entries).To(Equal(0))
+			Expect(cache.Stats().Misses).To(BeEquivalentTo(0))
+		})
+
+		It("Disallow to share cached response between queries with different transient data", func() {
+			newGateway(gateway.WithUnaryInterceptor(countQueries))
+			signerCtx := gateway.ContextWithSigner(context.Background(), signer1)
+
+			list(gateway.ContextWithTransientValue(signerCtx, `key`, []byte(`a`)))
+			list(gateway.ContextWithTransientValue(signerCtx, `key`, []byte(`b`)))
+			Expect(queried).To(Equal(2))
+
+			list(gateway.ContextWithTransientValue(signerCtx, `key`, []byte(`a`)))
+			Expect(queried).To(Equal(2))
+		})
+
+		It("Disallow to share cached response between args with same concatenation", func() {
+			cache := gateway.NewQueryCache()
+			handler := func(context.Context, *gateway.Invocation) (*peer.Response, error) {
+				return &peer.Response{Status: 200}, nil
+			}
+
+			for _, args := range [][][]byte{
+				{[]byte("a\x00"), []byte("b")},
+				{[]byte("a"), []byte("\x00b")},
+			} {
+				_, err := cache.Intercept(ctx, &gateway.Invocation{
+					Type:    gateway.InvocationType_INVOCATION_TYPE_QUERY,
+					Locator: &gateway.ChaincodeLocator{Channel: Channel, Chaincode: ChaincodeName},
+					Input:   &gateway.ChaincodeInput{Args: args},
+				}, handler)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			Expect(cache.Stats().Misses).To(BeEquivalentTo(2))
+			Expect(cache.Stats().Entries).To(Equal(2))
+		})
+	})
+
+	It("Close", func() {
+		Expect(closer()).NotTo(HaveOccurred())
+	})
+})
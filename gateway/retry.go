@@ -0,0 +1,157 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/fabric-protos-go/peer"
+
+	"github.com/s7techlab/cckit/sdk"
+)
+
+type (
+	// InvokeRetryPolicy defines retries of chaincode invoke, failed with retryable error.
+	// Each attempt is a new transaction: proposal is endorsed again with fresh tx id
+	InvokeRetryPolicy struct {
+		// Attempts max number of attempts, including first
+		Attempts int
+		// InitialBackoff delay before second attempt
+		InitialBackoff time.Duration
+		// MaxBackoff max delay between attempts
+		MaxBackoff time.Duration
+		// Multiplier of delay for each next attempt
+		Multiplier float64
+		// Jitter randomizes delay in range [delay * (1 - Jitter), delay * (1 + Jitter)], from 0 to 1, negative - no jitter
+		Jitter float64
+		// Retryable returns true if invoke error can be retried, by default - IsReadConflict
+		Retryable func(err error) bool
+	}
+)
+
+var (
+	// DefaultInvokeRetryPolicy 5 attempts with exponential backoff from 100ms to 2s
+	DefaultInvokeRetryPolicy = InvokeRetryPolicy{
+		Attempts:       5,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     2 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+	}
+
+	// ReadConflictValidationCodes tx validation codes, when tx can be successfully committed on retry
+	ReadConflictValidationCodes = []peer.TxValidationCode{
+		peer.TxValidationCode_MVCC_READ_CONFLICT,
+		peer.TxValidationCode_PHANTOM_READ_CONFLICT,
+	}
+)
+
+// WithInvokeRetry retries chaincode invoke, failed with retryable error, with exponential backoff.
+// Zero fields of policy are set from DefaultInvokeRetryPolicy
+func WithInvokeRetry(policy InvokeRetryPolicy) Opt {
+	return func(o *Opts) {
+		if policy.Attempts == 0 {
+			policy.Attempts = DefaultInvokeRetryPolicy.Attempts
+		}
+		if policy.InitialBackoff == 0 {
+			policy.InitialBackoff = DefaultInvokeRetryPolicy.InitialBackoff
+		}
+		if policy.MaxBackoff == 0 {
+			policy.MaxBackoff = DefaultInvokeRetryPolicy.MaxBackoff
+		}
+		if policy.Multiplier == 0 {
+			policy.Multiplier = DefaultInvokeRetryPolicy.Multiplier
+		}
+		if policy.Jitter == 0 {
+			policy.Jitter = DefaultInvokeRetryPolicy.Jitter
+		}
+		if policy.Retryable == nil {
+			policy.Retryable = IsReadConflict
+		}
+
+		o.InvokeRetry = &policy
+	}
+}
+
+// IsReadConflict returns true if tx is invalidated by MVCC or phantom read conflict.
+// Validation code is taken from sdk.TxValidationError, for SDK without typed errors
+// validation code name (for example `MVCC_READ_CONFLICT`) is searched in error message
+func IsReadConflict(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var txErr *sdk.TxValidationError
+	if errors.As(err, &txErr) {
+		return isReadConflictCode(txErr.Code)
+	}
+
+	for _, code := range ReadConflictValidationCodes {
+		if strings.Contains(err.Error(), code.String()) {
+			return true
+		}
+	}
+	return false
+}
+
+func isReadConflictCode(code peer.TxValidationCode) bool {
+	for _, c := range ReadConflictValidationCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// Backoff returns delay before attempt (attempt numbers start from 1)
+func (p *InvokeRetryPolicy) Backoff(attempt int) time.Duration {
+	if attempt <= 1 {
+		return 0
+	}
+
+	backoff := float64(p.InitialBackoff) * math.Pow(p.Multiplier, float64(attempt-2))
+	if backoff > float64(p.MaxBackoff) {
+		backoff = float64(p.MaxBackoff)
+	}
+
+	if p.Jitter > 0 {
+		backoff = backoff * (1 - p.Jitter + 2*p.Jitter*rand.Float64())
+	}
+
+	return time.Duration(backoff)
+}
+
+// invoke calls invoke func until success, non retryable error, attempts limit or context done
+func (p *InvokeRetryPolicy) invoke(ctx context.Context, invoke func() (*peer.Response, error)) (*peer.Response, error) {
+	var (
+		response *peer.Response
+		err      error
+	)
+
+	for attempt := 1; attempt <= p.Attempts; attempt++ {
+		if attempt > 1 {
+			backoff := p.Backoff(attempt)
+			if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < backoff {
+				return nil, fmt.Errorf(`retry after %s exceeds context deadline: %w`, backoff, err)
+			}
+
+			timer := time.NewTimer(backoff)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return nil, fmt.Errorf(`retry: %s: %w`, ctx.Err(), err)
+			case <-timer.C:
+			}
+		}
+
+		if response, err = invoke(); err == nil || !p.Retryable(err) {
+			return response, err
+		}
+	}
+
+	return nil, fmt.Errorf(`attempts %d: %w`, p.Attempts, err)
+}
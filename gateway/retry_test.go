@@ -0,0 +1,138 @@
+package gateway_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/hyperledger/fabric/msp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	cpservice "github.com/s7techlab/cckit/examples/cpaper_asservice"
+	"github.com/s7techlab/cckit/examples/cpaper_asservice/testdata"
+	"github.com/s7techlab/cckit/gateway"
+	"github.com/s7techlab/cckit/sdk"
+	testcc "github.com/s7techlab/cckit/testing"
+)
+
+var _ = Describe(`Invoke retry`, func() {
+
+	var (
+		errMVCC = errors.New(`transaction invalidated with status (` +
+			peer.TxValidationCode_MVCC_READ_CONFLICT.String() + `)`)
+
+		peerDecorator *testcc.MockedPeerDecorator
+		attempts      int
+		txIDs         []string
+
+		// failInvoke fails first invokes with error, next invokes are passed to mocked peer
+		failInvoke = func(failures int, err error) {
+			attempts = 0
+			txIDs = nil
+			peerDecorator.InvokeMutator = func(
+				sdk sdk.SDK, ctx context.Context, channel string, chaincode string, args [][]byte,
+				identity msp.SigningIdentity, transArgs map[string][]byte, txWaiterType string) (*peer.Response, string, error) {
+				attempts++
+				if attempts <= failures {
+					return nil, ``, err
+				}
+				res, txID, err := sdk.Invoke(ctx, channel, chaincode, args, identity, transArgs, txWaiterType)
+				txIDs = append(txIDs, txID)
+				return res, txID, err
+			}
+		}
+
+		policy = gateway.InvokeRetryPolicy{
+			Attempts:       3,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     5 * time.Millisecond,
+		}
+
+		cPaperGateway *cpservice.CPaperServiceGateway
+	)
+
+	It("Init", func() {
+		ccImpl, err := cpservice.NewCC()
+		Expect(err).NotTo(HaveOccurred())
+
+		peerDecorator = testcc.NewPeerDecorator(
+			testcc.NewPeer().WithChannel(Channel, testcc.NewMockStub(ChaincodeName, ccImpl)))
+		cPaperGateway = cpservice.NewCPaperServiceGateway(peerDecorator, Channel, ChaincodeName,
+			gateway.WithInvokeRetry(policy))
+	})
+
+	It("Allow to retry invoke, failed with read conflict", func() {
+		failInvoke(2, errMVCC)
+
+		_, err := cPaperGateway.Issue(ctx, testdata.Issue1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(attempts).To(Equal(3))
+		Expect(txIDs).To(HaveLen(1))
+	})
+
+	It("Disallow to retry more than policy attempts", func() {
+		failInvoke(3, errMVCC)
+
+		_, err := cPaperGateway.Delete(ctx, testdata.Id1)
+		Expect(errors.Is(err, errMVCC)).To(BeTrue())
+		Expect(attempts).To(Equal(3))
+	})
+
+	It("Disallow to retry not retryable error", func() {
+		failInvoke(1, testcc.ErrPeerInvoke)
+
+		_, err := cPaperGateway.Delete(ctx, testdata.Id1)
+		Expect(errors.Is(err, testcc.ErrPeerInvoke)).To(BeTrue())
+		Expect(attempts).To(Equal(1))
+	})
+
+	It("Disallow to retry after context deadline", func() {
+		failInvoke(1, errMVCC)
+		slowGateway := cpservice.NewCPaperServiceGateway(peerDecorator, Channel, ChaincodeName,
+			gateway.WithInvokeRetry(gateway.InvokeRetryPolicy{InitialBackoff: time.Second}))
+
+		deadlineCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		defer cancel()
+
+		_, err := slowGateway.Delete(deadlineCtx, testdata.Id1)
+		Expect(errors.Is(err, errMVCC)).To(BeTrue())
+		Expect(attempts).To(Equal(1))
+	})
+
+	It("Allow to calculate exponential backoff", func() {
+		p := gateway.InvokeRetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second, Multiplier: 2}
+		Expect(p.Backoff(1)).To(Equal(time.Duration(0)))
+		Expect(p.Backoff(2)).To(Equal(100 * time.Millisecond))
+		Expect(p.Backoff(4)).To(Equal(400 * time.Millisecond))
+		Expect(p.Backoff(10)).To(Equal(time.Second))
+	})
+
+	It("Allow to set zero fields of policy from default policy", func() {
+		opts := &gateway.Opts{}
+		gateway.WithInvokeRetry(gateway.InvokeRetryPolicy{Attempts: 2})(opts)
+		Expect(opts.InvokeRetry.Attempts).To(Equal(2))
+		Expect(opts.InvokeRetry.Jitter).To(Equal(gateway.DefaultInvokeRetryPolicy.Jitter))
+
+		gateway.WithInvokeRetry(gateway.InvokeRetryPolicy{Jitter: -1})(opts)
+		Expect(opts.InvokeRetry.Backoff(2)).To(Equal(gateway.DefaultInvokeRetryPolicy.InitialBackoff))
+	})
+
+	DescribeTable("Detect read conflict",
+		func(err error, expected bool) {
+			Expect(gateway.IsReadConflict(err)).To(Equal(expected))
+		},
+		Entry(`typed mvcc conflict`, &sdk.TxValidationError{Code: peer.TxValidationCode_MVCC_READ_CONFLICT}, true),
+		Entry(`wrapped typed phantom conflict`, fmt.Errorf(`invoke: %w`,
+			&sdk.TxValidationError{Code: peer.TxValidationCode_PHANTOM_READ_CONFLICT}), true),
+		Entry(`typed not conflict`, &sdk.TxValidationError{Code: peer.TxValidationCode_ENDORSEMENT_POLICY_FAILURE}, false),
+		// validation code names, used by SDKs without typed errors, are pinned
+		Entry(`mvcc conflict in message`, errors.New(`transaction invalidated with status (MVCC_READ_CONFLICT)`), true),
+		Entry(`phantom conflict in message`, errors.New(`commit failed: PHANTOM_READ_CONFLICT`), true),
+		Entry(`not conflict in message`, errors.New(`commit failed: ENDORSEMENT_POLICY_FAILURE`), false),
+		Entry(`no error`, nil, false),
+	)
+})
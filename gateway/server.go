@@ -0,0 +1,249 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	DefaultSwaggerPath = `/swagger.json`
+	DefaultHealthPath  = `/healthz`
+	// DefaultShutdownTimeout is time to wait for in-flight requests and streams on shutdown
+	DefaultShutdownTimeout = 10 * time.Second
+)
+
+type (
+	// Server serves gateway services over gRPC and HTTP (grpc-gateway) with merged swagger
+	// document and health service
+	Server struct {
+		Services []Service
+		GRPC     *grpc.Server
+		// GatewayMux grpc-gateway mux, handlers are registered with RegisterGateway
+		GatewayMux *runtime.ServeMux
+		Health     *health.Server
+		Swagger    []byte
+		Logger     *zap.Logger
+
+		opts *serverOpts
+	}
+
+	ServerOpt func(*serverOpts)
+
+	serverOpts struct {
+		services    []Service
+		grpcOpts    []grpc.ServerOption
+		muxOpts     []runtime.ServeMuxOption
+		swaggerInfo SwaggerInfo
+		swaggerPath string
+		healthPath  string
+		logger      *zap.Logger
+		// shutdownTimeout after which in-flight requests and streams are closed
+		shutdownTimeout time.Duration
+	}
+)
+
+// WithServices adds services to server
+func WithServices(services ...Service) ServerOpt {
+	return func(o *serverOpts) {
+		o.services = append(o.services, services...)
+	}
+}
+
+func WithGRPCServerOptions(opts ...grpc.ServerOption) ServerOpt {
+	return func(o *serverOpts) {
+		o.grpcOpts = append(o.grpcOpts, opts...)
+	}
+}
+
+func WithGatewayMuxOptions(opts ...runtime.ServeMuxOption) ServerOpt {
+	return func(o *serverOpts) {
+		o.muxOpts = append(o.muxOpts, opts...)
+	}
+}
+
+// WithSwagger sets info and http path of merged swagger document
+func WithSwagger(info SwaggerInfo, path string) ServerOpt {
+	return func(o *serverOpts) {
+		o.swaggerInfo = info
+		o.swaggerPath = path
+	}
+}
+
+// WithHealthPath sets http path of health check
+func WithHealthPath(path string) ServerOpt {
+	return func(o *serverOpts) {
+		o.healthPath = path
+	}
+}
+
+// WithShutdownTimeout sets time to wait for in-flight requests and streams on shutdown,
+// after timeout HTTP connections are closed and gRPC server is stopped
+func WithShutdownTimeout(timeout time.Duration) ServerOpt {
+	return func(o *serverOpts) {
+		o.shutdownTimeout = timeout
+	}
+}
+
+func WithServerLogger(logger *zap.Logger) ServerOpt {
+	return func(o *serverOpts) {
+		o.logger = logger
+	}
+}
+
+// NewServer registers services on gRPC server, merges services swagger documents
+// and sets serving status of services in health service
+func NewServer(opts ...ServerOpt) (*Server, error) {
+	o := &serverOpts{
+		swaggerInfo:     SwaggerInfo{Title: `cckit gateway`, Version: `version not set`},
+		swaggerPath:     DefaultSwaggerPath,
+		healthPath:      DefaultHealthPath,
+		logger:          zap.NewNop(),
+		shutdownTimeout: DefaultShutdownTimeout,
+	}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	s := &Server{
+		Services:   o.services,
+		GRPC:       grpc.NewServer(o.grpcOpts...),
+		GatewayMux: runtime.NewServeMux(o.muxOpts...),
+		Health:     health.NewServer(),
+		Logger:     o.logger,
+		opts:       o,
+	}
+
+	var swaggers [][]byte
+	for _, service := range s.Services {
+		s.GRPC.RegisterService(service.GRPCDesc(), service.Impl())
+		s.Health.SetServingStatus(service.Name(), grpc_health_v1.HealthCheckResponse_SERVING)
+		swaggers = append(swaggers, service.Swagger())
+	}
+
+	grpc_health_v1.RegisterHealthServer(s.GRPC, s.Health)
+
+	var err error
+	if s.Swagger, err = MergeSwagger(o.swaggerInfo, swaggers...); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// RegisterGateway registers grpc-gateway handlers of services, proxying requests to gRPC endpoint
+func (s *Server) RegisterGateway(ctx context.Context, grpcEndpoint string, opts ...grpc.DialOption) error {
+	for _, service := range s.Services {
+		register := service.GRPCGatewayRegister()
+		if register == nil {
+			continue
+		}
+
+		if err := register(ctx, s.GatewayMux, grpcEndpoint, opts); err != nil {
+			return fmt.Errorf(`register gateway handler of %s: %w`, service.Name(), err)
+		}
+	}
+	return nil
+}
+
+// HTTPHandler returns handler of grpc-gateway mux, swagger document and health check
+func (s *Server) HTTPHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle(`/`, s.GatewayMux)
+
+	mux.HandleFunc(s.opts.swaggerPath, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set(`Content-Type`, `application/json`)
+		_, _ = w.Write(s.Swagger)
+	})
+
+	mux.HandleFunc(s.opts.healthPath, func(w http.ResponseWriter, r *http.Request) {
+		res, err := s.Health.Check(r.Context(), &grpc_health_v1.HealthCheckRequest{Service: r.URL.Query().Get(`service`)})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set(`Content-Type`, `application/json`)
+		if res.Status != grpc_health_v1.HealthCheckResponse_SERVING {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{`status`: res.Status.String()})
+	})
+
+	return mux
+}
+
+// Serve serves gRPC and HTTP on listeners until context done, HTTP requests are proxied to gRPC listener
+func (s *Server) Serve(ctx context.Context, grpcListener, httpListener net.Listener, dialOpts ...grpc.DialOption) error {
+	if len(dialOpts) == 0 {
+		dialOpts = []grpc.DialOption{grpc.WithInsecure()}
+	}
+
+	if err := s.RegisterGateway(ctx, grpcListener.Addr().String(), dialOpts...); err != nil {
+		return err
+	}
+
+	httpServer := &http.Server{Handler: s.HTTPHandler()}
+	errs := make(chan error, 2)
+
+	go func() {
+		errs <- s.GRPC.Serve(grpcListener)
+	}()
+	go func() {
+		if err := httpServer.Serve(httpListener); err != http.ErrServerClosed {
+			errs <- err
+		}
+	}()
+
+	s.Logger.Info(`gateway server started`,
+		zap.String(`grpc`, grpcListener.Addr().String()), zap.String(`http`, httpListener.Addr().String()))
+
+	select {
+	case <-ctx.Done():
+	case err := <-errs:
+		s.Health.Shutdown()
+		_ = httpServer.Close()
+		s.GRPC.Stop()
+		return err
+	}
+
+	s.Health.Shutdown()
+	s.shutdown(httpServer)
+	return nil
+}
+
+// shutdown gracefully stops HTTP and gRPC servers, in-flight requests and streams (i.e. events streams,
+// which never end by itself) are closed after shutdown timeout
+func (s *Server) shutdown(httpServer *http.Server) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.opts.shutdownTimeout)
+	defer cancel()
+
+	if err := httpServer.Shutdown(ctx); err != nil {
+		s.Logger.Warn(`gateway http server shutdown timeout, close connections`, zap.Error(err))
+		_ = httpServer.Close()
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		s.GRPC.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		s.Logger.Warn(`gateway grpc server shutdown timeout, stop server`)
+		s.GRPC.Stop()
+		<-stopped
+	}
+}
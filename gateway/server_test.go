@@ -0,0 +1,163 @@
+package gateway_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	cpservice "github.com/s7techlab/cckit/examples/cpaper_asservice"
+	"github.com/s7techlab/cckit/examples/cpaper_asservice/testdata"
+	"github.com/s7techlab/cckit/gateway"
+	idtestdata "github.com/s7techlab/cckit/identity/testdata"
+	testcc "github.com/s7techlab/cckit/testing"
+)
+
+var _ = Describe(`Server`, func() {
+
+	var (
+		server   *gateway.Server
+		cancel   context.CancelFunc
+		served   chan error
+		grpcAddr string
+		httpURL  string
+		getJSON  = func(path string, expectedStatus int) map[string]interface{} {
+			res, err := http.Get(httpURL + path)
+			Expect(err).NotTo(HaveOccurred())
+			defer func() { _ = res.Body.Close() }()
+			Expect(res.StatusCode).To(Equal(expectedStatus))
+
+			body, err := ioutil.ReadAll(res.Body)
+			Expect(err).NotTo(HaveOccurred())
+
+			doc := make(map[string]interface{})
+			Expect(json.Unmarshal(body, &doc)).NotTo(HaveOccurred())
+			return doc
+		}
+	)
+
+	It("Allow to merge swagger documents with shared definitions", func() {
+		merged, err := gateway.MergeSwagger(gateway.SwaggerInfo{Title: `merged`, Version: `1`},
+			gateway.ChaincodeSwagger, cpservice.CPaperServiceSwagger, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		doc := make(map[string]interface{})
+		Expect(json.Unmarshal(merged, &doc)).NotTo(HaveOccurred())
+		Expect(doc[`info`]).To(HaveKeyWithValue(`title`, `merged`))
+		Expect(doc[`paths`]).To(HaveKey(`/chaincode/exec`))
+		Expect(doc[`paths`]).To(HaveKey(`/cpaper`))
+		Expect(doc[`definitions`]).To(HaveKey(`protobufAny`))
+		Expect(doc[`definitions`]).To(HaveKey(`cckitgatewayChaincodeEvent`))
+		Expect(doc[`definitions`]).To(HaveKey(`cpaper_asserviceCommercialPaper`))
+	})
+
+	It("Disallow to merge swagger documents with conflicting definitions", func() {
+		_, err := gateway.MergeSwagger(gateway.SwaggerInfo{},
+			[]byte(`{"definitions":{"Shared":{"type":"string"}}}`),
+			[]byte(`{"definitions":{"Shared":{"type":"object"}}}`))
+		Expect(errors.Is(err, gateway.ErrSwaggerConflict)).To(BeTrue())
+	})
+
+	It("Allow to build server with multiple services", func() {
+		ccImpl, err := cpservice.NewCC()
+		Expect(err).NotTo(HaveOccurred())
+
+		mockedPeer := testcc.NewPeer().WithChannel(Channel, testcc.NewMockStub(ChaincodeName, ccImpl))
+		ccService := gateway.NewChaincodeService(mockedPeer)
+		cPaperGateway := cpservice.NewCPaperServiceGateway(mockedPeer, Channel, ChaincodeName,
+			gateway.WithDefaultSigner(idtestdata.Certificates[0].MustIdentity(idtestdata.DefaultMSP)))
+
+		server, err = gateway.NewServer(
+			gateway.WithServices(ccService.ServiceDef(), ccService.EventService.ServiceDef(), cPaperGateway.ServiceDef()),
+			gateway.WithSwagger(gateway.SwaggerInfo{Title: `cpaper`, Version: `1.0`}, `/openapi.json`),
+			gateway.WithShutdownTimeout(200*time.Millisecond))
+		Expect(err).NotTo(HaveOccurred())
+
+		grpcListener, err := net.Listen(`tcp`, `127.0.0.1:0`)
+		Expect(err).NotTo(HaveOccurred())
+		httpListener, err := net.Listen(`tcp`, `127.0.0.1:0`)
+		Expect(err).NotTo(HaveOccurred())
+		grpcAddr, httpURL = grpcListener.Addr().String(), `http://`+httpListener.Addr().String()
+
+		var ctx context.Context
+		ctx, cancel = context.WithCancel(context.Background())
+		served = make(chan error, 1)
+		go func() {
+			served <- server.Serve(ctx, grpcListener, httpListener)
+		}()
+	})
+
+	It("Allow to serve merged swagger", func() {
+		doc := getJSON(`/openapi.json`, http.StatusOK)
+		Expect(doc[`info`]).To(HaveKeyWithValue(`title`, `cpaper`))
+		Expect(doc[`paths`]).To(HaveKey(`/chaincode/events`))
+		Expect(doc[`paths`]).To(HaveKey(`/cpaper/{issuer}/{paper_number}`))
+	})
+
+	It("Allow to call services over HTTP", func() {
+		Eventually(func() int {
+			res, err := http.Get(httpURL + `/cpaper`)
+			if err != nil {
+				return 0
+			}
+			_ = res.Body.Close()
+			return res.StatusCode
+		}).Should(Equal(http.StatusOK))
+	})
+
+	It("Allow to check health over HTTP and gRPC", func() {
+		Expect(getJSON(`/healthz`, http.StatusOK)).To(HaveKeyWithValue(`status`, `SERVING`))
+		Expect(getJSON(`/healthz?service=cckit.gateway.ChaincodeService`, http.StatusOK)).
+			To(HaveKeyWithValue(`status`, `SERVING`))
+
+		res, err := http.Get(httpURL + `/healthz?service=unknown`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.StatusCode).To(Equal(http.StatusNotFound))
+		_ = res.Body.Close()
+
+		conn, err := grpc.Dial(grpcAddr, grpc.WithInsecure())
+		Expect(err).NotTo(HaveOccurred())
+		defer func() { _ = conn.Close() }()
+
+		check, err := grpc_health_v1.NewHealthClient(conn).Check(context.Background(),
+			&grpc_health_v1.HealthCheckRequest{Service: `examples.cpaper_asservice.CPaperService`})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(check.Status).To(Equal(grpc_health_v1.HealthCheckResponse_SERVING))
+
+		list, err := cpservice.NewCPaperServiceClient(conn).List(context.Background(), &empty.Empty{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.Items).To(BeEmpty())
+	})
+
+	It("Allow to stop server with open events stream after shutdown timeout", func() {
+		conn, err := grpc.Dial(grpcAddr, grpc.WithInsecure())
+		Expect(err).NotTo(HaveOccurred())
+		defer func() { _ = conn.Close() }()
+
+		_, err = cpservice.NewCPaperServiceClient(conn).Issue(context.Background(), testdata.Issue1)
+		Expect(err).NotTo(HaveOccurred())
+
+		stream, err := gateway.NewChaincodeEventsServiceClient(conn).EventsStream(context.Background(),
+			&gateway.ChaincodeEventsStreamRequest{
+				Locator:   &gateway.ChaincodeLocator{Channel: Channel, Chaincode: ChaincodeName},
+				FromBlock: &gateway.BlockLimit{Num: 0},
+			})
+		Expect(err).NotTo(HaveOccurred())
+
+		// stream is open and never ends by itself
+		_, err = stream.Recv()
+		Expect(err).NotTo(HaveOccurred())
+
+		cancel()
+		Eventually(served, time.Second).Should(Receive(BeNil()))
+	})
+})
@@ -2,11 +2,15 @@ package gateway
 
 import (
 	"context"
+	_ "embed"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"google.golang.org/grpc"
 )
 
+//go:embed chaincode.swagger.json
+var ChaincodeSwagger []byte
+
 type (
 	RegisterHandlerFromEndpoint func(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error)
 
@@ -37,7 +41,11 @@ func NewServiceDef(name string, swagger []byte, desc *grpc.ServiceDesc, service
 	}
 }
 
+// Name returns service name, by default - gRPC service name
 func (s ServiceDef) Name() string {
+	if s.name == `` && s.Desc != nil {
+		return s.Desc.ServiceName
+	}
 	return s.name
 }
 
@@ -0,0 +1,237 @@
+package gateway
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/hyperledger/fabric/msp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	grpcpeer "google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	AuthorizationHeader = `authorization`
+	BearerPrefix        = `Bearer `
+)
+
+type (
+	// Principal authenticated caller of gateway
+	Principal struct {
+		// ID of principal in identity store: certificate common name, token subject or header value
+		ID string
+		// Cert client TLS certificate, if principal is extracted from mTLS connection
+		Cert *x509.Certificate
+	}
+
+	// PrincipalExtractor extracts principal from request context, returns ErrPrincipalNotFound
+	// if request has no appropriate credentials
+	PrincipalExtractor func(ctx context.Context) (*Principal, error)
+
+	// TokenVerifier verifies bearer token and returns token subject
+	TokenVerifier func(ctx context.Context, token string) (subject string, err error)
+
+	// IdentityStore returns signing identity of principal, ErrUnknownPrincipal if principal is not known
+	IdentityStore interface {
+		SigningIdentity(ctx context.Context, principal *Principal) (msp.SigningIdentity, error)
+	}
+
+	// SignerResolver returns signing identity for request
+	SignerResolver interface {
+		ResolveSigner(ctx context.Context) (msp.SigningIdentity, error)
+	}
+
+	// PrincipalSignerResolver extracts principal with first matched extractor and gets its identity from store
+	PrincipalSignerResolver struct {
+		Store      IdentityStore
+		Extractors []PrincipalExtractor
+	}
+
+	// signerResolveError error of signer resolving with Unauthenticated or PermissionDenied gRPC status
+	signerResolveError struct {
+		err error
+	}
+)
+
+// NewSignerResolver creates signer resolver, extractors are tried in order
+func NewSignerResolver(store IdentityStore, extractors ...PrincipalExtractor) *PrincipalSignerResolver {
+	return &PrincipalSignerResolver{
+		Store:      store,
+		Extractors: extractors,
+	}
+}
+
+func (r *PrincipalSignerResolver) ResolveSigner(ctx context.Context) (msp.SigningIdentity, error) {
+	for _, extract := range r.Extractors {
+		principal, err := extract(ctx)
+		if errors.Is(err, ErrPrincipalNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return r.Store.SigningIdentity(ctx, principal)
+	}
+
+	return nil, ErrPrincipalNotFound
+}
+
+// WithSignerResolver resolves signing identity for every request: query, invoke, events (stream, list,
+// subscription) and transaction status. Signer, already set in context with ContextWithSigner, is not overridden,
+// request without principal is served with default signer (WithDefaultSigner), if it's set.
+// Resolve errors have gRPC status: PermissionDenied for unknown principal, Unauthenticated for others
+func WithSignerResolver(resolver SignerResolver) Opt {
+	return func(o *Opts) {
+		o.SignerResolver = resolver
+
+		WithUnaryInterceptor(func(ctx context.Context, invocation *Invocation, handler UnaryHandler) (
+			*peer.Response, error) {
+			ctx, err := o.resolveSigner(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return handler(ctx, invocation)
+		})(o)
+
+		WithStreamInterceptor(func(ctx context.Context, invocation *EventsStreamInvocation, handler StreamHandler) error {
+			ctx, err := o.resolveSigner(ctx)
+			if err != nil {
+				return err
+			}
+			return handler(ctx, invocation)
+		})(o)
+	}
+}
+
+// resolveSigner sets signer, resolved with signer resolver, to context
+func (o *Opts) resolveSigner(ctx context.Context) (context.Context, error) {
+	if o.SignerResolver == nil {
+		return ctx, nil
+	}
+
+	if _, err := SignerFromContext(ctx); err == nil {
+		return ctx, nil
+	}
+
+	signer, err := o.SignerResolver.ResolveSigner(ctx)
+	switch {
+	case err == nil:
+		return ContextWithSigner(ctx, signer), nil
+
+	// default signer is set to context by context opts
+	case errors.Is(err, ErrPrincipalNotFound) && o.hasDefaultSigner(ctx):
+		return ctx, nil
+
+	default:
+		return nil, &signerResolveError{err: fmt.Errorf(`resolve signer: %w`, err)}
+	}
+}
+
+func (o *Opts) hasDefaultSigner(ctx context.Context) bool {
+	for _, c := range o.Context {
+		ctx = c(ctx)
+	}
+	_, err := SignerFromContext(ctx)
+	return err == nil
+}
+
+// signerContext returns context with signer, resolved with signer resolver or set by context opts,
+// for requests, which are not intercepted
+func (o *Opts) signerContext(ctx context.Context) (context.Context, error) {
+	ctx, err := o.resolveSigner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range o.Context {
+		ctx = c(ctx)
+	}
+	return ctx, nil
+}
+
+func (e *signerResolveError) Error() string {
+	return e.err.Error()
+}
+
+func (e *signerResolveError) Unwrap() error {
+	return e.err
+}
+
+// GRPCStatus returns PermissionDenied status for unknown principal and Unauthenticated for others,
+// used by gRPC server and grpc-gateway
+func (e *signerResolveError) GRPCStatus() *status.Status {
+	if errors.Is(e.err, ErrUnknownPrincipal) {
+		return status.New(codes.PermissionDenied, e.err.Error())
+	}
+	return status.New(codes.Unauthenticated, e.err.Error())
+}
+
+// PrincipalFromTLSCert extracts principal from verified client certificate of mTLS gRPC connection,
+// principal ID is certificate subject common name
+func PrincipalFromTLSCert() PrincipalExtractor {
+	return func(ctx context.Context) (*Principal, error) {
+		p, ok := grpcpeer.FromContext(ctx)
+		if !ok {
+			return nil, ErrPrincipalNotFound
+		}
+
+		tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+		if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+			return nil, ErrPrincipalNotFound
+		}
+
+		cert := tlsInfo.State.VerifiedChains[0][0]
+		return &Principal{ID: cert.Subject.CommonName, Cert: cert}, nil
+	}
+}
+
+// PrincipalFromBearerToken extracts principal from `authorization: Bearer {token}` request metadata,
+// principal ID is token subject, returned by verifier
+func PrincipalFromBearerToken(verify TokenVerifier) PrincipalExtractor {
+	return func(ctx context.Context) (*Principal, error) {
+		value := metadataValue(ctx, AuthorizationHeader)
+		if !strings.HasPrefix(value, BearerPrefix) {
+			return nil, ErrPrincipalNotFound
+		}
+
+		subject, err := verify(ctx, strings.TrimPrefix(value, BearerPrefix))
+		if err != nil {
+			return nil, fmt.Errorf(`verify bearer token: %w`, err)
+		}
+		return &Principal{ID: subject}, nil
+	}
+}
+
+// PrincipalFromHeader extracts principal from request metadata (gRPC metadata or HTTP header, forwarded by
+// grpc-gateway). Header should be set by trusted proxy, that authenticates caller
+func PrincipalFromHeader(header string) PrincipalExtractor {
+	return func(ctx context.Context) (*Principal, error) {
+		value := metadataValue(ctx, header)
+		if value == `` {
+			return nil, ErrPrincipalNotFound
+		}
+		return &Principal{ID: value}, nil
+	}
+}
+
+func metadataValue(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ``
+	}
+
+	// grpc-gateway forwards permanent HTTP headers with `grpcgateway-` prefix
+	for _, k := range []string{key, `grpcgateway-` + key} {
+		if values := md.Get(k); len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ``
+}
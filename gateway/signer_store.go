@@ -0,0 +1,95 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/hyperledger/fabric/msp"
+
+	"github.com/s7techlab/cckit/identity"
+)
+
+const (
+	// CertFileExt extension of certificate file in identity directory
+	CertFileExt = `.pem`
+	// KeyFileExt extension of private key file in identity directory
+	KeyFileExt = `.key.pem`
+)
+
+type (
+	// MemoryIdentityStore signing identities by principal ID
+	MemoryIdentityStore struct {
+		mu         sync.RWMutex
+		identities map[string]msp.SigningIdentity
+	}
+)
+
+var _ IdentityStore = &MemoryIdentityStore{}
+
+func NewMemoryIdentityStore() *MemoryIdentityStore {
+	return &MemoryIdentityStore{
+		identities: make(map[string]msp.SigningIdentity),
+	}
+}
+
+// NewFileIdentityStore loads signing identities of one MSP from directory with PEM files:
+// certificate `{principal}.pem` and private key `{principal}.key.pem`
+func NewFileIdentityStore(mspID, dir string) (*MemoryIdentityStore, error) {
+	certFiles, err := filepath.Glob(filepath.Join(dir, `*`+CertFileExt))
+	if err != nil {
+		return nil, err
+	}
+
+	store := NewMemoryIdentityStore()
+	for _, certFile := range certFiles {
+		if strings.HasSuffix(certFile, KeyFileExt) {
+			continue
+		}
+
+		principal := strings.TrimSuffix(filepath.Base(certFile), CertFileExt)
+		keyFile := filepath.Join(dir, principal+KeyFileExt)
+
+		certPEM, err := ioutil.ReadFile(certFile)
+		if err != nil {
+			return nil, err
+		}
+
+		keyPEM, err := ioutil.ReadFile(keyFile)
+		if err != nil {
+			return nil, fmt.Errorf(`private key of %s: %w`, principal, err)
+		}
+
+		signer, err := identity.NewSigning(mspID, certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf(`identity %s: %w`, principal, err)
+		}
+
+		store.Add(principal, signer)
+	}
+
+	return store, nil
+}
+
+// Add adds or replaces signing identity of principal
+func (s *MemoryIdentityStore) Add(principal string, signer msp.SigningIdentity) *MemoryIdentityStore {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.identities[principal] = signer
+	return s
+}
+
+func (s *MemoryIdentityStore) SigningIdentity(_ context.Context, principal *Principal) (msp.SigningIdentity, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	signer, ok := s.identities[principal.ID]
+	if !ok {
+		return nil, fmt.Errorf(`%w: %s`, ErrUnknownPrincipal, principal.ID)
+	}
+	return signer, nil
+}
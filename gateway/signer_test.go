@@ -0,0 +1,175 @@
+package gateway_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/hyperledger/fabric/msp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	grpcpeer "google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	cpservice "github.com/s7techlab/cckit/examples/cpaper_asservice"
+	"github.com/s7techlab/cckit/gateway"
+	"github.com/s7techlab/cckit/identity"
+	idtestdata "github.com/s7techlab/cckit/identity/testdata"
+	testcc "github.com/s7techlab/cckit/testing"
+)
+
+var _ = Describe(`Signer resolver`, func() {
+
+	const principalHeader = `x-principal`
+
+	var (
+		store    *gateway.MemoryIdentityStore
+		resolver *gateway.PrincipalSignerResolver
+
+		cPaperGateway     *cpservice.CPaperServiceGateway
+		ccInstanceService *gateway.ChaincodeInstanceService
+		usedSigner        msp.SigningIdentity
+
+		errTokenInvalid = errors.New(`token invalid`)
+
+		withHeader = func(value string) context.Context {
+			return metadata.NewIncomingContext(context.Background(), metadata.Pairs(principalHeader, value))
+		}
+	)
+
+	It("Init", func() {
+		var err error
+		store, err = gateway.NewFileIdentityStore(idtestdata.DefaultMSP, `../identity/testdata`)
+		Expect(err).NotTo(HaveOccurred())
+
+		resolver = gateway.NewSignerResolver(store,
+			gateway.PrincipalFromTLSCert(),
+			gateway.PrincipalFromBearerToken(func(ctx context.Context, token string) (string, error) {
+				if token != `valid-token` {
+					return ``, errTokenInvalid
+				}
+				return `victor-nosov`, nil
+			}),
+			gateway.PrincipalFromHeader(principalHeader))
+
+		ccImpl, err := cpservice.NewCC()
+		Expect(err).NotTo(HaveOccurred())
+
+		mockedPeer := testcc.NewPeer().WithChannel(Channel, testcc.NewMockStub(ChaincodeName, ccImpl))
+		ccInstanceService = gateway.NewChaincodeInstanceService(mockedPeer,
+			&gateway.ChaincodeLocator{Channel: Channel, Chaincode: ChaincodeName},
+			gateway.WithSignerResolver(resolver))
+
+		cPaperGateway = cpservice.NewCPaperServiceGateway(
+			mockedPeer, Channel, ChaincodeName,
+			gateway.WithSignerResolver(resolver),
+			gateway.WithUnaryInterceptor(func(ctx context.Context, invocation *gateway.Invocation,
+				handler gateway.UnaryHandler) (*peer.Response, error) {
+				usedSigner, _ = gateway.SignerFromContext(ctx)
+				return handler(ctx, invocation)
+			}))
+	})
+
+	It("Allow to load signing identities from directory with PEM files", func() {
+		signer, err := store.SigningIdentity(context.Background(), &gateway.Principal{ID: `some-person`})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(signer.GetMSPIdentifier()).To(Equal(idtestdata.DefaultMSP))
+
+		sig, err := signer.Sign([]byte(`message`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(signer.Verify([]byte(`message`), sig)).NotTo(HaveOccurred())
+		Expect(errors.Is(signer.Verify([]byte(`another message`), sig), identity.ErrSignatureInvalid)).To(BeTrue())
+	})
+
+	It("Allow to resolve signer from header", func() {
+		_, err := cPaperGateway.List(withHeader(`some-person`), &empty.Empty{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(usedSigner.(*identity.CertSigningIdentity).GetPEM()).To(
+			Equal(idtestdata.Certificates[1].MustCertBytes()))
+	})
+
+	It("Allow to resolve signer from bearer token", func() {
+		ctx := metadata.NewIncomingContext(context.Background(),
+			metadata.Pairs(gateway.AuthorizationHeader, gateway.BearerPrefix+`valid-token`))
+		_, err := cPaperGateway.List(ctx, &empty.Empty{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(usedSigner.(*identity.CertSigningIdentity).GetPEM()).To(
+			Equal(idtestdata.Certificates[2].MustCertBytes()))
+
+		ctx = metadata.NewIncomingContext(context.Background(),
+			metadata.Pairs(gateway.AuthorizationHeader, gateway.BearerPrefix+`invalid-token`))
+		_, err = cPaperGateway.List(ctx, &empty.Empty{})
+		Expect(errors.Is(err, errTokenInvalid)).To(BeTrue())
+	})
+
+	It("Allow to resolve signer from mTLS client certificate", func() {
+		cert := idtestdata.Certificates[0].MustCert()
+		store.Add(cert.Subject.CommonName, idtestdata.Certificates[0].MustIdentity(idtestdata.DefaultMSP))
+
+		ctx := grpcpeer.NewContext(context.Background(), &grpcpeer.Peer{
+			AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{
+				VerifiedChains: [][]*x509.Certificate{{cert}},
+			}},
+		})
+
+		_, err := cPaperGateway.List(ctx, &empty.Empty{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(usedSigner.GetMSPIdentifier()).To(Equal(idtestdata.DefaultMSP))
+	})
+
+	It("Disallow to resolve signer for unknown principal", func() {
+		_, err := cPaperGateway.List(withHeader(`unknown`), &empty.Empty{})
+		Expect(errors.Is(err, gateway.ErrUnknownPrincipal)).To(BeTrue())
+		Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+	})
+
+	It("Disallow to resolve signer without credentials", func() {
+		_, err := cPaperGateway.List(context.Background(), &empty.Empty{})
+		Expect(errors.Is(err, gateway.ErrPrincipalNotFound)).To(BeTrue())
+		Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+	})
+
+	It("Allow to resolve signer for events list and transaction status", func() {
+		_, err := ccInstanceService.Events(withHeader(`some-person`), &gateway.ChaincodeInstanceEventsRequest{})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = ccInstanceService.Events(context.Background(), &gateway.ChaincodeInstanceEventsRequest{})
+		Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+
+		_, _, err = ccInstanceService.EventService().EventsChan(withHeader(`unknown`))
+		Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+
+		_, err = ccInstanceService.GetTransactionStatus(withHeader(`unknown`),
+			&gateway.ChaincodeInstanceTransactionStatusRequest{TxId: `some`})
+		Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+	})
+
+	It("Allow to use default signer for request without credentials", func() {
+		ccImpl, err := cpservice.NewCC()
+		Expect(err).NotTo(HaveOccurred())
+
+		gw := cpservice.NewCPaperServiceGateway(
+			testcc.NewPeer().WithChannel(Channel, testcc.NewMockStub(ChaincodeName, ccImpl)), Channel, ChaincodeName,
+			gateway.WithSignerResolver(resolver),
+			gateway.WithDefaultSigner(idtestdata.Certificates[0].MustIdentity(idtestdata.DefaultMSP)))
+
+		_, err = gw.List(context.Background(), &empty.Empty{})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = gw.List(withHeader(`unknown`), &empty.Empty{})
+		Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+	})
+
+	It("Allow to use signer from context", func() {
+		signer := idtestdata.Certificates[0].MustIdentity(idtestdata.DefaultMSP)
+		_, err := cPaperGateway.List(gateway.ContextWithSigner(withHeader(`some-person`), signer), &empty.Empty{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(usedSigner).To(Equal(signer))
+	})
+})
@@ -0,0 +1,189 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/s7techlab/cckit/router"
+)
+
+type (
+	// Subscription delivers chaincode events after subscriber checkpoint.
+	// Delivered events must be acknowledged with Ack, subscription resumes after last acknowledged event
+	Subscription struct {
+		SubscriberID string
+
+		store  CheckpointStore
+		events chan *CheckpointedEvent
+		closer func() error
+
+		acked *Checkpoint
+		mu    sync.Mutex
+	}
+
+	// CheckpointedEvent chaincode event with its position
+	CheckpointedEvent struct {
+		Event      *ChaincodeEvent
+		Checkpoint *Checkpoint
+	}
+)
+
+// Subscribe creates subscription to chaincode events. If subscriber has stored checkpoint,
+// events are delivered from checkpoint block, skipping events of checkpoint block up to acknowledged transaction
+// (found by tx id, by position for checkpoints without tx id), otherwise from request FromBlock.
+// Positions are counted among all chaincode events before EventName and PayloadFilter filtering,
+// so checkpoint is valid for subscriptions of same chaincode with any filters
+func (ces *ChaincodeInstanceEventService) Subscribe(ctx context.Context, subscriberID string,
+	store CheckpointStore, rr ...*ChaincodeInstanceEventsStreamRequest) (*Subscription, error) {
+	if subscriberID == `` {
+		return nil, ErrSubscriberIDInvalid
+	}
+
+	req := &ChaincodeInstanceEventsStreamRequest{}
+	if len(rr) == 1 {
+		req = rr[0]
+		if err := router.ValidateRequest(req); err != nil {
+			return nil, err
+		}
+	}
+
+	filter, err := payloadFilter(req.PayloadFilter, ces.Opts)
+	if err != nil {
+		return nil, err
+	}
+
+	acked, err := store.Get(ctx, subscriberID)
+	switch {
+	case err == nil:
+		req = &ChaincodeInstanceEventsStreamRequest{
+			FromBlock:     &BlockLimit{Num: int64(acked.Block)},
+			ToBlock:       req.ToBlock,
+			EventName:     req.EventName,
+			PayloadFilter: req.PayloadFilter,
+		}
+	case errors.Is(err, ErrCheckpointNotFound):
+		acked = nil
+	default:
+		return nil, err
+	}
+
+	if ctx, err = ces.Opts.signerContext(ctx); err != nil {
+		return nil, err
+	}
+	signer, _ := SignerFromContext(ctx)
+
+	ctx, cancel := context.WithCancel(ctx)
+	events, deliveryCloser, err := ces.EventDelivery.Events(
+		ctx,
+		ces.Locator.Channel,
+		ces.Locator.Chaincode,
+		signer,
+		BlockRange(req.FromBlock, req.ToBlock)...,
+	)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	sub := &Subscription{
+		SubscriberID: subscriberID,
+		store:        store,
+		events:       make(chan *CheckpointedEvent),
+		acked:        acked,
+		closer: func() error {
+			cancel()
+			return deliveryCloser()
+		},
+	}
+
+	go func() {
+		defer close(sub.events)
+
+		var (
+			delivered = acked
+			position  *Checkpoint
+			// acknowledged transaction is searched by tx id in checkpoint block
+			resuming = acked != nil && acked.TxId != ``
+		)
+
+		for e := range events {
+			position = position.Next(e.Block(), e.Event().GetTxId())
+
+			if resuming && position.Block == acked.Block {
+				// events of checkpoint block up to acknowledged transaction are skipped
+				if position.TxId == acked.TxId {
+					resuming = false
+					delivered = position
+				}
+				continue
+			}
+
+			if resuming && position.Block > acked.Block {
+				ces.Logger.Warn(`checkpoint transaction not found in checkpoint block`,
+					zap.String(`subscriber`, subscriberID), zap.String(`checkpoint`, acked.String()),
+					zap.String(`tx_id`, acked.TxId))
+				resuming = false
+			}
+
+			// already delivered or acknowledged before restart
+			if !position.After(delivered) {
+				continue
+			}
+			delivered = position
+
+			processedEvent, err := ProcessEventWithFilter(e, ces.Opts.Event, req.EventName, filter)
+			if err != nil {
+				ces.Logger.Warn(`event processing`, zap.Error(err))
+			}
+
+			if processedEvent == nil {
+				continue
+			}
+
+			select {
+			case sub.events <- &CheckpointedEvent{Event: processedEvent, Checkpoint: position}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return sub, nil
+}
+
+// Events returns channel of events, channel is closed when subscription closed
+func (s *Subscription) Events() <-chan *CheckpointedEvent {
+	return s.events
+}
+
+// Ack stores event checkpoint, acknowledging event and all events delivered before it
+func (s *Subscription) Ack(ctx context.Context, event *CheckpointedEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !event.Checkpoint.After(s.acked) {
+		return nil
+	}
+
+	if err := s.store.Put(ctx, s.SubscriberID, event.Checkpoint); err != nil {
+		return err
+	}
+
+	s.acked = event.Checkpoint
+	return nil
+}
+
+// Checkpoint returns last acknowledged checkpoint, nil if no events acknowledged
+func (s *Subscription) Checkpoint() *Checkpoint {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.acked
+}
+
+func (s *Subscription) Close() error {
+	return s.closer()
+}
@@ -0,0 +1,162 @@
+package gateway_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	cpservice "github.com/s7techlab/cckit/examples/cpaper_asservice"
+	"github.com/s7techlab/cckit/examples/cpaper_asservice/testdata"
+	"github.com/s7techlab/cckit/gateway"
+	testcc "github.com/s7techlab/cckit/testing"
+)
+
+var _ = Describe(`Event subscription`, func() {
+
+	const subscriberID = `cpaper-projection`
+
+	var (
+		cPaperGateway *cpservice.CPaperServiceGateway
+		eventService  *gateway.ChaincodeInstanceEventService
+		checkpointDir string
+		boughtTxID    string
+
+		subscribe = func() *gateway.Subscription {
+			store, err := gateway.NewFileCheckpointStore(checkpointDir)
+			Expect(err).NotTo(HaveOccurred())
+
+			sub, err := eventService.Subscribe(ctx, subscriberID, store, &gateway.ChaincodeInstanceEventsStreamRequest{
+				FromBlock: &gateway.BlockLimit{Num: 0},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			return sub
+		}
+
+		receive = func(sub *gateway.Subscription) *gateway.CheckpointedEvent {
+			var e *gateway.CheckpointedEvent
+			Eventually(sub.Events(), time.Second).Should(Receive(&e))
+			return e
+		}
+	)
+
+	It("Init", func() {
+		ccImpl, err := cpservice.NewCC()
+		Expect(err).NotTo(HaveOccurred())
+
+		mockedPeer := testcc.NewPeer().WithChannel(Channel, testcc.NewMockStub(ChaincodeName, ccImpl))
+		cPaperGateway = cpservice.NewCPaperServiceGateway(mockedPeer, Channel, ChaincodeName)
+		eventService = gateway.NewChaincodeInstanceEventService(mockedPeer, Channel, ChaincodeName,
+			gateway.WithEventResolver(cpservice.EventMappings))
+
+		checkpointDir, err = ioutil.TempDir(``, `checkpoints`)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = cPaperGateway.Issue(ctx, testdata.Issue1)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Allow to deliver events and acknowledge them", func() {
+		sub := subscribe()
+		defer func() { Expect(sub.Close()).NotTo(HaveOccurred()) }()
+
+		issued := receive(sub)
+		Expect(issued.Event.Event.EventName).To(Equal(`IssueCommercialPaper`))
+		Expect(issued.Checkpoint.Block).To(BeEquivalentTo(testcc.MockedEventsBlock))
+		Expect(issued.Checkpoint.TxIndex).To(BeEquivalentTo(0))
+		Expect(sub.Ack(context.Background(), issued)).NotTo(HaveOccurred())
+
+		_, err := cPaperGateway.Buy(ctx, testdata.Buy1)
+		Expect(err).NotTo(HaveOccurred())
+
+		// delivered, but not acknowledged
+		bought := receive(sub)
+		Expect(bought.Event.Event.EventName).To(Equal(`BuyCommercialPaper`))
+		Expect(bought.Checkpoint.TxIndex).To(BeEquivalentTo(1))
+		boughtTxID = bought.Event.Event.TxId
+
+		Expect(sub.Checkpoint()).To(Equal(issued.Checkpoint))
+	})
+
+	It("Allow to resume subscription after last acknowledged event", func() {
+		// event while subscriber is down
+		_, err := cPaperGateway.Redeem(ctx, testdata.Redeem1)
+		Expect(err).NotTo(HaveOccurred())
+
+		sub := subscribe()
+		defer func() { Expect(sub.Close()).NotTo(HaveOccurred()) }()
+
+		bought := receive(sub)
+		Expect(bought.Event.Event.EventName).To(Equal(`BuyCommercialPaper`))
+
+		redeemed := receive(sub)
+		Expect(redeemed.Event.Event.EventName).To(Equal(`RedeemCommercialPaper`))
+		Expect(redeemed.Checkpoint.TxIndex).To(BeEquivalentTo(2))
+
+		// acknowledges all previous events
+		Expect(sub.Ack(context.Background(), redeemed)).NotTo(HaveOccurred())
+		Expect(sub.Ack(context.Background(), bought)).NotTo(HaveOccurred())
+		Expect(sub.Checkpoint()).To(Equal(redeemed.Checkpoint))
+	})
+
+	It("Disallow to deliver acknowledged events twice", func() {
+		sub := subscribe()
+		defer func() { Expect(sub.Close()).NotTo(HaveOccurred()) }()
+
+		Consistently(sub.Events(), 100*time.Millisecond).ShouldNot(Receive())
+	})
+
+	It("Allow to resume subscription after acknowledged transaction, found by tx id", func() {
+		store := gateway.NewMemoryCheckpointStore()
+		// position doesn't match acknowledged transaction
+		Expect(store.Put(ctx, subscriberID, &gateway.Checkpoint{
+			Block: testcc.MockedEventsBlock, TxIndex: 0, TxId: boughtTxID})).NotTo(HaveOccurred())
+
+		sub, err := eventService.Subscribe(ctx, subscriberID, store, &gateway.ChaincodeInstanceEventsStreamRequest{
+			EventName: []string{`BuyCommercialPaper`, `RedeemCommercialPaper`},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		defer func() { Expect(sub.Close()).NotTo(HaveOccurred()) }()
+
+		redeemed := receive(sub)
+		Expect(redeemed.Event.Event.EventName).To(Equal(`RedeemCommercialPaper`))
+		// position is counted before filtering
+		Expect(redeemed.Checkpoint.TxIndex).To(BeEquivalentTo(2))
+	})
+
+	It("Allow to delete checkpoint and deliver events from the beginning", func() {
+		store, err := gateway.NewFileCheckpointStore(checkpointDir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(store.Delete(ctx, subscriberID)).NotTo(HaveOccurred())
+
+		_, err = store.Get(ctx, subscriberID)
+		Expect(errors.Is(err, gateway.ErrCheckpointNotFound)).To(BeTrue())
+		// subscriber without checkpoint
+		Expect(store.Delete(ctx, subscriberID)).NotTo(HaveOccurred())
+
+		sub := subscribe()
+		defer func() { Expect(sub.Close()).NotTo(HaveOccurred()) }()
+
+		issued := receive(sub)
+		Expect(issued.Event.Event.EventName).To(Equal(`IssueCommercialPaper`))
+	})
+
+	It("Disallow to subscribe with invalid subscriber id", func() {
+		store, err := gateway.NewFileCheckpointStore(checkpointDir)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = eventService.Subscribe(ctx, `../sub`, store)
+		Expect(errors.Is(err, gateway.ErrSubscriberIDInvalid)).To(BeTrue())
+
+		_, err = gateway.NewMemoryCheckpointStore().Get(ctx, subscriberID)
+		Expect(errors.Is(err, gateway.ErrCheckpointNotFound)).To(BeTrue())
+	})
+
+	It("Clean", func() {
+		Expect(os.RemoveAll(checkpointDir)).NotTo(HaveOccurred())
+	})
+})
@@ -0,0 +1,130 @@
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+type (
+	// SwaggerInfo info section of merged swagger document
+	SwaggerInfo struct {
+		Title       string `json:"title"`
+		Description string `json:"description,omitempty"`
+		Version     string `json:"version"`
+	}
+)
+
+// swaggerMergedSections sections of swagger documents, merged by key. Equal values with the same key are
+// deduplicated, different values with the same key are conflicting
+var swaggerMergedSections = []string{`definitions`, `parameters`, `responses`, `securityDefinitions`}
+
+// MergeSwagger merges swagger (OpenAPI v2) documents of services into one document.
+// Paths are merged by path and http method, shared definitions are deduplicated.
+// Returns ErrSwaggerConflict if documents contain different operations or definitions with the same key
+func MergeSwagger(info SwaggerInfo, docs ...[]byte) ([]byte, error) {
+	merged := map[string]interface{}{
+		`swagger`:  `2.0`,
+		`info`:     info,
+		`consumes`: []interface{}{},
+		`produces`: []interface{}{},
+		`paths`:    map[string]interface{}{},
+		`tags`:     []interface{}{},
+	}
+
+	for _, section := range swaggerMergedSections {
+		merged[section] = map[string]interface{}{}
+	}
+
+	for _, doc := range docs {
+		if len(doc) == 0 {
+			continue
+		}
+
+		swagger := make(map[string]interface{})
+		if err := json.Unmarshal(doc, &swagger); err != nil {
+			return nil, fmt.Errorf(`unmarshal swagger: %w`, err)
+		}
+
+		if err := mergeSwaggerPaths(merged[`paths`].(map[string]interface{}), swagger[`paths`]); err != nil {
+			return nil, err
+		}
+
+		for _, section := range swaggerMergedSections {
+			if err := mergeSwaggerSection(section, merged[section].(map[string]interface{}), swagger[section]); err != nil {
+				return nil, err
+			}
+		}
+
+		for _, list := range []string{`consumes`, `produces`, `tags`} {
+			merged[list] = appendUnique(merged[list].([]interface{}), swagger[list])
+		}
+	}
+
+	for _, key := range append([]string{`consumes`, `produces`, `tags`}, swaggerMergedSections...) {
+		if reflect.ValueOf(merged[key]).Len() == 0 {
+			delete(merged, key)
+		}
+	}
+
+	return json.MarshalIndent(merged, ``, `  `)
+}
+
+func mergeSwaggerPaths(merged map[string]interface{}, paths interface{}) error {
+	pathsMap, _ := paths.(map[string]interface{})
+	for _, path := range sortedKeys(pathsMap) {
+		operations, _ := pathsMap[path].(map[string]interface{})
+
+		mergedOperations, ok := merged[path].(map[string]interface{})
+		if !ok {
+			mergedOperations = make(map[string]interface{})
+			merged[path] = mergedOperations
+		}
+
+		for method, operation := range operations {
+			if existing, ok := mergedOperations[method]; ok && !reflect.DeepEqual(existing, operation) {
+				return fmt.Errorf(`%w: path %s %s`, ErrSwaggerConflict, method, path)
+			}
+			mergedOperations[method] = operation
+		}
+	}
+	return nil
+}
+
+func mergeSwaggerSection(section string, merged map[string]interface{}, values interface{}) error {
+	valuesMap, _ := values.(map[string]interface{})
+	for key, value := range valuesMap {
+		if existing, ok := merged[key]; ok && !reflect.DeepEqual(existing, value) {
+			return fmt.Errorf(`%w: %s %s`, ErrSwaggerConflict, section, key)
+		}
+		merged[key] = value
+	}
+	return nil
+}
+
+func appendUnique(list []interface{}, values interface{}) []interface{} {
+	valuesList, _ := values.([]interface{})
+	for _, value := range valuesList {
+		exists := false
+		for _, existing := range list {
+			if reflect.DeepEqual(existing, value) {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			list = append(list, value)
+		}
+	}
+	return list
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
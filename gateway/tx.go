@@ -0,0 +1,68 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/s7techlab/cckit/sdk"
+)
+
+const CtxInvokedTxKey = contextKey(`InvokedTx`)
+
+// invokedTx holds tx id of invocation, set by ChaincodeInstanceService after SDK invoke,
+// because unary interceptors return chaincode response only
+type invokedTx struct {
+	id string
+}
+
+// Type returns tx waiter type, passed to sdk.Invoker, empty for unspecified policy.
+// N of M policy without number of organizations is rejected with InvalidArgument
+func (w *TxWaiter) Type() (string, error) {
+	switch w.GetPolicy() {
+	case TxWaitPolicy_TX_WAIT_POLICY_UNSPECIFIED:
+		return ``, nil
+	case TxWaitPolicy_TX_WAIT_POLICY_NONE:
+		return sdk.TxWaiterNone, nil
+	case TxWaitPolicy_TX_WAIT_POLICY_OWN_ORG:
+		return sdk.TxWaiterSelf, nil
+	case TxWaitPolicy_TX_WAIT_POLICY_ALL:
+		return sdk.TxWaiterAll, nil
+	case TxWaitPolicy_TX_WAIT_POLICY_N_OF_M:
+		if w.GetN() == 0 {
+			return ``, &invalidArgumentError{err: fmt.Errorf(`%w: n of m policy requires n > 0`, ErrTxWaitPolicyInvalid)}
+		}
+		return sdk.TxWaiterNOfM(w.GetN()), nil
+	default:
+		return ``, &invalidArgumentError{err: fmt.Errorf(`%w: %s`, ErrTxWaitPolicyNotSupported, w.GetPolicy())}
+	}
+}
+
+// ContextWithTxWaitPolicy sets tx waiter type from typed waiting policy, context is not changed for unspecified policy
+func ContextWithTxWaitPolicy(ctx context.Context, waiter *TxWaiter) (context.Context, error) {
+	txWaiterType, err := waiter.Type()
+	if err != nil || txWaiterType == `` {
+		return ctx, err
+	}
+	return ContextWithTxWaiter(ctx, txWaiterType), nil
+}
+
+func contextWithInvokedTx(ctx context.Context) (context.Context, *invokedTx) {
+	tx := &invokedTx{}
+	return context.WithValue(ctx, CtxInvokedTxKey, tx), tx
+}
+
+func setInvokedTxID(ctx context.Context, txID string) {
+	if tx, ok := ctx.Value(CtxInvokedTxKey).(*invokedTx); ok {
+		tx.id = txID
+	}
+}
+
+// TransactionStatusFromSDK converts sdk transaction status to gateway transaction status
+func TransactionStatusFromSDK(status *sdk.TxStatus) *TransactionStatus {
+	return &TransactionStatus{
+		TxId:           status.TxID,
+		BlockNumber:    status.BlockNumber,
+		ValidationCode: status.ValidationCode.String(),
+		Timestamp:      status.Timestamp,
+	}
+}
@@ -0,0 +1,133 @@
+package gateway_test
+
+import (
+	"errors"
+
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/s7techlab/cckit/convert"
+	cpservice "github.com/s7techlab/cckit/examples/cpaper_asservice"
+	"github.com/s7techlab/cckit/examples/cpaper_asservice/testdata"
+	"github.com/s7techlab/cckit/gateway"
+	"github.com/s7techlab/cckit/sdk"
+	testcc "github.com/s7techlab/cckit/testing"
+)
+
+var _ = Describe(`Transaction status`, func() {
+
+	var (
+		ccService *gateway.ChaincodeService
+		locator   = &gateway.ChaincodeLocator{Channel: Channel, Chaincode: ChaincodeName}
+		input     = func(method string, arg interface{}) *gateway.ChaincodeInput {
+			argBytes, err := convert.ToBytes(arg)
+			Expect(err).NotTo(HaveOccurred())
+			return &gateway.ChaincodeInput{Args: [][]byte{[]byte(method), argBytes}}
+		}
+	)
+
+	It("Init", func() {
+		ccImpl, err := cpservice.NewCC()
+		Expect(err).NotTo(HaveOccurred())
+
+		ccService = gateway.NewChaincodeService(
+			testcc.NewPeer().WithChannel(Channel, testcc.NewMockStub(ChaincodeName, ccImpl)))
+	})
+
+	It("Allow to convert tx wait policy to tx waiter type", func() {
+		for waiter, txWaiterType := range map[*gateway.TxWaiter]string{
+			{Policy: gateway.TxWaitPolicy_TX_WAIT_POLICY_UNSPECIFIED}:  ``,
+			{Policy: gateway.TxWaitPolicy_TX_WAIT_POLICY_NONE}:         sdk.TxWaiterNone,
+			{Policy: gateway.TxWaitPolicy_TX_WAIT_POLICY_OWN_ORG}:      sdk.TxWaiterSelf,
+			{Policy: gateway.TxWaitPolicy_TX_WAIT_POLICY_ALL}:          sdk.TxWaiterAll,
+			{Policy: gateway.TxWaitPolicy_TX_WAIT_POLICY_N_OF_M, N: 2}: sdk.TxWaiterNOfM(2),
+		} {
+			waiterType, err := waiter.Type()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(waiterType).To(Equal(txWaiterType))
+		}
+
+		n, ok := sdk.ParseTxWaiterNOfM(sdk.TxWaiterNOfM(2))
+		Expect(ok).To(BeTrue())
+		Expect(n).To(BeEquivalentTo(2))
+
+		_, ok = sdk.ParseTxWaiterNOfM(sdk.TxWaiterAll)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("Disallow to invoke chaincode with N of M tx wait policy without n", func() {
+		_, err := (&gateway.TxWaiter{Policy: gateway.TxWaitPolicy_TX_WAIT_POLICY_N_OF_M}).Type()
+		Expect(errors.Is(err, gateway.ErrTxWaitPolicyInvalid)).To(BeTrue())
+
+		_, err = ccService.InvokeTx(ctx, &gateway.ChaincodeInvokeRequest{
+			Locator:  locator,
+			Input:    input(cpservice.CPaperServiceChaincode_Issue, testdata.Issue1),
+			TxWaiter: &gateway.TxWaiter{Policy: gateway.TxWaitPolicy_TX_WAIT_POLICY_N_OF_M},
+		})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+
+	It("Disallow to invoke chaincode with N of M tx wait policy with n greater than M", func() {
+		_, err := ccService.InvokeTx(ctx, &gateway.ChaincodeInvokeRequest{
+			Locator:  locator,
+			Input:    input(cpservice.CPaperServiceChaincode_Issue, testdata.Issue1),
+			TxWaiter: &gateway.TxWaiter{Policy: gateway.TxWaitPolicy_TX_WAIT_POLICY_N_OF_M, N: testcc.MockedOrgs + 1},
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(testcc.ErrTxWaiterNotSatisfiable.Error()))
+	})
+
+	It("Allow to invoke chaincode with transaction status in response", func() {
+		res, err := ccService.InvokeTx(ctx, &gateway.ChaincodeInvokeRequest{
+			Locator:  locator,
+			Input:    input(cpservice.CPaperServiceChaincode_Issue, testdata.Issue1),
+			TxWaiter: &gateway.TxWaiter{Policy: gateway.TxWaitPolicy_TX_WAIT_POLICY_N_OF_M, N: testcc.MockedOrgs},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Response.Status).To(Equal(int32(shim.OK)))
+		Expect(res.Tx.TxId).NotTo(BeEmpty())
+		Expect(res.Tx.BlockNumber).To(Equal(uint64(testcc.MockedEventsBlock)))
+		Expect(res.Tx.ValidationCode).To(Equal(`VALID`))
+		Expect(res.Tx.Timestamp).NotTo(BeNil())
+
+		status, err := ccService.GetTransactionStatus(ctx, &gateway.ChaincodeTransactionStatusRequest{
+			Locator: locator,
+			TxId:    res.Tx.TxId,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.TxId).To(Equal(res.Tx.TxId))
+		Expect(status.BlockNumber).To(Equal(res.Tx.BlockNumber))
+	})
+
+	It("Allow to invoke chaincode without waiting for transaction commit", func() {
+		res, err := ccService.InvokeTx(ctx, &gateway.ChaincodeInvokeRequest{
+			Locator:  locator,
+			Input:    input(cpservice.CPaperServiceChaincode_Delete, testdata.Id1),
+			TxWaiter: &gateway.TxWaiter{Policy: gateway.TxWaitPolicy_TX_WAIT_POLICY_NONE},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Tx.TxId).NotTo(BeEmpty())
+		Expect(res.Tx.BlockNumber).To(BeZero())
+		Expect(res.Tx.ValidationCode).To(BeEmpty())
+	})
+
+	It("Disallow to get status of unknown transaction", func() {
+		_, err := ccService.GetTransactionStatus(ctx, &gateway.ChaincodeTransactionStatusRequest{
+			Locator: locator,
+			TxId:    `unknown`,
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(testcc.ErrTxNotFound.Error()))
+	})
+
+	It("Disallow to get transaction status from sdk without tx status support", func() {
+		_, err := gateway.NewChaincodeService(nil).GetTransactionStatus(ctx, &gateway.ChaincodeTransactionStatusRequest{
+			Locator: locator,
+			TxId:    `some`,
+		})
+		Expect(err).To(MatchError(gateway.ErrTxStatusNotSupported))
+	})
+})
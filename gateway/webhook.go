@@ -0,0 +1,338 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"go.uber.org/zap"
+)
+
+const (
+	// WebhookSignatureHeader contains HMAC-SHA256 of request body, as `sha256=<hex>`
+	WebhookSignatureHeader = `X-Cckit-Signature`
+	// WebhookEventHeader contains chaincode event name
+	WebhookEventHeader = `X-Cckit-Event`
+	// WebhookDeliveryHeader contains id of delivery: tx id of chaincode event
+	WebhookDeliveryHeader = `X-Cckit-Delivery`
+
+	WebhookSignaturePrefix = `sha256=`
+)
+
+type (
+	// Webhook endpoint, chaincode events are POSTed to
+	Webhook struct {
+		URL string
+		// EventName delivered events names, all events if empty
+		EventName []string
+		// Secret of HMAC signature, request is not signed if empty
+		Secret []byte
+		Header http.Header
+	}
+
+	// WebhookSink delivers chaincode events from subscription to webhooks.
+	// Event is acknowledged after delivery to all webhooks, events failed after all attempts
+	// are stored to dead letter store
+	WebhookSink struct {
+		Events       *ChaincodeInstanceEventService
+		SubscriberID string
+		Checkpoints  CheckpointStore
+		Webhooks     []Webhook
+
+		Request     *ChaincodeInstanceEventsStreamRequest
+		Client      *http.Client
+		Retry       InvokeRetryPolicy
+		DeadLetters DeadLetterStore
+		Logger      *zap.Logger
+	}
+
+	WebhookSinkOpt func(*WebhookSink)
+
+	// DeadLetter chaincode event, not delivered to webhook
+	DeadLetter struct {
+		URL        string
+		Event      *ChaincodeEvent
+		Checkpoint *Checkpoint
+		Attempts   int
+		Error      string
+		Time       time.Time
+	}
+
+	DeadLetterStore interface {
+		Put(ctx context.Context, letter *DeadLetter) error
+		List(ctx context.Context) ([]*DeadLetter, error)
+	}
+
+	MemoryDeadLetterStore struct {
+		letters []*DeadLetter
+		mu      sync.RWMutex
+	}
+
+	// WebhookResponseError occurs when webhook responds with non 2xx status
+	WebhookResponseError struct {
+		URL        string
+		StatusCode int
+	}
+)
+
+var (
+	// DefaultWebhookRetryPolicy 5 attempts with exponential backoff from 500ms to 30s
+	DefaultWebhookRetryPolicy = InvokeRetryPolicy{
+		Attempts:       5,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     30 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+		Retryable:      IsWebhookRetryable,
+	}
+
+	_ DeadLetterStore = &MemoryDeadLetterStore{}
+)
+
+// NewWebhookSink creates webhook sink, delivering events with subscription of subscriberID
+func NewWebhookSink(events *ChaincodeInstanceEventService, subscriberID string, checkpoints CheckpointStore,
+	webhooks []Webhook, opts ...WebhookSinkOpt) *WebhookSink {
+	s := &WebhookSink{
+		Events:       events,
+		SubscriberID: subscriberID,
+		Checkpoints:  checkpoints,
+		Webhooks:     webhooks,
+		Client:       &http.Client{Timeout: 10 * time.Second},
+		Retry:        DefaultWebhookRetryPolicy,
+		DeadLetters:  NewMemoryDeadLetterStore(),
+		Logger:       zap.NewNop(),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// WebhookEventsRequest sets events stream request (block range, event names, payload filter) of webhook subscription
+func WebhookEventsRequest(req *ChaincodeInstanceEventsStreamRequest) WebhookSinkOpt {
+	return func(s *WebhookSink) {
+		s.Request = req
+	}
+}
+
+func WebhookHTTPClient(client *http.Client) WebhookSinkOpt {
+	return func(s *WebhookSink) {
+		s.Client = client
+	}
+}
+
+// WebhookRetry sets delivery retries, zero fields of policy are set from DefaultWebhookRetryPolicy
+func WebhookRetry(policy InvokeRetryPolicy) WebhookSinkOpt {
+	return func(s *WebhookSink) {
+		if policy.Attempts == 0 {
+			policy.Attempts = DefaultWebhookRetryPolicy.Attempts
+		}
+		if policy.InitialBackoff == 0 {
+			policy.InitialBackoff = DefaultWebhookRetryPolicy.InitialBackoff
+		}
+		if policy.MaxBackoff == 0 {
+			policy.MaxBackoff = DefaultWebhookRetryPolicy.MaxBackoff
+		}
+		if policy.Multiplier == 0 {
+			policy.Multiplier = DefaultWebhookRetryPolicy.Multiplier
+		}
+		if policy.Retryable == nil {
+			policy.Retryable = DefaultWebhookRetryPolicy.Retryable
+		}
+		s.Retry = policy
+	}
+}
+
+func WebhookDeadLetters(store DeadLetterStore) WebhookSinkOpt {
+	return func(s *WebhookSink) {
+		s.DeadLetters = store
+	}
+}
+
+func WebhookLogger(logger *zap.Logger) WebhookSinkOpt {
+	return func(s *WebhookSink) {
+		s.Logger = logger
+	}
+}
+
+// Run delivers events until context done or subscription closed.
+// Returns error if event can be neither delivered nor stored as dead letter, event is not acknowledged
+// and will be delivered again after restart
+func (s *WebhookSink) Run(ctx context.Context) error {
+	var rr []*ChaincodeInstanceEventsStreamRequest
+	if s.Request != nil {
+		rr = append(rr, s.Request)
+	}
+
+	sub, err := s.Events.Subscribe(ctx, s.SubscriberID, s.Checkpoints, rr...)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = sub.Close() }()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case e, ok := <-sub.Events():
+			if !ok {
+				return nil
+			}
+
+			for _, webhook := range s.Webhooks {
+				if !MatchEventName(e.Event.Event.GetEventName(), webhook.EventName) {
+					continue
+				}
+
+				if err = s.deliver(ctx, webhook, e); err != nil {
+					// event is not acknowledged and will be delivered again
+					if ctx.Err() != nil {
+						return nil
+					}
+					return err
+				}
+			}
+
+			if err = sub.Ack(ctx, e); err != nil {
+				return fmt.Errorf(`ack event: %w`, err)
+			}
+		}
+	}
+}
+
+// deliver posts event to webhook with retries, failed event is stored as dead letter
+func (s *WebhookSink) deliver(ctx context.Context, webhook Webhook, e *CheckpointedEvent) error {
+	body, err := (&jsonpb.Marshaler{OrigName: true}).MarshalToString(e.Event)
+	if err != nil {
+		return fmt.Errorf(`marshal event: %w`, err)
+	}
+
+	attempts := 0
+	_, err = s.Retry.invoke(ctx, func() (_ *peer.Response, err error) {
+		attempts++
+		return nil, s.post(ctx, webhook, e.Event, []byte(body))
+	})
+	if err == nil {
+		return nil
+	}
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	s.Logger.Warn(`webhook delivery failed`, zap.String(`url`, webhook.URL),
+		zap.String(`tx_id`, e.Event.Event.GetTxId()), zap.Int(`attempts`, attempts), zap.Error(err))
+
+	if err = s.DeadLetters.Put(ctx, &DeadLetter{
+		URL:        webhook.URL,
+		Event:      e.Event,
+		Checkpoint: e.Checkpoint,
+		Attempts:   attempts,
+		Error:      err.Error(),
+		Time:       time.Now(),
+	}); err != nil {
+		return fmt.Errorf(`store dead letter: %w`, err)
+	}
+
+	return nil
+}
+
+func (s *WebhookSink) post(ctx context.Context, webhook Webhook, event *ChaincodeEvent, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	for name, values := range webhook.Header {
+		for _, value := range values {
+			req.Header.Add(name, value)
+		}
+	}
+	req.Header.Set(`Content-Type`, `application/json`)
+	req.Header.Set(WebhookEventHeader, event.Event.GetEventName())
+	req.Header.Set(WebhookDeliveryHeader, event.Event.GetTxId())
+	if len(webhook.Secret) > 0 {
+		req.Header.Set(WebhookSignatureHeader, WebhookSignature(webhook.Secret, body))
+	}
+
+	res, err := s.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	_ = res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return &WebhookResponseError{URL: webhook.URL, StatusCode: res.StatusCode}
+	}
+
+	return nil
+}
+
+// WebhookSignature returns HMAC-SHA256 signature of webhook request body
+func WebhookSignature(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return WebhookSignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyWebhookSignature checks signature of webhook request body, for webhook receivers
+func VerifyWebhookSignature(secret, body []byte, signature string) bool {
+	if !strings.HasPrefix(signature, WebhookSignaturePrefix) {
+		return false
+	}
+	return hmac.Equal([]byte(WebhookSignature(secret, body)), []byte(signature))
+}
+
+// IsWebhookRetryable returns true for network errors, 5xx and 429 webhook responses
+func IsWebhookRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var resErr *WebhookResponseError
+	if errors.As(err, &resErr) {
+		return resErr.StatusCode >= 500 || resErr.StatusCode == http.StatusTooManyRequests
+	}
+
+	return true
+}
+
+func (e *WebhookResponseError) Error() string {
+	return fmt.Sprintf(`%s: %s responded with status %d`, ErrWebhookResponse, e.URL, e.StatusCode)
+}
+
+func (e *WebhookResponseError) Unwrap() error {
+	return ErrWebhookResponse
+}
+
+func NewMemoryDeadLetterStore() *MemoryDeadLetterStore {
+	return &MemoryDeadLetterStore{}
+}
+
+func (s *MemoryDeadLetterStore) Put(_ context.Context, letter *DeadLetter) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.letters = append(s.letters, letter)
+	return nil
+}
+
+func (s *MemoryDeadLetterStore) List(_ context.Context) ([]*DeadLetter, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return append([]*DeadLetter{}, s.letters...), nil
+}
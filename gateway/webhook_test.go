@@ -0,0 +1,189 @@
+package gateway_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	cpservice "github.com/s7techlab/cckit/examples/cpaper_asservice"
+	"github.com/s7techlab/cckit/examples/cpaper_asservice/testdata"
+	"github.com/s7techlab/cckit/gateway"
+	testcc "github.com/s7techlab/cckit/testing"
+)
+
+type webhookReceiver struct {
+	server   *httptest.Server
+	statuses []int
+	mu       sync.Mutex
+	received []*http.Request
+	bodies   [][]byte
+}
+
+// newWebhookReceiver responds with statuses in order, then with 200
+func newWebhookReceiver(statuses ...int) *webhookReceiver {
+	r := &webhookReceiver{statuses: statuses}
+	r.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := ioutil.ReadAll(req.Body)
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		status := http.StatusOK
+		if len(r.statuses) > 0 {
+			status, r.statuses = r.statuses[0], r.statuses[1:]
+		}
+		if status == http.StatusOK {
+			r.received = append(r.received, req)
+			r.bodies = append(r.bodies, body)
+		}
+		w.WriteHeader(status)
+	}))
+	return r
+}
+
+func (r *webhookReceiver) Received() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.received)
+}
+
+var _ = Describe(`Webhook sink`, func() {
+
+	var (
+		cPaperGateway *cpservice.CPaperServiceGateway
+		eventService  *gateway.ChaincodeInstanceEventService
+		checkpoints   gateway.CheckpointStore
+		deadLetters   gateway.DeadLetterStore
+		secret        = []byte(`webhook-secret`)
+
+		audit    *webhookReceiver
+		flaky    *webhookReceiver
+		gone     *webhookReceiver
+		webhooks []gateway.Webhook
+
+		run = func() (cancel func(), done chan error) {
+			ctx, cancel := context.WithCancel(ctx)
+			done = make(chan error, 1)
+
+			sink := gateway.NewWebhookSink(eventService, `webhooks`, checkpoints, webhooks,
+				gateway.WebhookRetry(gateway.InvokeRetryPolicy{
+					Attempts:       3,
+					InitialBackoff: time.Millisecond,
+					MaxBackoff:     5 * time.Millisecond,
+				}),
+				gateway.WebhookDeadLetters(deadLetters),
+				gateway.WebhookEventsRequest(&gateway.ChaincodeInstanceEventsStreamRequest{
+					FromBlock: &gateway.BlockLimit{Num: 0},
+				}))
+
+			go func() {
+				done <- sink.Run(ctx)
+			}()
+			return cancel, done
+		}
+	)
+
+	It("Init", func() {
+		ccImpl, err := cpservice.NewCC()
+		Expect(err).NotTo(HaveOccurred())
+
+		mockedPeer := testcc.NewPeer().WithChannel(Channel, testcc.NewMockStub(ChaincodeName, ccImpl))
+		cPaperGateway = cpservice.NewCPaperServiceGateway(mockedPeer, Channel, ChaincodeName)
+		eventService = gateway.NewChaincodeInstanceEventService(mockedPeer, Channel, ChaincodeName,
+			gateway.WithEventResolver(cpservice.EventMappings))
+
+		checkpoints = gateway.NewMemoryCheckpointStore()
+		deadLetters = gateway.NewMemoryDeadLetterStore()
+
+		audit = newWebhookReceiver()
+		// fails twice, succeeds on third attempt
+		flaky = newWebhookReceiver(http.StatusServiceUnavailable, http.StatusBadGateway)
+		gone = newWebhookReceiver(http.StatusGone)
+
+		webhooks = []gateway.Webhook{
+			{URL: audit.server.URL, Secret: secret},
+			{URL: flaky.server.URL, EventName: []string{`BuyCommercialPaper`}},
+			{URL: gone.server.URL, EventName: []string{`IssueCommercialPaper`}},
+		}
+
+		_, err = cPaperGateway.Issue(ctx, testdata.Issue1)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = cPaperGateway.Buy(ctx, testdata.Buy1)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Allow to deliver signed events to webhooks", func() {
+		cancel, done := run()
+
+		Eventually(audit.Received, time.Second).Should(Equal(2))
+		Eventually(flaky.Received, time.Second).Should(Equal(1))
+		Eventually(func() uint64 {
+			checkpoint, err := checkpoints.Get(ctx, `webhooks`)
+			if err != nil {
+				return 0
+			}
+			return checkpoint.TxIndex
+		}, time.Second).Should(BeEquivalentTo(1))
+
+		cancel()
+		Eventually(done, time.Second).Should(Receive(BeNil()))
+
+		req, body := audit.received[0], audit.bodies[0]
+		Expect(req.Header.Get(gateway.WebhookEventHeader)).To(Equal(`IssueCommercialPaper`))
+		Expect(gateway.VerifyWebhookSignature(secret, body, req.Header.Get(gateway.WebhookSignatureHeader))).To(BeTrue())
+		Expect(gateway.VerifyWebhookSignature([]byte(`other`), body, req.Header.Get(gateway.WebhookSignatureHeader))).To(BeFalse())
+
+		delivered := make(map[string]interface{})
+		Expect(json.Unmarshal(body, &delivered)).NotTo(HaveOccurred())
+		Expect(delivered[`payload`]).To(HaveKeyWithValue(`paper_number`, testdata.Issue1.PaperNumber))
+
+		Expect(flaky.received[0].Header.Get(gateway.WebhookSignatureHeader)).To(BeEmpty())
+	})
+
+	It("Allow to store not delivered events as dead letters", func() {
+		letters, err := deadLetters.List(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(letters).To(HaveLen(1))
+		Expect(letters[0].URL).To(Equal(gone.server.URL))
+		Expect(letters[0].Attempts).To(Equal(1))
+		Expect(letters[0].Event.Event.EventName).To(Equal(`IssueCommercialPaper`))
+		Expect(letters[0].Error).To(ContainSubstring(`410`))
+	})
+
+	It("Allow to resume delivery from checkpoint", func() {
+		_, err := cPaperGateway.Redeem(ctx, testdata.Redeem1)
+		Expect(err).NotTo(HaveOccurred())
+
+		cancel, done := run()
+		defer func() {
+			cancel()
+			Eventually(done, time.Second).Should(Receive(BeNil()))
+		}()
+
+		Eventually(audit.Received, time.Second).Should(Equal(3))
+		Consistently(audit.Received, 100*time.Millisecond).Should(Equal(3))
+		Expect(audit.received[2].Header.Get(gateway.WebhookEventHeader)).To(Equal(`RedeemCommercialPaper`))
+		Expect(flaky.Received()).To(Equal(1))
+	})
+
+	It("Allow to retry only network errors and 5xx, 429 responses", func() {
+		Expect(gateway.IsWebhookRetryable(errors.New(`connection refused`))).To(BeTrue())
+		Expect(gateway.IsWebhookRetryable(&gateway.WebhookResponseError{StatusCode: http.StatusTooManyRequests})).To(BeTrue())
+		Expect(gateway.IsWebhookRetryable(&gateway.WebhookResponseError{StatusCode: http.StatusBadRequest})).To(BeFalse())
+	})
+
+	It("Close", func() {
+		for _, r := range []*webhookReceiver{audit, flaky, gone} {
+			r.server.Close()
+		}
+	})
+})
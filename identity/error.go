@@ -9,4 +9,7 @@ var (
 
 	// ErrPemEncodedExpected pem format error
 	ErrPemEncodedExpected = errors.New(`expecting a PEM-encoded X509 certificate; PEM block not found`)
+
+	// ErrSignatureInvalid signature verification failed
+	ErrSignatureInvalid = errors.New(`signature invalid`)
 )
@@ -0,0 +1,80 @@
+package identity
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"math/big"
+
+	"github.com/hyperledger/fabric/msp"
+)
+
+// CertSigningIdentity certificate identity with ecdsa private key, signs messages as fabric msp
+type CertSigningIdentity struct {
+	*CertIdentity
+	PrivateKey *ecdsa.PrivateKey
+}
+
+var _ msp.SigningIdentity = &CertSigningIdentity{}
+
+// NewSigning creates signing identity from an mspID, certificate and private key
+func NewSigning(mspID string, certPEM, keyPEM []byte) (*CertSigningIdentity, error) {
+	ci, err := New(mspID, certPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := PrivateKey(keyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CertSigningIdentity{CertIdentity: ci, PrivateKey: key}, nil
+}
+
+// Sign signs sha256 hash of message, signature is ASN.1 encoded with low S value
+func (si *CertSigningIdentity) Sign(msg []byte) ([]byte, error) {
+	hash := sha256.Sum256(msg)
+	r, s, err := ecdsa.Sign(rand.Reader, si.PrivateKey, hash[:])
+	if err != nil {
+		return nil, err
+	}
+
+	// fabric accepts only signatures with low S value
+	halfOrder := new(big.Int).Rsh(si.PrivateKey.Params().N, 1)
+	if s.Cmp(halfOrder) > 0 {
+		s.Sub(si.PrivateKey.Params().N, s)
+	}
+
+	return asn1.Marshal(struct{ R, S *big.Int }{r, s})
+}
+
+// Verify verifies signature of message with certificate public key
+func (si *CertSigningIdentity) Verify(msg []byte, sig []byte) error {
+	return VerifySignature(si.Cert, msg, sig)
+}
+
+func (si *CertSigningIdentity) GetPublicVersion() msp.Identity {
+	return si.CertIdentity
+}
+
+// VerifySignature verifies ASN.1 encoded ecdsa signature of message sha256 hash with certificate public key
+func VerifySignature(cert *x509.Certificate, msg []byte, sig []byte) error {
+	publicKey, ok := cert.PublicKey.(*ecdsa.PublicKey)
+	if !ok {
+		return ErrSignatureInvalid
+	}
+
+	var signature struct{ R, S *big.Int }
+	if _, err := asn1.Unmarshal(sig, &signature); err != nil {
+		return err
+	}
+
+	hash := sha256.Sum256(msg)
+	if !ecdsa.Verify(publicKey, hash[:], signature.R, signature.S) {
+		return ErrSignatureInvalid
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric-protos-go/common"
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/hyperledger/fabric/protoutil"
+)
+
+type (
+	// OfflineInvoker invokes chaincode with proposal and transaction, signed by client itself.
+	// Signing key is not required for invoker:
+	// * CreateProposal creates proposal, client signs serialized proposal
+	// * Endorse sends signed proposal to endorsing peers
+	// * CreateTransaction creates transaction payload from endorsements, client signs it
+	// * Submit sends signed transaction envelope to orderer
+	OfflineInvoker interface {
+		Invoker
+
+		Endorse(
+			ctx context.Context,
+			chanName string,
+			ccName string,
+			proposal *peer.SignedProposal,
+		) ([]*peer.ProposalResponse, error)
+
+		Submit(
+			ctx context.Context,
+			chanName string,
+			envelope *common.Envelope,
+			txWaiterType string,
+		) (chaincodeTx string, err error)
+	}
+
+	// unsignedSigner serializes creator from proposal header and doesn't sign,
+	// transaction payload is signed by client
+	unsignedSigner struct {
+		creator []byte
+	}
+)
+
+// CreateProposal creates unsigned chaincode invocation proposal for creator (serialized msp.SerializedIdentity)
+func CreateProposal(
+	chanName string,
+	ccName string,
+	args [][]byte,
+	creator []byte,
+	transient map[string][]byte,
+) (proposal *peer.Proposal, txID string, err error) {
+	spec := &peer.ChaincodeInvocationSpec{
+		ChaincodeSpec: &peer.ChaincodeSpec{
+			Type:        peer.ChaincodeSpec_GOLANG,
+			ChaincodeId: &peer.ChaincodeID{Name: ccName},
+			Input:       &peer.ChaincodeInput{Args: args},
+		},
+	}
+
+	return protoutil.CreateChaincodeProposalWithTransient(
+		common.HeaderType_ENDORSER_TRANSACTION, chanName, spec, creator, transient)
+}
+
+// SignedProposal returns proposal with client signature of serialized proposal
+func SignedProposal(proposalBytes, signature []byte) *peer.SignedProposal {
+	return &peer.SignedProposal{ProposalBytes: proposalBytes, Signature: signature}
+}
+
+// CreateTransaction creates unsigned transaction envelope from proposal and endorsements,
+// client signs envelope payload
+func CreateTransaction(proposal *peer.Proposal, responses ...*peer.ProposalResponse) (*common.Envelope, error) {
+	header, err := protoutil.UnmarshalHeader(proposal.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	signatureHeader, err := protoutil.UnmarshalSignatureHeader(header.SignatureHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	return protoutil.CreateSignedTx(proposal, &unsignedSigner{creator: signatureHeader.Creator}, responses...)
+}
+
+// ProposalFromBytes unmarshals serialized proposal
+func ProposalFromBytes(proposalBytes []byte) (*peer.Proposal, error) {
+	proposal := &peer.Proposal{}
+	if err := proto.Unmarshal(proposalBytes, proposal); err != nil {
+		return nil, err
+	}
+	return proposal, nil
+}
+
+func (s *unsignedSigner) Serialize() ([]byte, error) {
+	return s.creator, nil
+}
+
+func (s *unsignedSigner) Sign([]byte) ([]byte, error) {
+	return nil, nil
+}
@@ -0,0 +1,76 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/hyperledger/fabric/msp"
+)
+
+// Tx waiter types, passed to Invoker.Invoke as txWaiterType
+const (
+	// TxWaiterNone don't wait for transaction commit
+	TxWaiterNone = `none`
+	// TxWaiterSelf wait for commit on peer of own organization
+	TxWaiterSelf = `self`
+	// TxWaiterAll wait for commit on peers of all organizations from endorsement policy
+	TxWaiterAll = `all`
+	// TxWaiterNOfMPrefix prefix of tx waiter type for waiting commit on peers of N of M organizations
+	// from endorsement policy, tx waiter type is created with TxWaiterNOfM and parsed with ParseTxWaiterNOfM
+	TxWaiterNOfMPrefix = `n_of_m:`
+)
+
+type (
+	// TxStatus commit status of transaction
+	TxStatus struct {
+		TxID           string
+		BlockNumber    uint64
+		ValidationCode peer.TxValidationCode
+		Timestamp      *timestamp.Timestamp
+	}
+
+	// TxValidationError occurs when transaction is committed to block with not valid validation code.
+	// Invoker implementations should return it (or wrap it) when waiting for tx commit,
+	// so clients can check validation code without parsing error message
+	TxValidationError struct {
+		TxID string
+		Code peer.TxValidationCode
+	}
+
+	// TxStatusReader returns commit status of transaction by tx id
+	TxStatusReader interface {
+		TransactionStatus(
+			ctx context.Context,
+			chanName string,
+			txID string,
+			identity msp.SigningIdentity,
+		) (*TxStatus, error)
+	}
+)
+
+// TxWaiterNOfM returns tx waiter type for waiting commit on peers of n organizations from endorsement policy.
+// Invoker returns error if n is greater than number of organizations from endorsement policy (M)
+func TxWaiterNOfM(n uint32) string {
+	return TxWaiterNOfMPrefix + strconv.FormatUint(uint64(n), 10)
+}
+
+// ParseTxWaiterNOfM returns number of organizations of N of M tx waiter type, ok is false for other tx waiter types
+func ParseTxWaiterNOfM(txWaiterType string) (n uint32, ok bool) {
+	if !strings.HasPrefix(txWaiterType, TxWaiterNOfMPrefix) {
+		return 0, false
+	}
+
+	parsed, err := strconv.ParseUint(strings.TrimPrefix(txWaiterType, TxWaiterNOfMPrefix), 10, 32)
+	if err != nil || parsed == 0 {
+		return 0, false
+	}
+	return uint32(parsed), true
+}
+
+func (e *TxValidationError) Error() string {
+	return fmt.Sprintf(`transaction %s invalidated with status (%s)`, e.TxID, e.Code)
+}
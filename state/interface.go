@@ -72,6 +72,15 @@ type (
 			interface{}, *pb.QueryResponseMetadata, error)
 	}
 
+	// KeysPaginator is optional interface of State implementation, not included in State for compatibility
+	// with external implementations. Use type assertion to check support
+	KeysPaginator interface {
+		// KeysPaginated returns slice of keys with pagination
+		// namespace can be part of key (string or []string) or entity with defined mapping
+		KeysPaginated(namespace interface{}, pageSize int32, bookmark string) (
+			[]string, *pb.QueryResponseMetadata, error)
+	}
+
 	Deletable interface {
 		// Delete returns result of deleting entry from state
 		// entry can be Key (string or []string) or type implementing Keyer interface
@@ -117,6 +126,25 @@ type (
 		ExistsPrivate(collection string, entry interface{}) (bool, error)
 	}
 
+	// PrivateKeysLister is optional interface of State implementation, not included in State for compatibility
+	// with external implementations. Use type assertion to check support
+	PrivateKeysLister interface {
+		// KeysPrivate returns slice of keys from private state
+		// namespace can be part of key (string or []string) or entity with defined mapping
+		KeysPrivate(collection string, namespace interface{}) ([]string, error)
+
+		// KeysPrivatePaginated returns slice of keys from private state with pagination
+		// namespace can be part of key (string or []string) or entity with defined mapping
+		KeysPrivatePaginated(collection string, namespace interface{}, pageSize int32, bookmark string) (
+			[]string, *pb.QueryResponseMetadata, error)
+	}
+
+	// TxStorer is optional interface of State implementation, providing store of values for current transaction,
+	// shared by state clones and wrappers. Use type assertion to check support
+	TxStorer interface {
+		TxStore() TxStore
+	}
+
 	Transformable interface {
 		UseKeyTransformer(KeyTransformer)
 		UseKeyReverseTransformer(KeyTransformer)
@@ -0,0 +1,225 @@
+package mapping
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/s7techlab/cckit/state"
+	"github.com/s7techlab/cckit/state/schema"
+)
+
+type (
+	// CatalogResolver decodes state entries and events with descriptors from catalog, without compiled-in types
+	CatalogResolver struct {
+		catalog *schema.Catalog
+		files   *protoregistry.Files
+	}
+
+	catalogFiles struct {
+		seen  map[string]bool
+		files []*descriptorpb.FileDescriptorProto
+	}
+)
+
+// Catalog returns description of state and event mappings with descriptors of proto files,
+// used by state and event schemas
+func Catalog(states StateMappings, events EventMappings) *schema.Catalog {
+	catalog := &schema.Catalog{}
+	files := &catalogFiles{seen: make(map[string]bool)}
+
+	for _, sm := range states.sorted() {
+		desc := &schema.StateDescription{
+			Schema:     files.add(sm.schema),
+			Namespace:  sm.namespace,
+			PrimaryKey: sm.primaryKeyAttrs,
+		}
+
+		if sm.list != nil {
+			desc.List = files.add(sm.list)
+		}
+
+		if sm.keyerForSchema != nil {
+			desc.KeyerFor = schemaName(sm.keyerForSchema)
+		}
+
+		if sm.collection != nil {
+			desc.Collection = sm.collection.Name
+		}
+
+		for _, idx := range sm.indexes {
+			desc.Indexes = append(desc.Indexes, &schema.IndexDescription{
+				Name:     idx.Name,
+				Uniq:     idx.Uniq,
+				Required: idx.Required,
+				Fields:   idx.Fields,
+			})
+		}
+
+		for _, ref := range sm.references {
+			desc.References = append(desc.References, &schema.ReferenceDescription{
+				Name:     ref.Name,
+				Fields:   ref.Fields,
+				To:       schemaName(ref.To),
+				OnDelete: schema.ReferenceDeletePolicy(ref.OnDelete),
+			})
+		}
+
+		for _, a := range sm.aggregates {
+			desc.Aggregates = append(desc.Aggregates, &schema.AggregateDescription{
+				Name:    a.Name,
+				GroupBy: a.GroupBy,
+				Sum:     a.Sum,
+			})
+		}
+
+		catalog.States = append(catalog.States, desc)
+	}
+
+	for _, em := range events {
+		catalog.Events = append(catalog.Events, &schema.EventDescription{
+			Name:    em.name,
+			Schema:  files.add(em.schema),
+			Version: em.version,
+		})
+	}
+	sort.Slice(catalog.Events, func(i, j int) bool {
+		return catalog.Events[i].Name < catalog.Events[j].Name
+	})
+
+	catalog.Files = &descriptorpb.FileDescriptorSet{File: files.files}
+	return catalog
+}
+
+// schemaName returns full name of proto message or go type name for non-proto schema
+func schemaName(s interface{}) string {
+	if msg, ok := s.(proto.Message); ok {
+		return string(proto.MessageReflect(msg).Descriptor().FullName())
+	}
+	return mapKey(s)
+}
+
+// add adds proto file of schema with dependencies, returns schema name
+func (f *catalogFiles) add(s interface{}) string {
+	if msg, ok := s.(proto.Message); ok {
+		f.addFile(proto.MessageReflect(msg).Descriptor().ParentFile())
+	}
+	return schemaName(s)
+}
+
+// addFile adds file after its dependencies
+func (f *catalogFiles) addFile(file protoreflect.FileDescriptor) {
+	if f.seen[file.Path()] {
+		return
+	}
+	f.seen[file.Path()] = true
+
+	imports := file.Imports()
+	for i := 0; i < imports.Len(); i++ {
+		f.addFile(imports.Get(i).FileDescriptor)
+	}
+
+	fd := protodesc.ToFileDescriptorProto(file)
+	// files, registered with legacy api, can have unknown syntax
+	if fd.GetSyntax() != `proto3` {
+		fd.Syntax = nil
+	}
+	f.files = append(f.files, fd)
+}
+
+// NewCatalogResolver creates resolver from catalog, proto files from catalog are registered in separate registry
+func NewCatalogResolver(catalog *schema.Catalog) (*CatalogResolver, error) {
+	files, err := protodesc.NewFiles(catalog.GetFiles())
+	if err != nil {
+		return nil, fmt.Errorf(`catalog files: %w`, err)
+	}
+
+	return &CatalogResolver{
+		catalog: catalog,
+		files:   files,
+	}, nil
+}
+
+// ResolveState returns description of state mapping with longest namespace, matched to key,
+// and state entry value, decoded to dynamic proto message
+func (r *CatalogResolver) ResolveState(key state.Key, value []byte) (
+	*schema.StateDescription, proto.Message, error) {
+	var matched *schema.StateDescription
+	for _, desc := range r.catalog.States {
+		if !keyHasPrefix(key, desc.Namespace) {
+			continue
+		}
+
+		// mapping of primary key schema has same namespace as target schema
+		if matched == nil || len(desc.Namespace) > len(matched.Namespace) ||
+			(len(desc.Namespace) == len(matched.Namespace) && matched.KeyerFor != `` && desc.KeyerFor == ``) {
+			matched = desc
+		}
+	}
+
+	if matched == nil {
+		return nil, nil, fmt.Errorf(`%s: %s`, ErrStateMappingNotFound, key)
+	}
+
+	msg, err := r.decode(matched.Schema, value)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return matched, msg, nil
+}
+
+// ResolveEvent returns description of event mapping and event payload, decoded to dynamic proto message
+func (r *CatalogResolver) ResolveEvent(name string, payload []byte) (*schema.EventDescription, proto.Message, error) {
+	for _, desc := range r.catalog.Events {
+		if desc.Name != name {
+			continue
+		}
+
+		msg, err := r.decode(desc.Schema, payload)
+		if err != nil {
+			return nil, nil, err
+		}
+		return desc, msg, nil
+	}
+
+	return nil, nil, fmt.Errorf(`%s: %s`, ErrEventNameNotFound, name)
+}
+
+func (r *CatalogResolver) decode(name string, bb []byte) (proto.Message, error) {
+	desc, err := r.files.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, fmt.Errorf(`%s: %w`, name, err)
+	}
+
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf(`%s: %s`, ErrEntryTypeNotSupported, name)
+	}
+
+	msg := dynamicpb.NewMessage(msgDesc)
+	if err = proto.Unmarshal(bb, msg); err != nil {
+		return nil, fmt.Errorf(`%s: %w`, name, err)
+	}
+
+	return msg, nil
+}
+
+func keyHasPrefix(key, prefix state.Key) bool {
+	if len(prefix) == 0 || len(key) < len(prefix) {
+		return false
+	}
+
+	for i := range prefix {
+		if key[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -23,6 +23,36 @@ var (
 	// ErrIndexAlreadyExists occurs when trying to add index to mapping with existent name
 	ErrIndexAlreadyExists = errors.New(`index already exists`)
 
+	// ErrIndexNotFound occurs when index is not defined in mapping
+	ErrIndexNotFound = errors.New(`index not found`)
+
+	// ErrIndexNotUniq occurs when trying to get one entry by non-uniq index
+	ErrIndexNotUniq = errors.New(`index is not uniq`)
+
+	// ErrReferredEntryNotFound occurs when entry refers to non-existent entry
+	ErrReferredEntryNotFound = errors.New(`referred entry not found`)
+
+	// ErrReferringEntriesExist occurs when trying to delete entry, referred by another entries with restrict policy
+	ErrReferringEntriesExist = errors.New(`referring entries exist`)
+
+	// ErrCollectionNotSupported occurs when operation is not supported for entries in private data collection
+	ErrCollectionNotSupported = errors.New(`operation not supported for private data collection`)
+
+	// ErrFieldMaskInvalid occurs when field mask is empty or contains paths, not existing in entry
+	ErrFieldMaskInvalid = errors.New(`field mask invalid`)
+
+	// ErrAggregateNotFound occurs when aggregate is not defined in mapping
+	ErrAggregateNotFound = errors.New(`aggregate not found`)
+
+	// ErrFieldTypeNotSupportedForAggregate occurs when summed field is not numeric
+	ErrFieldTypeNotSupportedForAggregate = errors.New(`field type not supported for aggregate`)
+
+	// ErrEventUpcastVersion occurs when upcaster returns event payload with not greater version of same event
+	ErrEventUpcastVersion = errors.New(`upcasted event version should be greater`)
+
+	// ErrEntryInvalid occurs when mapped entry, written to state, is not valid. Error details in EntryValidationError
+	ErrEntryInvalid = errors.New(`entry invalid`)
+
 	// ErrIndexReferenceNotFound occurs when trying to find entry by index
 	ErrIndexReferenceNotFound = errors.New(`index reference not found`)
 )
@@ -21,6 +21,10 @@ type (
 	EventMapping struct {
 		schema interface{}
 		name   string
+		// base name of versioned event, name includes version suffix
+		baseName string
+		version  uint32
+		upcaster EventUpcaster
 	}
 
 	EventMappings map[string]*EventMapping
@@ -50,6 +54,12 @@ type (
 	EventResolver interface {
 		Resolve(eventName string, payload []byte) (event interface{}, err error)
 	}
+
+	// VersionedEventResolver resolves event of any version to latest version of event
+	VersionedEventResolver interface {
+		EventResolver
+		ResolveLatest(eventName string, payload []byte) (event *Event, err error)
+	}
 )
 
 func (emm EventMappings) Add(schema interface{}, opts ...EventMappingOpt) EventMappings {
@@ -66,11 +76,28 @@ func (emm EventMappings) Add(schema interface{}, opts ...EventMappingOpt) EventM
 	return emm
 }
 
+// AddNamed adds mapping of event with name, stored by event name,
+// so events with same payload type and different names can be resolved
+func (emm EventMappings) AddNamed(schema interface{}, name string, opts ...EventMappingOpt) EventMappings {
+	em := &EventMapping{schema: schema}
+	for _, opt := range opts {
+		opt(em)
+	}
+
+	em.name = name
+	applyEventMappingDefaults(em)
+	emm[name] = em
+	return emm
+}
+
 func applyEventMappingDefaults(em *EventMapping) {
 	// default namespace based on type names
 	if len(em.name) == 0 {
 		em.name = EventNameForPayload(em.schema)
 	}
+
+	em.baseName = em.name
+	em.name = VersionedEventName(em.baseName, em.version)
 }
 
 func (emm EventMappings) Get(entry interface{}) (EventMapper, error) {
@@ -100,10 +127,19 @@ func (emm EventMappings) Map(entry interface{}) (instance *EventInstance, err er
 	}
 }
 
+// Resolve returns event payload, payload of previous version of event is upcasted to latest version
 func (emm EventMappings) Resolve(eventName string, payload []byte) (event interface{}, err error) {
+	e, err := emm.ResolveLatest(eventName, payload)
+	if err != nil {
+		return nil, err
+	}
+	return e.Payload, nil
+}
+
+func (emm EventMappings) byName(eventName string) (*EventMapping, error) {
 	for _, m := range emm {
 		if m.name == eventName {
-			return DefaultSerializer.FromBytes(payload, m.Schema())
+			return m, nil
 		}
 	}
 
@@ -118,6 +154,11 @@ func (em EventMapping) Name(_ interface{}) (string, error) {
 	return em.name, nil
 }
 
+// Version returns version of event schema, 0 - if event is not versioned
+func (em EventMapping) Version() uint32 {
+	return em.version
+}
+
 func EventNameForPayload(payload interface{}) string {
 	t := reflect.TypeOf(payload).String()
 	return t[strings.Index(t, `.`)+1:]
@@ -0,0 +1,77 @@
+package mapping
+
+import (
+	"fmt"
+	"strconv"
+)
+
+type (
+	// EventUpcaster converts payload of event to payload of next version of same event
+	EventUpcaster func(payload interface{}) (interface{}, error)
+)
+
+// EventVersionSeparator separates event name and version, for example `CarRegistered.v2`
+const EventVersionSeparator = `.v`
+
+// VersionedEventName returns name of event with version. Name of first version is same as name of not versioned event,
+// so events, emitted before versioning of schema, resolves as first version
+func VersionedEventName(name string, version uint32) string {
+	if version <= 1 {
+		return name
+	}
+	return name + EventVersionSeparator + strconv.FormatUint(uint64(version), 10)
+}
+
+// EventName sets event name instead of name based on payload type name
+func EventName(name string) EventMappingOpt {
+	return func(em *EventMapping) {
+		em.name = name
+	}
+}
+
+// EventVersion sets version of event schema, name of event with version > 1 includes version suffix
+func EventVersion(version uint32) EventMappingOpt {
+	return func(em *EventMapping) {
+		em.version = version
+	}
+}
+
+// EventUpcast sets upcaster of event payload to next version of event. Event without upcaster is the latest version
+func EventUpcast(upcaster EventUpcaster) EventMappingOpt {
+	return func(em *EventMapping) {
+		em.upcaster = upcaster
+	}
+}
+
+// ResolveLatest returns event with name and payload of latest version, payload of previous version
+// is sequentially upcasted with registered upcasters
+func (emm EventMappings) ResolveLatest(eventName string, payload []byte) (*Event, error) {
+	m, err := emm.byName(eventName)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := DefaultSerializer.FromBytes(payload, m.Schema())
+	if err != nil {
+		return nil, err
+	}
+
+	for m.upcaster != nil {
+		if event, err = m.upcaster(event); err != nil {
+			return nil, fmt.Errorf(`upcast event %s: %w`, m.name, err)
+		}
+
+		next, err := emm.Get(event)
+		if err != nil {
+			return nil, fmt.Errorf(`upcast event %s: %w`, m.name, err)
+		}
+
+		nextMapping := next.(*EventMapping)
+		if nextMapping.baseName != m.baseName || nextMapping.version <= m.version {
+			return nil, fmt.Errorf(`%w: %s -> %s`, ErrEventUpcastVersion, m.name, nextMapping.name)
+		}
+		m = nextMapping
+	}
+
+	return &Event{Name: m.name, Payload: event}, nil
+}
@@ -0,0 +1,80 @@
+package mapping_test
+
+import (
+	"errors"
+
+	"github.com/golang/protobuf/proto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	m "github.com/s7techlab/cckit/state/mapping"
+	"github.com/s7techlab/cckit/state/mapping/testdata"
+	"github.com/s7techlab/cckit/state/mapping/testdata/schema"
+)
+
+var _ = Describe(`Event versions`, func() {
+
+	var (
+		resolve = func(name string, payload proto.Message) *m.Event {
+			bb, err := proto.Marshal(payload)
+			Expect(err).NotTo(HaveOccurred())
+
+			event, err := testdata.VersionedEventMappings.ResolveLatest(name, bb)
+			Expect(err).NotTo(HaveOccurred())
+			return event
+		}
+
+		latest = &schema.EntityRegistered{Id: `1`, FirstName: `John`, LastName: `Doe`, Title: `Mr`}
+	)
+
+	It(`Allow to map versioned event name`, func() {
+		ev1, err := testdata.VersionedEventMappings.Map(&schema.EntityRegisteredV1{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ev1.Name()).To(Equal(`EntityRegistered`))
+
+		ev3, err := testdata.VersionedEventMappings.Map(&schema.EntityRegistered{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ev3.Name()).To(Equal(`EntityRegistered.v3`))
+	})
+
+	It(`Allow to resolve latest version of event`, func() {
+		event := resolve(`EntityRegistered.v3`, latest)
+		Expect(event.Name).To(Equal(`EntityRegistered.v3`))
+		Expect(proto.Equal(event.Payload.(proto.Message), latest)).To(BeTrue())
+	})
+
+	It(`Allow to upcast previous versions of event`, func() {
+		event := resolve(`EntityRegistered.v2`, &schema.EntityRegisteredV2{Id: `1`, Name: `John Doe`, Title: `Mr`})
+		Expect(event.Name).To(Equal(`EntityRegistered.v3`))
+		Expect(proto.Equal(event.Payload.(proto.Message), latest)).To(BeTrue())
+
+		// event, emitted before versioning of schema
+		event = resolve(`EntityRegistered`, &schema.EntityRegisteredV1{Id: `1`, Name: `John Doe`})
+		Expect(proto.Equal(event.Payload.(proto.Message),
+			&schema.EntityRegistered{Id: `1`, FirstName: `John`, LastName: `Doe`})).To(BeTrue())
+	})
+
+	It(`Allow to resolve upcasted payload`, func() {
+		bb, err := proto.Marshal(&schema.EntityRegisteredV1{Id: `1`, Name: `John Doe`})
+		Expect(err).NotTo(HaveOccurred())
+
+		payload, err := testdata.VersionedEventMappings.Resolve(`EntityRegistered`, bb)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(payload).To(BeAssignableToTypeOf(&schema.EntityRegistered{}))
+	})
+
+	It(`Disallow to upcast event to previous version`, func() {
+		mappings := m.EventMappings{}.
+			Add(&schema.EntityRegisteredV1{}, m.EventName(`EntityRegistered`), m.EventVersion(2)).
+			Add(&schema.EntityRegisteredV2{}, m.EventName(`EntityRegistered`), m.EventVersion(3),
+				m.EventUpcast(func(payload interface{}) (interface{}, error) {
+					return &schema.EntityRegisteredV1{}, nil
+				}))
+
+		bb, err := proto.Marshal(&schema.EntityRegisteredV2{Id: `1`})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = mappings.ResolveLatest(`EntityRegistered.v3`, bb)
+		Expect(errors.Is(err, m.ErrEventUpcastVersion)).To(BeTrue())
+	})
+})
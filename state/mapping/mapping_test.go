@@ -99,6 +99,26 @@ var _ = Describe(`State mapping in chaincode`, func() {
 			Expect(entities.Items[0].Value).To(BeNumerically("==", create1.Value))
 		})
 
+		It("Allow to get entry list by non uniq index", func() {
+			entities := expectcc.PayloadIs(compositeIDCC.Query(`listBySecondPart`, `1`),
+				&schema.EntityWithCompositeIdList{}).(*schema.EntityWithCompositeIdList)
+			Expect(len(entities.Items)).To(Equal(2))
+			Expect(entities.Items[0].Name).To(Equal(create1.Name))
+			Expect(entities.Items[1].Name).To(Equal(create2.Name))
+
+			entities = expectcc.PayloadIs(compositeIDCC.Query(`listBySecondPart`, `2`),
+				&schema.EntityWithCompositeIdList{}).(*schema.EntityWithCompositeIdList)
+			Expect(len(entities.Items)).To(Equal(1))
+			Expect(entities.Items[0].Name).To(Equal(create3.Name))
+		})
+
+		It("Allow to get entry list by non uniq index with pagination", func() {
+			entities := expectcc.PayloadIs(compositeIDCC.Query(`listBySecondPartPaginated`, `1`, 1, ``),
+				&schema.EntityWithCompositeIdList{}).(*schema.EntityWithCompositeIdList)
+			Expect(len(entities.Items)).To(Equal(1))
+			Expect(entities.Items[0].Name).To(Equal(create1.Name))
+		})
+
 		It("Allow to get entry raw protobuf", func() {
 			dataFromCC := compositeIDCC.Query(`get`,
 				&schema.EntityCompositeId{
@@ -155,10 +175,19 @@ var _ = Describe(`State mapping in chaincode`, func() {
 
 			Expect(len(ee.Items)).To(Equal(2))
 			expectcc.ResponseError(compositeIDCC.Invoke(`get`, toDelete), state.ErrKeyNotFound)
+
+			ee = expectcc.PayloadIs(compositeIDCC.Query(`listBySecondPart`, `1`),
+				&schema.EntityWithCompositeIdList{}).(*schema.EntityWithCompositeIdList)
+			Expect(len(ee.Items)).To(Equal(1))
+			Expect(ee.Items[0].Name).To(Equal(create2.Name))
 		})
 
 		It("Allow to insert entry once more time", func() {
 			expectcc.ResponseOk(compositeIDCC.Invoke(`create`, create1))
+
+			ee := expectcc.PayloadIs(compositeIDCC.Query(`listBySecondPart`, `1`),
+				&schema.EntityWithCompositeIdList{}).(*schema.EntityWithCompositeIdList)
+			Expect(len(ee.Items)).To(Equal(2))
 		})
 	})
 
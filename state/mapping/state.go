@@ -3,8 +3,10 @@ package mapping
 import (
 	"fmt"
 
+	"github.com/golang/protobuf/proto"
 	pb "github.com/hyperledger/fabric-protos-go/peer"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	"github.com/s7techlab/cckit/state"
 	"github.com/s7techlab/cckit/state/schema"
@@ -27,14 +29,41 @@ type (
 
 		// GetByKey return one entry
 		GetByKey(schema interface{}, idx string, idxVal []string, target ...interface{}) (result interface{}, err error)
+
+		// ListByIndex returns list of entries, referred by index keys with idxValPrefix
+		ListByIndex(schema interface{}, idx string, idxValPrefix []string, target ...interface{}) (result interface{}, err error)
+
+		// ListPaginatedByIndex returns list of entries, referred by index keys with idxValPrefix, with pagination
+		ListPaginatedByIndex(schema interface{}, idx string, idxValPrefix []string, pageSize int32, bookmark string, target ...interface{}) (
+			result interface{}, metadata *pb.QueryResponseMetadata, err error)
+
+		// Patch updates fields of stored entry, listed in mask, returns patched entry
+		Patch(entry interface{}, mask *fieldmaskpb.FieldMask, opts ...PatchOpt) (result interface{}, err error)
+
+		// Aggregate returns count of entries and sums of numeric fields for group values prefix
+		Aggregate(schema interface{}, name string, groupPrefix []string) (result *schema.Aggregate, err error)
 	}
 
 	Impl struct {
 		state.State
 		mappings StateMappings
+		// tx is used if state doesn't implement state.TxStorer
+		tx *txState
+	}
+
+	// txState mapped entries of transaction, shared by mapped state wrappers of transaction
+	txState struct {
+		// entries written (entry) or deleted (nil) in transaction, by primary key,
+		// used for reference checks, because state reads don't see writes of current tx
+		entries map[string]interface{}
+		// deleting primary keys of entries being deleted, guards cascade delete cycles
+		deleting map[string]bool
 	}
 )
 
+// txStateKey key of mapped entries in transaction store
+const txStateKey = `mapping.txState`
+
 func WrapState(s state.State, mappings StateMappings) *Impl {
 	return &Impl{
 		State:    s,
@@ -42,6 +71,32 @@ func WrapState(s state.State, mappings StateMappings) *Impl {
 	}
 }
 
+// txState returns mapped entries of current transaction, stored in transaction store of state,
+// so entries, written with one wrapper, are seen by other wrappers of same transaction
+func (s *Impl) txState() *txState {
+	if storer, ok := s.State.(state.TxStorer); ok {
+		store := storer.TxStore()
+		if tx, ok := store[txStateKey].(*txState); ok {
+			return tx
+		}
+		tx := newTxState()
+		store[txStateKey] = tx
+		return tx
+	}
+
+	if s.tx == nil {
+		s.tx = newTxState()
+	}
+	return s.tx
+}
+
+func newTxState() *txState {
+	return &txState{
+		entries:  make(map[string]interface{}),
+		deleting: make(map[string]bool),
+	}
+}
+
 func (s *Impl) MappingNamespace(schema interface{}) (state.Key, error) {
 	m, err := s.mappings.Get(schema)
 	if err != nil {
@@ -68,7 +123,7 @@ func (s *Impl) Get(entry interface{}, target ...interface{}) (interface{}, error
 		target = append(target, targetFromMapping)
 	}
 
-	return s.State.Get(mapped, target...)
+	return s.stateFor(mapped.Mapper()).Get(mapped, target...)
 }
 
 func (s *Impl) GetHistory(entry interface{}, target interface{}) (state.HistoryEntryList, error) {
@@ -86,7 +141,7 @@ func (s *Impl) Exists(entry interface{}) (bool, error) {
 		return s.State.Exists(entry) // return as is
 	}
 
-	return s.State.Exists(mapped)
+	return s.stateFor(mapped.Mapper()).Exists(mapped)
 }
 
 func (s *Impl) Put(entry interface{}, value ...interface{}) error {
@@ -95,6 +150,24 @@ func (s *Impl) Put(entry interface{}, value ...interface{}) error {
 		return s.State.Put(entry, value...) // return as is
 	}
 
+	if err = validate(mapped.Mapper(), entry); err != nil {
+		return err
+	}
+
+	if err = s.checkReferences(mapped.Mapper(), entry); err != nil {
+		return err
+	}
+
+	st := s.stateFor(mapped.Mapper())
+
+	//get previous entry value, nil if entry not exists
+	var prevEntry interface{}
+	if len(mapped.Mapper().Indexes()) > 0 || len(mapped.Mapper().Aggregates()) > 0 {
+		if prevEntry, err = s.get(mapped, entry); err != nil {
+			prevEntry = nil
+		}
+	}
+
 	// update ref keys
 	if len(mapped.Mapper().Indexes()) > 0 {
 		keyRefs, err := mapped.Keys() // key refs based on current entry value, defined by mapping indexes
@@ -103,10 +176,8 @@ func (s *Impl) Put(entry interface{}, value ...interface{}) error {
 		}
 
 		var insertKeyRefs, deleteKeyRefs []state.KeyValue
-		//get previous entry value
-		prevEntry, err := s.Get(entry)
 
-		if err == nil { // prev exists
+		if prevEntry != nil { // prev exists
 
 			// prev entry exists, calculate refs to delete and to insert
 			prevMapped, err := s.mappings.Map(prevEntry)
@@ -130,20 +201,32 @@ func (s *Impl) Put(entry interface{}, value ...interface{}) error {
 
 		// delete previous key refs if key exists
 		for _, kr := range deleteKeyRefs {
-			if err = s.State.Delete(kr); err != nil {
+			if err = st.Delete(kr); err != nil {
 				return fmt.Errorf(`delete previous mapping key ref: %w`, err)
 			}
 		}
 
 		// insert new key refs
 		for _, kr := range insertKeyRefs {
-			if err = s.State.Insert(kr); err != nil {
+			if err = st.Insert(kr); err != nil {
 				return fmt.Errorf(`%s: %s`, ErrMappingUniqKeyExists, err)
 			}
 		}
 	}
 
-	return s.State.Put(mapped)
+	if err = s.updateAggregates(st, mapped.Mapper(), prevEntry, entry); err != nil {
+		return err
+	}
+
+	if err = st.Put(mapped); err != nil {
+		return err
+	}
+
+	if err = s.putPublic(mapped.Mapper(), mapped); err != nil {
+		return err
+	}
+
+	return s.txEntry(mapped, entry)
 }
 
 func (s *Impl) Insert(entry interface{}, value ...interface{}) error {
@@ -152,19 +235,85 @@ func (s *Impl) Insert(entry interface{}, value ...interface{}) error {
 		return s.State.Insert(entry, value...) // return as is
 	}
 
+	if err = validate(mapped.Mapper(), entry); err != nil {
+		return err
+	}
+
+	if err = s.checkReferences(mapped.Mapper(), entry); err != nil {
+		return err
+	}
+
 	keyRefs, err := mapped.Keys() // key refs, defined by mapping indexes
 	if err != nil {
 		return err
 	}
 
+	st := s.stateFor(mapped.Mapper())
+
 	// insert key refs, if key already exists - error returned
 	for _, kr := range keyRefs {
-		if err = s.State.Insert(kr); err != nil {
+		if err = st.Insert(kr); err != nil {
 			return fmt.Errorf(`%s: %s`, ErrMappingUniqKeyExists, err)
 		}
 	}
 
-	return s.State.Insert(mapped)
+	if err = st.Insert(mapped); err != nil {
+		return err
+	}
+
+	if err = s.updateAggregates(st, mapped.Mapper(), nil, entry); err != nil {
+		return err
+	}
+
+	if err = s.putPublic(mapped.Mapper(), mapped); err != nil {
+		return err
+	}
+
+	return s.txEntry(mapped, entry)
+}
+
+// txEntry remembers entry, written (entry) or deleted (nil) in transaction
+func (s *Impl) txEntry(mapped *StateInstance, entry interface{}) error {
+	key, err := mapped.Key()
+	if err != nil {
+		return err
+	}
+
+	s.txState().entries[key.String()] = cloneEntry(entry)
+	return nil
+}
+
+// get returns mapped entry, taking into account entries, written or deleted in transaction
+func (s *Impl) get(mapped *StateInstance, entry interface{}) (interface{}, error) {
+	key, err := mapped.Key()
+	if err != nil {
+		return nil, err
+	}
+
+	if txEntry, ok := s.txState().entries[key.String()]; ok {
+		if txEntry == nil {
+			return nil, fmt.Errorf(`%w: %s`, state.ErrKeyNotFound, key)
+		}
+		return cloneEntry(txEntry), nil
+	}
+
+	return s.Get(entry)
+}
+
+// exists checks mapped entry existence, taking into account entries, written or deleted in transaction
+func (s *Impl) exists(m StateMapper, key state.Key) (bool, error) {
+	if txEntry, ok := s.txState().entries[key.String()]; ok {
+		return txEntry != nil, nil
+	}
+
+	return s.stateFor(m).Exists(key)
+}
+
+func cloneEntry(entry interface{}) interface{} {
+	if msg, ok := entry.(proto.Message); ok {
+		return proto.Clone(msg)
+	}
+	return entry
 }
 
 func (s *Impl) List(entry interface{}, target ...interface{}) (interface{}, error) {
@@ -180,7 +329,7 @@ func (s *Impl) List(entry interface{}, target ...interface{}) (interface{}, erro
 	namespace := m.Namespace()
 	s.Logger().Debug(`state mapped LIST`, zap.String(`namespace`, namespace.String()))
 
-	return s.State.List(namespace, m.Schema(), m.List())
+	return s.stateFor(m).List(namespace, m.Schema(), m.List())
 }
 
 func (s *Impl) ListPaginated(entry interface{}, pageSize int32, bookmark string, target ...interface{}) (
@@ -198,7 +347,7 @@ func (s *Impl) ListPaginated(entry interface{}, pageSize int32, bookmark string,
 	s.Logger().Debug(`state mapped LIST`, zap.String(`namespace`, namespace.String()),
 		zap.Int32("pageSize", pageSize), zap.String("bookmark", bookmark))
 
-	return s.State.ListPaginated(namespace, pageSize, bookmark, m.Schema(), m.List())
+	return s.stateFor(m).ListPaginated(namespace, pageSize, bookmark, m.Schema(), m.List())
 }
 
 func (s *Impl) ListWith(entry interface{}, key state.Key) (result interface{}, err error) {
@@ -213,7 +362,7 @@ func (s *Impl) ListWith(entry interface{}, key state.Key) (result interface{}, e
 	namespace := m.Namespace()
 	s.Logger().Debug(`state mapped LIST`, zap.String(`namespace`, namespace.String()), zap.String(`list`, namespace.Append(key).String()))
 
-	return s.State.List(namespace.Append(key), m.Schema(), m.List())
+	return s.stateFor(m).List(namespace.Append(key), m.Schema(), m.List())
 }
 
 func (s *Impl) ListPaginatedWith(
@@ -232,7 +381,7 @@ func (s *Impl) ListPaginatedWith(
 		zap.String(`namespace`, namespace.String()), zap.String(`list`, namespace.Append(key).String()),
 		zap.Int32("pageSize", pageSize), zap.String("bookmark", bookmark))
 
-	return s.State.ListPaginated(namespace.Append(key), pageSize, bookmark, m.Schema(), m.List())
+	return s.stateFor(m).ListPaginated(namespace.Append(key), pageSize, bookmark, m.Schema(), m.List())
 }
 
 func (s *Impl) GetByUniqKey(
@@ -243,16 +392,138 @@ func (s *Impl) GetByUniqKey(
 func (s *Impl) GetByKey(
 	entry interface{}, idx string, idxVal []string, target ...interface{}) (result interface{}, err error) {
 
-	if !s.mappings.Exists(entry) {
+	m, err := s.mappings.Get(entry)
+	if err != nil {
 		return nil, ErrStateMappingNotFound
 	}
 
-	keyRef, err := s.State.Get(NewKeyRefIDInstance(entry, idx, idxVal), &schema.KeyRef{})
+	if index := mappingIndex(m, idx); index != nil && !index.Uniq {
+		return nil, fmt.Errorf(`%s: {%s}.%s`, ErrIndexNotUniq, mapKey(entry), idx)
+	}
+
+	st := s.stateFor(m)
+	keyRef, err := st.Get(NewKeyRefIDInstance(entry, idx, idxVal), &schema.KeyRef{})
 	if err != nil {
 		return nil, fmt.Errorf(`%s: {%s}.%s: %w`, ErrIndexReferenceNotFound, mapKey(entry), idx, err)
 	}
 
-	return s.State.Get(keyRef.(*schema.KeyRef).PKey, target...)
+	return st.Get(keyRef.(*schema.KeyRef).PKey, target...)
+}
+
+func (s *Impl) ListByIndex(
+	entry interface{}, idx string, idxValPrefix []string, target ...interface{}) (result interface{}, err error) {
+	m, err := s.indexMapping(entry, idx)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.listByIndex(m, idx, idxValPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	return entriesToList(m, entries, target...)
+}
+
+// listByIndex returns entries, referred by index keys with idxValPrefix
+func (s *Impl) listByIndex(m StateMapper, idx string, idxValPrefix []string) ([]interface{}, error) {
+	keyRefPrefix, err := NewKeyRefIDInstance(m.Schema(), idx, idxValPrefix).Key()
+	if err != nil {
+		return nil, err
+	}
+	s.Logger().Debug(`state mapped LIST by index`, zap.String(`index`, keyRefPrefix.String()))
+
+	keyRefs, err := s.stateFor(m).List(keyRefPrefix, &schema.KeyRef{}, &schema.KeyRefList{})
+	if err != nil {
+		return nil, fmt.Errorf(`list key refs: %w`, err)
+	}
+
+	return s.keyRefsToEntries(m, keyRefs.(*schema.KeyRefList))
+}
+
+func (s *Impl) ListPaginatedByIndex(
+	entry interface{}, idx string, idxValPrefix []string, pageSize int32, bookmark string, target ...interface{}) (
+	result interface{}, metadata *pb.QueryResponseMetadata, err error) {
+	m, err := s.indexMapping(entry, idx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyRefPrefix, err := NewKeyRefIDInstance(entry, idx, idxValPrefix).Key()
+	if err != nil {
+		return nil, nil, err
+	}
+	s.Logger().Debug(`state mapped LIST by index`, zap.String(`index`, keyRefPrefix.String()),
+		zap.Int32("pageSize", pageSize), zap.String("bookmark", bookmark))
+
+	keyRefs, metadata, err := s.stateFor(m).ListPaginated(keyRefPrefix, pageSize, bookmark, &schema.KeyRef{}, &schema.KeyRefList{})
+	if err != nil {
+		return nil, nil, fmt.Errorf(`list key refs: %w`, err)
+	}
+
+	entries, err := s.keyRefsToEntries(m, keyRefs.(*schema.KeyRefList))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result, err = entriesToList(m, entries, target...)
+	return result, metadata, err
+}
+
+// indexMapping returns mapping of entry, if mapping has index with name idx
+func (s *Impl) indexMapping(entry interface{}, idx string) (StateMapper, error) {
+	m, err := s.mappings.Get(entry)
+	if err != nil {
+		return nil, fmt.Errorf(`mapping: %w`, err)
+	}
+
+	if mappingIndex(m, idx) == nil {
+		return nil, fmt.Errorf(`%s: {%s}.%s`, ErrIndexNotFound, mapKey(entry), idx)
+	}
+
+	return m, nil
+}
+
+// keyRefsToEntries resolves key refs to entries
+func (s *Impl) keyRefsToEntries(m StateMapper, keyRefs *schema.KeyRefList) ([]interface{}, error) {
+	var entries []interface{}
+	for _, keyRef := range keyRefs.Items {
+		entry, err := s.stateFor(m).Get(keyRef.PKey, m.Schema())
+		if err != nil {
+			return nil, fmt.Errorf(`%s: %s: %w`, ErrIndexReferenceNotFound, keyRef.Idx, err)
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// entriesToList returns entries as list, defined in mapping or in target
+func entriesToList(m StateMapper, entries []interface{}, target ...interface{}) (interface{}, error) {
+	listTarget := m.List()
+	if len(target) > 0 {
+		listTarget = target[0]
+	}
+
+	list, err := state.NewStateList(m.Schema(), listTarget)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		list.AddElementToList(entry)
+	}
+
+	return list.Get()
+}
+
+func mappingIndex(m StateMapper, name string) *StateIndex {
+	for _, idx := range m.Indexes() {
+		if idx.Name == name {
+			return idx
+		}
+	}
+	return nil
 }
 
 func (s *Impl) Delete(entry interface{}) error {
@@ -263,29 +534,63 @@ func (s *Impl) Delete(entry interface{}) error {
 	// we need full entry data from state
 	// AND entry can be record to delete or reference to record
 	// If entry is keyer entity for another entry (reference)
-	entry, err := s.Get(entry)
+	mapped, err := s.mappings.Map(entry)
 	if err != nil {
 		return err
 	}
 
-	mapped, err := s.mappings.Map(entry)
+	if entry, err = s.get(mapped, entry); err != nil {
+		return err
+	}
+
+	if mapped, err = s.mappings.Map(entry); err != nil {
+		return err
+	}
+
+	key, err := mapped.Key()
 	if err != nil {
 		return err
 	}
 
+	// entry is already being deleted, i.e. with cascade delete of referring entries cycle
+	deleting := s.txState().deleting
+	if deleting[key.String()] {
+		return nil
+	}
+	deleting[key.String()] = true
+	defer delete(deleting, key.String())
+
+	if err = s.applyReferencesOnDelete(mapped.Mapper(), entry); err != nil {
+		return err
+	}
+
 	keyRefs, err := mapped.Keys() // additional keys
 	if err != nil {
 		return err
 	}
 
+	st := s.stateFor(mapped.Mapper())
+
 	// delete uniq key refs
 	for _, kr := range keyRefs {
-		if err = s.State.Delete(kr); err != nil {
+		if err = st.Delete(kr); err != nil {
 			return fmt.Errorf(`delete ref key: %w`, err)
 		}
 	}
 
-	return s.State.Delete(mapped)
+	if err = s.updateAggregates(st, mapped.Mapper(), entry, nil); err != nil {
+		return err
+	}
+
+	if err = st.Delete(mapped); err != nil {
+		return err
+	}
+
+	if err = s.deletePublic(mapped.Mapper(), mapped); err != nil {
+		return err
+	}
+
+	return s.txEntry(mapped, nil)
 }
 
 func (s *Impl) Logger() *zap.Logger {
@@ -335,6 +640,10 @@ func (s *Impl) InsertPrivate(collection string, entry interface{}, value ...inte
 		return s.State.InsertPrivate(collection, entry, value...) // return as is
 	}
 
+	if err = validate(mapped.Mapper(), entry); err != nil {
+		return err
+	}
+
 	keyRefs, err := mapped.Keys() // additional keys
 	if err != nil {
 		return
@@ -356,6 +665,10 @@ func (s *Impl) PutPrivate(collection string, entry interface{}, value ...interfa
 		return s.State.PutPrivate(collection, entry, value...) // return as is
 	}
 
+	if err = validate(mapped.Mapper(), entry); err != nil {
+		return err
+	}
+
 	keyRefs, err := mapped.Keys() // additional keys
 	if err != nil {
 		return
@@ -0,0 +1,235 @@
+package mapping
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/s7techlab/cckit/state"
+	"github.com/s7techlab/cckit/state/schema"
+)
+
+type (
+	// StateAggregate count of mapped entries and sums of numeric fields, maintained on Insert, Put and Delete.
+	// Each entry stores own contribution to aggregate, so concurrent transactions don't update same key,
+	// aggregate is calculated from contributions on read. Integer fields are summed as int64 or uint64, float fields - as float64
+	StateAggregate struct {
+		Name string
+		// GroupBy fields, aggregate is calculated for each group values, empty - for all entries
+		GroupBy []string
+		// Sum numeric fields
+		Sum []string
+	}
+
+	StateAggregateDef struct {
+		// Name of aggregate, by default - joined group by field names or `all`
+		Name    string
+		GroupBy []string
+		Sum     []string
+	}
+)
+
+const (
+	// AggregateNamespace namespace for entries contributions to aggregates
+	AggregateNamespace = `_agg`
+
+	// AggregateAll default name of aggregate without group by fields
+	AggregateAll = `all`
+)
+
+// WithAggregate defines aggregate, maintained for mapped entries
+func WithAggregate(def *StateAggregateDef) StateMappingOpt {
+	return func(sm *StateMapping, smm StateMappings) {
+		name := def.Name
+		if name == `` {
+			name = AggregateAll
+			if len(def.GroupBy) > 0 {
+				name = strings.Join(def.GroupBy, `-`)
+			}
+		}
+
+		sm.aggregates = append(sm.aggregates, &StateAggregate{
+			Name:    name,
+			GroupBy: def.GroupBy,
+			Sum:     def.Sum,
+		})
+	}
+}
+
+// CountBy defines aggregate with count of entries, grouped by fields
+func CountBy(groupBy ...string) StateMappingOpt {
+	return WithAggregate(&StateAggregateDef{GroupBy: groupBy})
+}
+
+// contribution returns contribution of entry to aggregate
+func (a *StateAggregate) contribution(m StateMapper, entry interface{}) (*schema.Aggregate, error) {
+	var group state.Key
+	if len(a.GroupBy) > 0 {
+		key, err := attrsKeyer(a.GroupBy)(entry)
+		if err != nil {
+			return nil, fmt.Errorf(`aggregate %s: %w`, a.Name, err)
+		}
+		group = key
+	}
+
+	contribution := &schema.Aggregate{
+		Schema: NewKeyRefID(m.Schema(), ``, nil).Schema,
+		Name:   a.Name,
+		Group:  group,
+		Count:  1,
+	}
+
+	v := reflect.Indirect(reflect.ValueOf(entry))
+	for _, field := range a.Sum {
+		f := v.FieldByName(field)
+		if !f.IsValid() {
+			return nil, fmt.Errorf(`aggregate %s: %s: %w`, a.Name, field, ErrFieldNotExists)
+		}
+
+		if err := addNumericValue(contribution, field, f); err != nil {
+			return nil, fmt.Errorf(`aggregate %s: %s: %w`, a.Name, field, err)
+		}
+	}
+
+	return contribution, nil
+}
+
+// aggregateKey returns key of entry contribution to aggregate
+// key will be <`_agg`,{SchemaName},{aggName}, {Group[1]},... {Group[n]}, {PKey[1]},... {PKey[n]}>
+func aggregateKey(a *schema.Aggregate, pKey state.Key) state.Key {
+	key := state.Key{AggregateNamespace, a.Schema, a.Name}
+	key = append(key, a.Group...)
+	return append(key, pKey...)
+}
+
+// addNumericValue adds field value to sum, integer fields are summed as integers without loss of precision
+func addNumericValue(a *schema.Aggregate, field string, v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if a.IntSum == nil {
+			a.IntSum = make(map[string]int64)
+		}
+		a.IntSum[field] += v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if a.UintSum == nil {
+			a.UintSum = make(map[string]uint64)
+		}
+		a.UintSum[field] += v.Uint()
+	case reflect.Float32, reflect.Float64:
+		if a.Sum == nil {
+			a.Sum = make(map[string]float64)
+		}
+		a.Sum[field] += v.Float()
+	default:
+		return ErrFieldTypeNotSupportedForAggregate
+	}
+
+	return nil
+}
+
+// updateAggregates updates contributions of entry to aggregates, prev or entry can be nil on insert or delete
+func (s *Impl) updateAggregates(st state.State, m StateMapper, prev, entry interface{}) error {
+	if len(m.Aggregates()) == 0 {
+		return nil
+	}
+
+	var pKey state.Key
+	var err error
+	for _, e := range []interface{}{entry, prev} {
+		if e != nil {
+			if pKey, err = m.PrimaryKey(e); err != nil {
+				return err
+			}
+			break
+		}
+	}
+
+	for _, a := range m.Aggregates() {
+		var prevKey, key state.Key
+		if prev != nil {
+			c, err := a.contribution(m, prev)
+			if err != nil {
+				return err
+			}
+			prevKey = aggregateKey(c, pKey)
+		}
+
+		if entry != nil {
+			c, err := a.contribution(m, entry)
+			if err != nil {
+				return err
+			}
+			key = aggregateKey(c, pKey)
+
+			if err = st.Put(key, c); err != nil {
+				return fmt.Errorf(`put aggregate %s: %w`, a.Name, err)
+			}
+		}
+
+		if prevKey != nil && prevKey.String() != key.String() {
+			if err = st.Delete(prevKey); err != nil {
+				return fmt.Errorf(`delete aggregate %s: %w`, a.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Aggregate returns count of entries and sums of numeric fields for group values prefix.
+// Aggregate is not stored as running total: contributions of all entries of group are read with range query
+// on each call, so cost is O(N) reads and transaction with Aggregate conflicts with concurrent writes of group entries.
+// Use it in queries, not in transactions with writes
+func (s *Impl) Aggregate(entry interface{}, name string, groupPrefix []string) (*schema.Aggregate, error) {
+	m, err := s.mappings.Get(entry)
+	if err != nil {
+		return nil, fmt.Errorf(`mapping: %w`, err)
+	}
+
+	if aggregate(m, name) == nil {
+		return nil, fmt.Errorf(`%w: {%s}.%s`, ErrAggregateNotFound, mapKey(entry), name)
+	}
+
+	result := &schema.Aggregate{
+		Schema:  NewKeyRefID(m.Schema(), ``, nil).Schema,
+		Name:    name,
+		Group:   groupPrefix,
+		Sum:     make(map[string]float64),
+		IntSum:  make(map[string]int64),
+		UintSum: make(map[string]uint64),
+	}
+
+	prefix := aggregateKey(result, nil)
+	s.Logger().Debug(`state mapped AGGREGATE`, zap.String(`prefix`, prefix.String()))
+
+	contributions, err := s.stateFor(m).List(prefix, &schema.Aggregate{}, &schema.AggregateList{})
+	if err != nil {
+		return nil, fmt.Errorf(`list aggregate contributions: %w`, err)
+	}
+
+	for _, c := range contributions.(*schema.AggregateList).Items {
+		result.Count += c.Count
+		for field, value := range c.Sum {
+			result.Sum[field] += value
+		}
+		for field, value := range c.IntSum {
+			result.IntSum[field] += value
+		}
+		for field, value := range c.UintSum {
+			result.UintSum[field] += value
+		}
+	}
+
+	return result, nil
+}
+
+func aggregate(m StateMapper, name string) *StateAggregate {
+	for _, a := range m.Aggregates() {
+		if a.Name == name {
+			return a
+		}
+	}
+	return nil
+}
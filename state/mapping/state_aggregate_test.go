@@ -0,0 +1,99 @@
+package mapping_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	m "github.com/s7techlab/cckit/state/mapping"
+	"github.com/s7techlab/cckit/state/mapping/testdata"
+	"github.com/s7techlab/cckit/state/mapping/testdata/schema"
+	testcc "github.com/s7techlab/cckit/testing"
+)
+
+var _ = Describe(`State aggregate`, func() {
+
+	var (
+		cc, ctx = testcc.NewTxHandler(`aggregate`)
+
+		mapped = func() m.MappedState {
+			return m.WrapState(ctx.State(), testdata.AggregatedEntityStateMapping)
+		}
+
+		expectAggregate = func(name string, group []string, count uint64, sum map[string]int64) {
+			cc.Tx(func() {
+				agg, err := mapped().Aggregate(&schema.EntityWithIndexes{}, name, group)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(agg.Count).To(Equal(count))
+				if sum != nil {
+					Expect(agg.IntSum).To(Equal(sum))
+				}
+			})
+		}
+	)
+
+	It(`Allow to count inserted entries`, func() {
+		cc.Tx(func() {
+			Expect(mapped().Insert(&schema.EntityWithIndexes{Id: `a`, ExternalId: `red`, Value: 1})).NotTo(HaveOccurred())
+			Expect(mapped().Insert(&schema.EntityWithIndexes{Id: `b`, ExternalId: `red`, Value: 2})).NotTo(HaveOccurred())
+			Expect(mapped().Insert(&schema.EntityWithIndexes{Id: `c`, ExternalId: `blue`, Value: 10})).NotTo(HaveOccurred())
+		})
+
+		expectAggregate(m.AggregateAll, nil, 3, nil)
+		expectAggregate(`ExternalId`, []string{`red`}, 2, map[string]int64{`Value`: 3})
+		expectAggregate(`ExternalId`, []string{`blue`}, 1, map[string]int64{`Value`: 10})
+		expectAggregate(`ExternalId`, nil, 3, map[string]int64{`Value`: 13})
+	})
+
+	It(`Allow to move entry contribution to other group on put`, func() {
+		cc.Tx(func() {
+			Expect(mapped().Put(&schema.EntityWithIndexes{Id: `b`, ExternalId: `blue`, Value: 5})).NotTo(HaveOccurred())
+		})
+
+		expectAggregate(m.AggregateAll, nil, 3, nil)
+		expectAggregate(`ExternalId`, []string{`red`}, 1, map[string]int64{`Value`: 1})
+		expectAggregate(`ExternalId`, []string{`blue`}, 2, map[string]int64{`Value`: 15})
+	})
+
+	It(`Allow to update entry contribution on patch`, func() {
+		cc.Tx(func() {
+			_, err := mapped().Patch(&schema.EntityWithIndexes{Id: `c`, Value: 20},
+				&fieldmaskpb.FieldMask{Paths: []string{`value`}})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		expectAggregate(`ExternalId`, []string{`blue`}, 2, map[string]int64{`Value`: 25})
+	})
+
+	It(`Allow to decrement aggregate on delete`, func() {
+		cc.Tx(func() {
+			Expect(mapped().Delete(&schema.EntityWithIndexes{Id: `a`})).NotTo(HaveOccurred())
+		})
+
+		expectAggregate(m.AggregateAll, nil, 2, nil)
+		expectAggregate(`ExternalId`, []string{`red`}, 0, map[string]int64{})
+	})
+
+	It(`Allow to sum integer fields without loss of precision`, func() {
+		cc.Tx(func() {
+			Expect(mapped().Insert(&schema.EntityWithIndexes{Id: `d`, ExternalId: `green`, Amount: 1<<60 + 1})).NotTo(HaveOccurred())
+			Expect(mapped().Insert(&schema.EntityWithIndexes{Id: `e`, ExternalId: `green`, Amount: 1<<60 + 2})).NotTo(HaveOccurred())
+		})
+
+		cc.Tx(func() {
+			agg, err := mapped().Aggregate(&schema.EntityWithIndexes{}, `ExternalId`, []string{`green`})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(agg.UintSum).To(Equal(map[string]uint64{`Amount`: 1<<61 + 3}))
+			Expect(agg.Sum).To(BeEmpty())
+		})
+	})
+
+	It(`Disallow to get unknown aggregate`, func() {
+		cc.Tx(func() {
+			_, err := mapped().Aggregate(&schema.EntityWithIndexes{}, `unknown`, nil)
+			Expect(errors.Is(err, m.ErrAggregateNotFound)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,220 @@
+package mapping
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	pb "github.com/hyperledger/fabric-protos-go/peer"
+
+	"github.com/s7techlab/cckit/state"
+)
+
+type (
+	// CollectionPublicEntry defines what is stored in public state for entry from private data collection
+	CollectionPublicEntry int
+
+	// StateCollection private data collection of mapped entries
+	StateCollection struct {
+		Name   string
+		Public CollectionPublicEntry
+	}
+
+	CollectionOpt func(*StateCollection)
+
+	// collectionState routes state operations to private data collection
+	collectionState struct {
+		state.State
+		collection *StateCollection
+	}
+)
+
+const (
+	// CollectionPublicNone nothing stored in public state
+	CollectionPublicNone CollectionPublicEntry = iota
+	// CollectionPublicHash sha256 hash of private entry stored in public state with same key
+	CollectionPublicHash
+	// CollectionPublicStub stub value stored in public state with same key
+	CollectionPublicStub
+)
+
+// CollectionPublicStubValue value of public stub entry, empty value cannot be put to state
+var CollectionPublicStubValue = []byte(`{}`)
+
+// WithCollection stores mapped entries and their key refs in private data collection.
+// Put, Insert, Get, Exists, List, ListPaginated and Delete of mapped state are routed to collection.
+// Private data has no paginated queries, so paginated list iterates namespace from the beginning until page is filled
+func WithCollection(name string, opts ...CollectionOpt) StateMappingOpt {
+	return func(sm *StateMapping, smm StateMappings) {
+		sm.collection = &StateCollection{Name: name}
+		for _, opt := range opts {
+			opt(sm.collection)
+		}
+	}
+}
+
+// CollectionPublicHashEntry also stores hash of private entry in public state
+func CollectionPublicHashEntry() CollectionOpt {
+	return func(c *StateCollection) {
+		c.Public = CollectionPublicHash
+	}
+}
+
+// CollectionPublicStubEntry also stores stub entry in public state
+func CollectionPublicStubEntry() CollectionOpt {
+	return func(c *StateCollection) {
+		c.Public = CollectionPublicStub
+	}
+}
+
+// stateFor returns state for mapped entries: public state or private data collection
+func (s *Impl) stateFor(m StateMapper) state.State {
+	if c := s.collection(m); c != nil {
+		return &collectionState{State: s.State, collection: c}
+	}
+	return s.State
+}
+
+// collection returns private data collection of mapper, key mapper uses collection of target mapper
+func (s *Impl) collection(m StateMapper) *StateCollection {
+	if keyerFor := m.KeyerFor(); keyerFor != nil {
+		if target, err := s.mappings.Get(keyerFor); err == nil {
+			m = target
+		}
+	}
+	return m.Collection()
+}
+
+// putPublic puts public hash or stub of private entry
+func (s *Impl) putPublic(m StateMapper, mapped *StateInstance) error {
+	c := s.collection(m)
+	if c == nil {
+		return nil
+	}
+
+	var value []byte
+	switch c.Public {
+	case CollectionPublicHash:
+		bb, err := mapped.ToBytes()
+		if err != nil {
+			return err
+		}
+		hash := sha256.Sum256(bb)
+		value = hash[:]
+
+	case CollectionPublicStub:
+		value = CollectionPublicStubValue
+
+	default:
+		return nil
+	}
+
+	key, err := mapped.Key()
+	if err != nil {
+		return err
+	}
+
+	if err = s.State.Put(key, value); err != nil {
+		return fmt.Errorf(`put public %s entry: %w`, c.Name, err)
+	}
+	return nil
+}
+
+// deletePublic deletes public hash or stub of private entry
+func (s *Impl) deletePublic(m StateMapper, mapped *StateInstance) error {
+	c := s.collection(m)
+	if c == nil || c.Public == CollectionPublicNone {
+		return nil
+	}
+
+	key, err := mapped.Key()
+	if err != nil {
+		return err
+	}
+
+	if err = s.State.Delete(key); err != nil {
+		return fmt.Errorf(`delete public %s entry: %w`, c.Name, err)
+	}
+	return nil
+}
+
+func (c *collectionState) Get(entry interface{}, target ...interface{}) (interface{}, error) {
+	return c.State.GetPrivate(c.collection.Name, entry, target...)
+}
+
+func (c *collectionState) Exists(entry interface{}) (bool, error) {
+	return c.State.ExistsPrivate(c.collection.Name, entry)
+}
+
+func (c *collectionState) Put(entry interface{}, value ...interface{}) error {
+	return c.State.PutPrivate(c.collection.Name, entry, value...)
+}
+
+func (c *collectionState) Insert(entry interface{}, value ...interface{}) error {
+	return c.State.InsertPrivate(c.collection.Name, entry, value...)
+}
+
+func (c *collectionState) Delete(entry interface{}) error {
+	return c.State.DeletePrivate(c.collection.Name, entry)
+}
+
+func (c *collectionState) List(namespace interface{}, target ...interface{}) (interface{}, error) {
+	return c.State.ListPrivate(c.collection.Name, true, namespace, target...)
+}
+
+// ListPaginated lists entries of page of private data keys, private data has no paginated queries,
+// so namespace is iterated from the beginning until page is filled
+func (c *collectionState) ListPaginated(
+	namespace interface{}, pageSize int32, bookmark string, target ...interface{}) (
+	interface{}, *pb.QueryResponseMetadata, error) {
+	keys, md, err := c.KeysPaginated(namespace, pageSize, bookmark)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	list, err := state.NewStateList(target...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var entryTarget []interface{}
+	if len(target) > 0 {
+		entryTarget = target[:1]
+	}
+
+	for _, key := range keys {
+		entry, err := c.Get(key, entryTarget...)
+		if err != nil {
+			return nil, nil, err
+		}
+		list.AddElementToList(entry)
+	}
+
+	result, err := list.Get()
+	return result, md, err
+}
+
+func (c *collectionState) Keys(namespace interface{}) ([]string, error) {
+	lister, err := c.keysLister()
+	if err != nil {
+		return nil, err
+	}
+	return lister.KeysPrivate(c.collection.Name, namespace)
+}
+
+func (c *collectionState) KeysPaginated(namespace interface{}, pageSize int32, bookmark string) (
+	[]string, *pb.QueryResponseMetadata, error) {
+	lister, err := c.keysLister()
+	if err != nil {
+		return nil, nil, err
+	}
+	return lister.KeysPrivatePaginated(c.collection.Name, namespace, pageSize, bookmark)
+}
+
+// keysLister returns state as private keys lister, it's optional interface of state implementation
+func (c *collectionState) keysLister() (state.PrivateKeysLister, error) {
+	lister, ok := c.State.(state.PrivateKeysLister)
+	if !ok {
+		return nil, fmt.Errorf(`%w: %s: keys listing not supported by state`, ErrCollectionNotSupported, c.collection.Name)
+	}
+	return lister, nil
+}
@@ -0,0 +1,159 @@
+package mapping_test
+
+import (
+	"crypto/sha256"
+	"errors"
+
+	"github.com/golang/protobuf/proto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/s7techlab/cckit/state"
+	m "github.com/s7techlab/cckit/state/mapping"
+	"github.com/s7techlab/cckit/state/mapping/testdata"
+	"github.com/s7techlab/cckit/state/mapping/testdata/schema"
+	testcc "github.com/s7techlab/cckit/testing"
+)
+
+var _ = Describe(`State collection`, func() {
+
+	var (
+		cc, ctx = testcc.NewTxHandler(`collection`)
+
+		mapped = func() m.MappedState {
+			return m.WrapState(ctx.State(), testdata.PrivateEntityStateMapping)
+		}
+
+		entity = &schema.EntityWithIndexes{Id: `aaa`, ExternalId: `aaa_aaa`, Value: 1}
+	)
+
+	It(`Allow to insert entry to private collection`, func() {
+		cc.Tx(func() {
+			Expect(mapped().Insert(entity)).NotTo(HaveOccurred())
+		})
+
+		cc.Tx(func() {
+			Expect(ctx.State().ExistsPrivate(testdata.PrivateCollection,
+				state.Key{`EntityWithIndexes`, `aaa`})).To(BeTrue())
+
+			// key ref in same collection
+			refKey, err := m.NewKeyRefIDInstance(entity, `ExternalId`, state.Key{`aaa_aaa`}).Key()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ctx.State().ExistsPrivate(testdata.PrivateCollection, refKey)).To(BeTrue())
+			Expect(ctx.State().Exists(refKey)).To(BeFalse())
+		})
+	})
+
+	It(`Allow to get entry from private collection`, func() {
+		cc.Tx(func() {
+			e, err := mapped().Get(&schema.EntityWithIndexes{Id: `aaa`})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(proto.Equal(e.(proto.Message), entity)).To(BeTrue())
+
+			e, err = mapped().GetByKey(&schema.EntityWithIndexes{}, `ExternalId`, []string{`aaa_aaa`}, &schema.EntityWithIndexes{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(proto.Equal(e.(proto.Message), entity)).To(BeTrue())
+
+			list, err := mapped().List(&schema.EntityWithIndexes{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(list.(*schema.EntityWithIndexesList).Items).To(HaveLen(1))
+		})
+	})
+
+	It(`Allow to get hash of private entry from public state`, func() {
+		cc.Tx(func() {
+			hash, err := ctx.State().Get(state.Key{`EntityWithIndexes`, `aaa`}, []byte{})
+			Expect(err).NotTo(HaveOccurred())
+
+			bb, err := proto.Marshal(entity)
+			Expect(err).NotTo(HaveOccurred())
+			expected := sha256.Sum256(bb)
+			Expect(hash).To(Equal(expected[:]))
+		})
+	})
+
+	It(`Allow paginated list of private entries`, func() {
+		cc.Tx(func() {
+			Expect(mapped().Insert(&schema.EntityWithIndexes{Id: `bbb`, ExternalId: `bbb_bbb`, Value: 2})).NotTo(HaveOccurred())
+			Expect(mapped().Insert(&schema.EntityWithIndexes{Id: `ccc`, ExternalId: `ccc_ccc`, Value: 3})).NotTo(HaveOccurred())
+		})
+
+		cc.Tx(func() {
+			list, md, err := mapped().ListPaginated(&schema.EntityWithIndexes{}, 2, ``)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(list.(*schema.EntityWithIndexesList).Items).To(HaveLen(2))
+			Expect(md.Bookmark).NotTo(BeEmpty())
+
+			list, md, err = mapped().ListPaginated(&schema.EntityWithIndexes{}, 2, md.Bookmark)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(list.(*schema.EntityWithIndexesList).Items).To(HaveLen(1))
+			Expect(list.(*schema.EntityWithIndexesList).Items[0].Id).To(Equal(`ccc`))
+			Expect(md.Bookmark).To(BeEmpty())
+
+			list, md, err = mapped().ListPaginatedByIndex(&schema.EntityWithIndexes{}, `ExternalId`, []string{}, 2, ``)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(list.(*schema.EntityWithIndexesList).Items).To(HaveLen(2))
+			Expect(md.Bookmark).NotTo(BeEmpty())
+		})
+	})
+
+	It(`Allow to check indexes of private entries in batches`, func() {
+		cc.Tx(func() {
+			refKey, err := m.NewKeyRefIDInstance(entity, `ExternalId`, state.Key{`bbb_bbb`}).Key()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ctx.State().DeletePrivate(testdata.PrivateCollection, refKey)).NotTo(HaveOccurred())
+		})
+
+		var (
+			issues   []*m.IndexIssue
+			checked  uint32
+			bookmark string
+		)
+		for {
+			cc.Tx(func() {
+				res, err := m.CheckIndexes(ctx.State(), testdata.PrivateEntityStateMapping,
+					&schema.EntityWithIndexes{}, 2, bookmark)
+				Expect(err).NotTo(HaveOccurred())
+				issues = append(issues, res.Issues...)
+				checked += res.Checked
+				bookmark = res.Bookmark
+			})
+			if bookmark == `` {
+				break
+			}
+		}
+
+		// 3 entries, 2 key refs
+		Expect(checked).To(Equal(uint32(5)))
+		Expect(issues).To(HaveLen(1))
+		Expect(issues[0].Type).To(Equal(m.IndexIssueMissing))
+		Expect(issues[0].PKey).To(Equal(state.Key{`EntityWithIndexes`, `bbb`}))
+	})
+
+	It(`Disallow to check indexes of private entries with state without private keys listing`, func() {
+		cc.Tx(func() {
+			// state.PrivateKeysLister is not implemented by wrapper
+			_, err := m.CheckIndexes(struct{ state.State }{ctx.State()}, testdata.PrivateEntityStateMapping,
+				&schema.EntityWithIndexes{}, 0, ``)
+			Expect(errors.Is(err, m.ErrCollectionNotSupported)).To(BeTrue())
+		})
+	})
+
+	It(`Disallow to rebuild indexes of private entries`, func() {
+		cc.Tx(func() {
+			_, err := m.RebuildIndexes(ctx.State(), testdata.PrivateEntityStateMapping, &schema.EntityWithIndexes{}, 0, ``)
+			Expect(errors.Is(err, m.ErrCollectionNotSupported)).To(BeTrue())
+		})
+	})
+
+	It(`Allow to delete entry from private collection`, func() {
+		cc.Tx(func() {
+			Expect(mapped().Delete(&schema.EntityWithIndexes{Id: `aaa`})).NotTo(HaveOccurred())
+		})
+
+		cc.Tx(func() {
+			Expect(mapped().Exists(&schema.EntityWithIndexes{Id: `aaa`})).To(BeFalse())
+			Expect(ctx.State().Exists(state.Key{`EntityWithIndexes`, `aaa`})).To(BeFalse())
+		})
+	})
+})
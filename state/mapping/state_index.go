@@ -0,0 +1,354 @@
+package mapping
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/s7techlab/cckit/state"
+	"github.com/s7techlab/cckit/state/schema"
+)
+
+type (
+	// IndexIssueType type of inconsistency between mapped entry and key references
+	IndexIssueType string
+
+	// IndexIssue inconsistency between mapped entry and key references
+	IndexIssue struct {
+		Type  IndexIssueType
+		Index string
+		// RefKey index value
+		RefKey state.Key
+		// PKey primary key of entry, key reference should refer to
+		PKey state.Key
+		// RefPKey primary key, key reference actually refers to
+		RefPKey state.Key
+		// Repaired is true if issue was fixed
+		Repaired bool
+	}
+
+	// IndexCheckResult result of checking one batch of entries and key references
+	IndexCheckResult struct {
+		Issues []*IndexIssue
+		// Checked number of checked entries and key references
+		Checked uint32
+		// Bookmark for next batch, empty if scan is completed
+		Bookmark string
+	}
+
+	indexChecker struct {
+		state  state.State
+		mapper StateMapper
+		repair bool
+		limit  uint32
+		result *IndexCheckResult
+	}
+)
+
+const (
+	// IndexIssueMissing entry has no key reference for index value
+	IndexIssueMissing IndexIssueType = `MISSING`
+	// IndexIssueDangling key reference refers to non-existent entry or entry with another index value
+	IndexIssueDangling IndexIssueType = `DANGLING`
+	// IndexIssueConflict two entries have same value of uniq index
+	IndexIssueConflict IndexIssueType = `CONFLICT`
+
+	indexBookmarkEntries = `entries:`
+	indexBookmarkRefs    = `refs:`
+)
+
+// CheckIndexes scans batch of mapped entries and key references of schema and reports missing, dangling and
+// conflicting key references. Limit sets max number of checked entries and key references in one batch (0 - no limit),
+// scan can be continued from bookmark, returned in result. Batch is read with paginated query, starting from bookmark,
+// so CheckIndexes must be called in read-only transaction
+func CheckIndexes(s state.State, mappings StateMappings, schema interface{}, limit uint32, bookmark string) (
+	*IndexCheckResult, error) {
+	return checkIndexes(s, mappings, schema, limit, bookmark, false)
+}
+
+// RebuildIndexes scans batch of mapped entries and key references like CheckIndexes, also inserts missing
+// and deletes dangling key references. Conflicting key references are reported only.
+// Fabric doesn't allow writes after paginated query, so each batch reads namespace keys from the beginning:
+// find issues with CheckIndexes and rebuild only namespaces with issues.
+// Indexes of entries in private data collection cannot be rebuilt, Fabric doesn't allow writes after private data range queries
+func RebuildIndexes(s state.State, mappings StateMappings, schema interface{}, limit uint32, bookmark string) (
+	*IndexCheckResult, error) {
+	return checkIndexes(s, mappings, schema, limit, bookmark, true)
+}
+
+func checkIndexes(
+	s state.State, mappings StateMappings, schema interface{}, limit uint32, bookmark string, repair bool) (
+	*IndexCheckResult, error) {
+	m, err := mappings.Get(schema)
+	if err != nil {
+		return nil, fmt.Errorf(`mapping: %w`, err)
+	}
+
+	if collection := m.Collection(); collection != nil {
+		// Fabric doesn't allow writes in transaction with private data range queries
+		if repair {
+			return nil, fmt.Errorf(`%w: %s: rebuild indexes`, ErrCollectionNotSupported, collection.Name)
+		}
+		s = &collectionState{State: s, collection: collection}
+	}
+
+	c := &indexChecker{
+		state:  s,
+		mapper: m,
+		repair: repair,
+		limit:  limit,
+		result: &IndexCheckResult{},
+	}
+
+	// entries are checked first, then key references
+	refsBookmark := ``
+	switch {
+	case strings.HasPrefix(bookmark, indexBookmarkRefs):
+		refsBookmark = strings.TrimPrefix(bookmark, indexBookmarkRefs)
+
+	default:
+		done, err := c.scan(m.Namespace(), strings.TrimPrefix(bookmark, indexBookmarkEntries),
+			indexBookmarkEntries, c.checkEntry)
+		if err != nil || !done {
+			return c.result, err
+		}
+	}
+
+	if _, err = c.scan(c.keyRefsNamespace(), refsBookmark, indexBookmarkRefs, c.checkKeyRef); err != nil {
+		return nil, err
+	}
+
+	return c.result, nil
+}
+
+func (c *indexChecker) keyRefsNamespace() state.Key {
+	return state.Key{KeyRefNamespace, NewKeyRefID(c.mapper.Schema(), ``, nil).Schema}
+}
+
+// scan checks keys with namespace from bookmark, returns true if all keys are checked
+func (c *indexChecker) scan(
+	namespace state.Key, bookmark, bookmarkPrefix string, check func(key string) error) (done bool, err error) {
+	c.result.Bookmark = bookmarkPrefix + bookmark
+	if c.limit > 0 && c.result.Checked >= c.limit {
+		return false, nil
+	}
+
+	keys, next, err := c.keys(namespace, bookmark)
+	if err != nil {
+		return false, fmt.Errorf(`keys: %w`, err)
+	}
+
+	for _, key := range keys {
+		if err = check(key); err != nil {
+			return false, err
+		}
+		c.result.Checked++
+	}
+
+	if next != `` {
+		c.result.Bookmark = bookmarkPrefix + next
+		return false, nil
+	}
+
+	c.result.Bookmark = ``
+	return true, nil
+}
+
+// keys returns batch of keys with namespace from bookmark and bookmark of next batch.
+// Check reads batch with paginated query, starting from bookmark. Fabric doesn't allow writes in transaction
+// with paginated query, so rebuild reads namespace keys from the beginning and skips keys before bookmark
+func (c *indexChecker) keys(namespace state.Key, bookmark string) (keys []string, next string, err error) {
+	var pageSize int32
+	if c.limit > 0 {
+		pageSize = int32(c.limit - c.result.Checked)
+	}
+
+	// state without paginated keys support is read from the beginning, like on rebuild
+	paginator, ok := c.state.(state.KeysPaginator)
+	if ok && !c.repair && pageSize > 0 {
+		keys, md, err := paginator.KeysPaginated(namespace, pageSize, bookmark)
+		if err != nil {
+			return nil, ``, err
+		}
+		if int32(len(keys)) == pageSize {
+			next = md.GetBookmark()
+		}
+		return keys, next, nil
+	}
+
+	all, err := c.state.Keys(namespace)
+	if err != nil {
+		return nil, ``, err
+	}
+
+	for _, key := range all {
+		if key < bookmark {
+			continue
+		}
+
+		if pageSize > 0 && int32(len(keys)) == pageSize {
+			return keys, key, nil
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, ``, nil
+}
+
+// checkEntry checks that all key references of entry exist
+func (c *indexChecker) checkEntry(key string) error {
+	entry, err := c.state.Get(key, c.mapper.Schema())
+	if err != nil {
+		return fmt.Errorf(`get entry: %w`, err)
+	}
+
+	pKey, err := c.mapper.PrimaryKey(entry)
+	if err != nil {
+		return err
+	}
+
+	keyRefs, err := c.mapper.Keys(entry)
+	if err != nil {
+		return err
+	}
+
+	for _, keyRef := range keyRefs {
+		issue, err := c.checkEntryKeyRef(keyRef.(*StateInstance), pKey)
+		if err != nil {
+			return err
+		}
+
+		if issue == nil {
+			continue
+		}
+
+		if c.repair && issue.Type == IndexIssueMissing {
+			if err = c.state.Put(keyRef); err != nil {
+				return fmt.Errorf(`put key ref: %w`, err)
+			}
+			issue.Repaired = true
+		}
+
+		c.result.Issues = append(c.result.Issues, issue)
+	}
+
+	return nil
+}
+
+func (c *indexChecker) checkEntryKeyRef(keyRef *StateInstance, pKey state.Key) (*IndexIssue, error) {
+	refKey, err := keyRef.Key()
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := c.state.Exists(refKey)
+	if err != nil {
+		return nil, err
+	}
+
+	expected := keyRef.instance.(*schema.KeyRef)
+	if !exists {
+		return newIndexIssue(IndexIssueMissing, expected, pKey, nil), nil
+	}
+
+	stored, err := c.state.Get(refKey, &schema.KeyRef{})
+	if err != nil {
+		return nil, fmt.Errorf(`get key ref: %w`, err)
+	}
+
+	refPKey := state.Key(stored.(*schema.KeyRef).PKey)
+	if refPKey.String() == pKey.String() {
+		return nil, nil
+	}
+
+	// key ref refers to another entry, it's conflict if referred entry also has same index value
+	referred, err := c.referredEntryHasKeyRef(refPKey, refKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if referred {
+		return newIndexIssue(IndexIssueConflict, expected, pKey, refPKey), nil
+	}
+
+	return newIndexIssue(IndexIssueMissing, expected, pKey, refPKey), nil
+}
+
+// checkKeyRef checks that key reference refers to existing entry with same index value
+func (c *indexChecker) checkKeyRef(key string) error {
+	stored, err := c.state.Get(key, &schema.KeyRef{})
+	if err != nil {
+		return fmt.Errorf(`get key ref: %w`, err)
+	}
+
+	keyRef := stored.(*schema.KeyRef)
+	if idx := mappingIndex(c.mapper, keyRef.Idx); idx != nil {
+		var instance *StateInstance
+		if idx.Uniq {
+			instance = NewKeyRefInstance(c.mapper.Schema(), keyRef.Idx, keyRef.RefKey, keyRef.PKey)
+		} else {
+			instance = NewNonUniqKeyRefInstance(c.mapper.Schema(), keyRef.Idx, keyRef.RefKey, keyRef.PKey)
+		}
+
+		refKey, err := instance.Key()
+		if err != nil {
+			return err
+		}
+
+		referred, err := c.referredEntryHasKeyRef(keyRef.PKey, refKey)
+		if err != nil || referred {
+			return err
+		}
+	}
+
+	issue := newIndexIssue(IndexIssueDangling, keyRef, nil, keyRef.PKey)
+	if c.repair {
+		if err = c.state.Delete(key); err != nil {
+			return fmt.Errorf(`delete key ref: %w`, err)
+		}
+		issue.Repaired = true
+	}
+
+	c.result.Issues = append(c.result.Issues, issue)
+	return nil
+}
+
+// referredEntryHasKeyRef checks that entry with pKey exists and has key reference refKey
+func (c *indexChecker) referredEntryHasKeyRef(pKey, refKey state.Key) (bool, error) {
+	exists, err := c.state.Exists(pKey)
+	if err != nil || !exists {
+		return false, err
+	}
+
+	entry, err := c.state.Get(pKey, c.mapper.Schema())
+	if err != nil {
+		return false, fmt.Errorf(`get referred entry: %w`, err)
+	}
+
+	keyRefs, err := c.mapper.Keys(entry)
+	if err != nil {
+		return false, err
+	}
+
+	for _, keyRef := range keyRefs {
+		k, err := keyRef.Key()
+		if err != nil {
+			return false, err
+		}
+
+		if k.String() == refKey.String() {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func newIndexIssue(issueType IndexIssueType, keyRef *schema.KeyRef, pKey, refPKey state.Key) *IndexIssue {
+	return &IndexIssue{
+		Type:    issueType,
+		Index:   keyRef.Idx,
+		RefKey:  keyRef.RefKey,
+		PKey:    pKey,
+		RefPKey: refPKey,
+	}
+}
@@ -13,12 +13,22 @@ const KeyRefNamespace = `_idx`
 // KeyRefIDKeyer keyer for KeyRef entity
 var KeyRefIDKeyer = attrsKeyer([]string{`Schema`, `Idx`, `RefKey`})
 
+// KeyRefNonUniqKeyer keyer for KeyRef entity of non-uniq index, primary key of referred entry is a part of key,
+// so many entries can share one index value
+var KeyRefNonUniqKeyer = attrsKeyer([]string{`Schema`, `Idx`, `RefKey`, `PKey`})
+
 var KeyRefMapper = &StateMapping{
 	schema:       &schema.KeyRef{},
 	namespace:    state.Key{KeyRefNamespace},
 	primaryKeyer: KeyRefIDKeyer,
 }
 
+var KeyRefNonUniqMapper = &StateMapping{
+	schema:       &schema.KeyRef{},
+	namespace:    state.Key{KeyRefNamespace},
+	primaryKeyer: KeyRefNonUniqKeyer,
+}
+
 var KeyRefIDMapper = &StateMapping{
 	schema:       &schema.KeyRefId{},
 	namespace:    state.Key{KeyRefNamespace},
@@ -46,6 +56,10 @@ func NewKeyRefInstance(target interface{}, idx string, refKey, pKey state.Key) *
 	return NewStateInstance(NewKeyRef(target, idx, refKey, pKey), KeyRefMapper, DefaultSerializer)
 }
 
+func NewNonUniqKeyRefInstance(target interface{}, idx string, refKey, pKey state.Key) *StateInstance {
+	return NewStateInstance(NewKeyRef(target, idx, refKey, pKey), KeyRefNonUniqMapper, DefaultSerializer)
+}
+
 func NewKeyRefIDInstance(target interface{}, idx string, refKey state.Key) *StateInstance {
 	return NewStateInstance(
 		NewKeyRefID(target, idx, refKey),
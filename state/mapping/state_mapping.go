@@ -3,6 +3,7 @@ package mapping
 import (
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/golang/protobuf/proto"
@@ -35,6 +36,14 @@ type (
 		//KeyerFor returns target entity if mapper is key mapper
 		KeyerFor() (schema interface{})
 		Indexes() []*StateIndex
+		// References returns foreign references to another mapped entries
+		References() []*StateReference
+		// Collection returns private data collection of mapped entries, nil for public state
+		Collection() *StateCollection
+		// Aggregates returns aggregates, maintained for mapped entries
+		Aggregates() []*StateAggregate
+		// SkipValidation returns true if mapped entries are not validated before writing to state
+		SkipValidation() bool
 	}
 
 	// InstanceKeyer returns key of a state entry instance
@@ -43,12 +52,17 @@ type (
 
 	// StateMapping defines metadata for mapping from schema to state keys/values
 	StateMapping struct {
-		schema         interface{}
-		namespace      state.Key     // prefix for primary key
-		keyerForSchema interface{}   // schema is keyer for another schema ( for example *schema.StaffId for *schema.Staff )
-		primaryKeyer   InstanceKeyer // primary key always one
-		list           interface{}   // list schema
-		indexes        []*StateIndex // additional keys
+		schema          interface{}
+		namespace       state.Key     // prefix for primary key
+		keyerForSchema  interface{}   // schema is keyer for another schema ( for example *schema.StaffId for *schema.Staff )
+		primaryKeyer    InstanceKeyer // primary key always one
+		primaryKeyAttrs []string      // fields of primary key, if primary keyer based on fields
+		list            interface{}   // list schema
+		indexes         []*StateIndex // additional keys
+		references      []*StateReference
+		collection      *StateCollection // private data collection
+		aggregates      []*StateAggregate
+		skipValidation  bool
 	}
 
 	// StateIndex additional index of entity instance
@@ -57,6 +71,8 @@ type (
 		Uniq     bool
 		Required bool
 		Keyer    InstanceMultiKeyer // index can have multiple keys
+		// Fields of index, empty if index is calculated with custom keyer
+		Fields []string
 	}
 
 	// StateIndexDef additional index definition
@@ -65,7 +81,9 @@ type (
 		Fields   []string
 		Required bool
 		Multi    bool
-		Keyer    InstanceMultiKeyer
+		// NonUniq allows many entries to share one index value
+		NonUniq bool
+		Keyer   InstanceMultiKeyer
 	}
 
 	StateMappings map[string]*StateMapping
@@ -171,6 +189,21 @@ func (smm StateMappings) Resolve(objectType string, value []byte) (entry interfa
 	return DefaultSerializer.FromBytes(value, mapper.Schema())
 }
 
+// sorted returns mappings ordered by schema type
+func (smm StateMappings) sorted() []*StateMapping {
+	keys := make([]string, 0, len(smm))
+	for k := range smm {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	mappings := make([]*StateMapping, 0, len(keys))
+	for _, k := range keys {
+		mappings = append(mappings, smm[k])
+	}
+	return mappings
+}
+
 func (smm StateMappings) IdxKey(entity interface{}, idx string, idxVal state.Key) (state.Key, error) {
 	keyMapped := NewKeyRefIDInstance(entity, idx, idxVal)
 	return keyMapped.Key()
@@ -188,6 +221,10 @@ func (sm *StateMapping) Indexes() []*StateIndex {
 	return sm.indexes
 }
 
+func (sm *StateMapping) References() []*StateReference {
+	return sm.references
+}
+
 func (sm *StateMapping) Schema() interface{} {
 	return sm.schema
 }
@@ -227,13 +264,26 @@ func (sm *StateMapping) Keys(entity interface{}) ([]state.KeyValue, error) {
 			return nil, fmt.Errorf(`uniq key %s: %w`, idx.Name, err)
 		}
 
-		for _, key := range idxKeys {
+		stateKeys = append(stateKeys, indexKeyRefs(sm.schema, idx, idxKeys, pk)...)
+	}
+
+	return stateKeys, nil
+}
+
+// indexKeyRefs returns key refs for index values of entry with primary key pk
+func indexKeyRefs(schema interface{}, idx *StateIndex, idxKeys []state.Key, pk state.Key) []state.KeyValue {
+	var keyRefs []state.KeyValue
+	for _, key := range idxKeys {
+		if idx.Uniq {
 			// key will be <`_idx`,{SchemaName},{idxName}, {Key[1]},... {Key[n}}>s
-			stateKeys = append(stateKeys, NewKeyRefInstance(sm.schema, idx.Name, key, pk))
+			keyRefs = append(keyRefs, NewKeyRefInstance(schema, idx.Name, key, pk))
+		} else {
+			// key will be <`_idx`,{SchemaName},{idxName}, {Key[1]},... {Key[n}}, {PKey[1]},... {PKey[n]}>s
+			keyRefs = append(keyRefs, NewNonUniqKeyRefInstance(schema, idx.Name, key, pk))
 		}
 	}
 
-	return stateKeys, nil
+	return keyRefs
 }
 
 func (sm *StateMapping) AddIndex(idx *StateIndex) error {
@@ -259,6 +309,18 @@ func (sm *StateMapping) KeyerFor() interface{} {
 	return sm.keyerForSchema
 }
 
+func (sm *StateMapping) Collection() *StateCollection {
+	return sm.collection
+}
+
+func (sm *StateMapping) Aggregates() []*StateAggregate {
+	return sm.aggregates
+}
+
+func (sm *StateMapping) SkipValidation() bool {
+	return sm.skipValidation
+}
+
 // KeyRefsDiff calculates diff between key reference set
 func KeyRefsDiff(prevKeys []state.KeyValue, newKeys []state.KeyValue) (deleted, inserted []state.KeyValue, err error) {
 
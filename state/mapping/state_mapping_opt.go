@@ -35,6 +35,7 @@ func WithConstPKey(keys ...state.Key) StateMappingOpt {
 		sm.primaryKeyer = func(_ interface{}) (state.Key, error) {
 			return key, nil
 		}
+		sm.primaryKeyAttrs = nil
 	}
 }
 
@@ -65,13 +66,31 @@ func UniqKey(name string, fields ...[]string) StateMappingOpt {
 	})
 }
 
+// NonUniqKey defined non-uniq key in entity, many entries can share one key value
+func NonUniqKey(name string, fields ...[]string) StateMappingOpt {
+	var ff []string
+	if len(fields) > 0 {
+		ff = fields[0]
+	}
+	return WithIndex(&StateIndexDef{
+		Name:     name,
+		Fields:   ff,
+		Required: true,
+		Multi:    false,
+		NonUniq:  true,
+	})
+}
+
 func WithIndex(idx *StateIndexDef) StateMappingOpt {
 	return func(sm *StateMapping, smm StateMappings) {
 		if idx.Name == `` {
 			return
 		}
 
-		var keyer InstanceMultiKeyer
+		var (
+			keyer  InstanceMultiKeyer
+			fields []string
+		)
 		if idx.Keyer != nil {
 			keyer = idx.Keyer
 		} else {
@@ -79,6 +98,7 @@ func WithIndex(idx *StateIndexDef) StateMappingOpt {
 			if len(idx.Fields) > 0 {
 				aa = idx.Fields
 			}
+			fields = aa
 
 			// multiple external ids refers to one entry
 			if idx.Multi {
@@ -90,9 +110,10 @@ func WithIndex(idx *StateIndexDef) StateMappingOpt {
 
 		_ = sm.AddIndex(&StateIndex{
 			Name:     idx.Name,
-			Uniq:     true,
+			Uniq:     !idx.NonUniq,
 			Required: idx.Required,
 			Keyer:    keyer,
+			Fields:   fields,
 		})
 	}
 }
@@ -105,6 +126,7 @@ func PKeySchema(pkeySchema interface{}) StateMappingOpt {
 
 	return func(sm *StateMapping, smm StateMappings) {
 		sm.primaryKeyer = attrsKeyer(attrs)
+		sm.primaryKeyAttrs = attrs
 
 		// inherit namespace from "parent" mapping
 		namespace := sm.namespace
@@ -124,6 +146,7 @@ func PKeySchema(pkeySchema interface{}) StateMappingOpt {
 func PKeyAttr(attrs ...string) StateMappingOpt {
 	return func(sm *StateMapping, smm StateMappings) {
 		sm.primaryKeyer = attrsKeyer(attrs)
+		sm.primaryKeyAttrs = attrs
 	}
 }
 
@@ -137,6 +160,7 @@ func PKeyId() StateMappingOpt {
 func PKeyComplexId(pkeySchema interface{}) StateMappingOpt {
 	return func(sm *StateMapping, smm StateMappings) {
 		sm.primaryKeyer = attrsKeyer([]string{`Id`})
+		sm.primaryKeyAttrs = []string{`Id`}
 		smm.Add(pkeySchema,
 			WithNamespace(SchemaNamespace(sm.schema)),
 			PKeyAttr(attrsFrom(pkeySchema)...),
@@ -147,6 +171,7 @@ func PKeyComplexId(pkeySchema interface{}) StateMappingOpt {
 func PKeyer(pKeyer InstanceKeyer) StateMappingOpt {
 	return func(sm *StateMapping, smm StateMappings) {
 		sm.primaryKeyer = pKeyer
+		sm.primaryKeyAttrs = nil
 	}
 }
 
@@ -0,0 +1,234 @@
+package mapping
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/s7techlab/cckit/state"
+	"github.com/s7techlab/cckit/state/schema"
+)
+
+type (
+	// PatchOpts options of partial update
+	PatchOpts struct {
+		// Event for emitting EntryPatched event
+		Event state.Event
+	}
+
+	PatchOpt func(*PatchOpts)
+)
+
+// PatchEventName returns name of event, emitted on partial update of schema entry, for example `CarPatched`
+func PatchEventName(schema interface{}) string {
+	return SchemaNamespace(schema)[0] + `Patched`
+}
+
+// AddPatched adds mapping of EntryPatched event, emitted on partial update of schema entry,
+// so event can be resolved by name, for example `CarPatched`, in gateway and projections
+func (emm EventMappings) AddPatched(entrySchema interface{}) EventMappings {
+	// all patch events have same payload type, so mapping is stored by event name
+	return emm.AddNamed(&schema.EntryPatched{}, PatchEventName(entrySchema))
+}
+
+// PatchEvent emits schema.EntryPatched event with mask and patched entry
+func PatchEvent(event state.Event) PatchOpt {
+	return func(opts *PatchOpts) {
+		opts.Event = event
+	}
+}
+
+// Patch updates fields of stored entry, listed in mask, with values from entry. Entry should contain primary key fields.
+// Only key refs of indexes, affected by changed fields, are updated. Returns patched entry
+func (s *Impl) Patch(entry interface{}, mask *fieldmaskpb.FieldMask, opts ...PatchOpt) (interface{}, error) {
+	patchOpts := &PatchOpts{}
+	for _, opt := range opts {
+		opt(patchOpts)
+	}
+
+	patch, ok := entry.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf(`%s: %s`, ErrEntryTypeNotSupported, mapKey(entry))
+	}
+
+	if err := validateFieldMask(patch, mask); err != nil {
+		return nil, err
+	}
+
+	mapped, err := s.mappings.Map(entry)
+	if err != nil {
+		return nil, err
+	}
+	m := mapped.Mapper()
+
+	prev, err := s.get(mapped, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	patched := proto.Clone(prev.(proto.Message))
+	if err = ApplyFieldMask(patched, patch, mask); err != nil {
+		return nil, err
+	}
+
+	// primary key fields of entry are same as of stored entry, so primary key cannot be changed with patch
+	pKey, err := m.PrimaryKey(prev)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = validate(m, patched); err != nil {
+		return nil, err
+	}
+
+	if err = s.checkReferences(m, patched); err != nil {
+		return nil, err
+	}
+
+	deleteKeyRefs, insertKeyRefs, err := patchKeyRefs(m, prev, patched, pKey)
+	if err != nil {
+		return nil, err
+	}
+
+	st := s.stateFor(m)
+	for _, kr := range deleteKeyRefs {
+		if err = st.Delete(kr); err != nil {
+			return nil, fmt.Errorf(`delete previous mapping key ref: %w`, err)
+		}
+	}
+
+	for _, kr := range insertKeyRefs {
+		if err = st.Insert(kr); err != nil {
+			return nil, fmt.Errorf(`%s: %s`, ErrMappingUniqKeyExists, err)
+		}
+	}
+
+	if err = s.updateAggregates(st, m, prev, patched); err != nil {
+		return nil, err
+	}
+
+	patchedMapped, err := s.mappings.Map(patched)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = st.Put(patchedMapped); err != nil {
+		return nil, err
+	}
+
+	if err = s.putPublic(m, patchedMapped); err != nil {
+		return nil, err
+	}
+
+	if err = s.txEntry(patchedMapped, patched); err != nil {
+		return nil, err
+	}
+
+	if patchOpts.Event != nil {
+		event, err := newEntryPatched(m, pKey, mask, patched)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = patchOpts.Event.Set(PatchEventName(m.Schema()), event); err != nil {
+			return nil, fmt.Errorf(`patch event: %w`, err)
+		}
+	}
+
+	return patched, nil
+}
+
+// ApplyFieldMask sets fields of dst, listed in mask, to values from src, field is cleared if it's not set in src
+func ApplyFieldMask(dst, src proto.Message, mask *fieldmaskpb.FieldMask) error {
+	if err := validateFieldMask(src, mask); err != nil {
+		return err
+	}
+
+	for _, path := range mask.GetPaths() {
+		patchField(proto.MessageReflect(dst), proto.MessageReflect(src), strings.Split(path, `.`))
+	}
+	return nil
+}
+
+func validateFieldMask(entry proto.Message, mask *fieldmaskpb.FieldMask) error {
+	if len(mask.GetPaths()) == 0 || !mask.IsValid(proto.MessageV2(entry)) {
+		return fmt.Errorf(`%s: %s: %v`, ErrFieldMaskInvalid, mapKey(entry), mask.GetPaths())
+	}
+	return nil
+}
+
+// patchField sets field from path in dst to value from src, field is cleared if it's not set in src
+func patchField(dst, src protoreflect.Message, path []string) {
+	fd := dst.Descriptor().Fields().ByName(protoreflect.Name(path[0]))
+
+	if len(path) > 1 {
+		patchField(dst.Mutable(fd).Message(), src.Get(fd).Message(), path[1:])
+		return
+	}
+
+	if src.Has(fd) {
+		dst.Set(fd, src.Get(fd))
+	} else {
+		dst.Clear(fd)
+	}
+}
+
+// patchKeyRefs returns key refs to delete and to insert for indexes with changed values
+func patchKeyRefs(m StateMapper, prev, patched interface{}, pKey state.Key) (deleted, inserted []state.KeyValue, err error) {
+	var prevKeyRefs, patchedKeyRefs []state.KeyValue
+
+	for _, idx := range m.Indexes() {
+		prevKeys, err := idx.Keyer(prev)
+		if err != nil {
+			return nil, nil, fmt.Errorf(`index %s: %w`, idx.Name, err)
+		}
+
+		patchedKeys, err := idx.Keyer(patched)
+		if err != nil {
+			return nil, nil, fmt.Errorf(`index %s: %w`, idx.Name, err)
+		}
+
+		// index is not affected by changed fields
+		if keysEqual(prevKeys, patchedKeys) {
+			continue
+		}
+
+		prevKeyRefs = append(prevKeyRefs, indexKeyRefs(m.Schema(), idx, prevKeys, pKey)...)
+		patchedKeyRefs = append(patchedKeyRefs, indexKeyRefs(m.Schema(), idx, patchedKeys, pKey)...)
+	}
+
+	return KeyRefsDiff(prevKeyRefs, patchedKeyRefs)
+}
+
+func keysEqual(a, b []state.Key) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i].String() != b[i].String() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func newEntryPatched(m StateMapper, pKey state.Key, mask *fieldmaskpb.FieldMask, patched proto.Message) (
+	*schema.EntryPatched, error) {
+	entry, err := ptypes.MarshalAny(patched)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schema.EntryPatched{
+		Schema: mapKey(m.Schema()),
+		Key:    pKey,
+		Mask:   mask,
+		Entry:  entry,
+	}, nil
+}
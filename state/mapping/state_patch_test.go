@@ -0,0 +1,111 @@
+package mapping_test
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/hyperledger/fabric-protos-go/peer"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/s7techlab/cckit/state"
+	m "github.com/s7techlab/cckit/state/mapping"
+	"github.com/s7techlab/cckit/state/mapping/testdata"
+	"github.com/s7techlab/cckit/state/mapping/testdata/schema"
+	stateschema "github.com/s7techlab/cckit/state/schema"
+	testcc "github.com/s7techlab/cckit/testing"
+)
+
+var _ = Describe(`State patch`, func() {
+
+	var (
+		cc, ctx = testcc.NewTxHandler(`patch`)
+
+		mapped = func() m.MappedState {
+			return m.WrapState(ctx.State(), testdata.EntityWithIndexesStateMapping)
+		}
+
+		keyRefExists = func(externalId string) bool {
+			exists, err := ctx.State().Exists(m.NewKeyRefIDInstance(
+				&schema.EntityWithIndexes{}, `ExternalId`, state.Key{externalId}))
+			Expect(err).NotTo(HaveOccurred())
+			return exists
+		}
+	)
+
+	It(`Allow to insert entry`, func() {
+		cc.Tx(func() {
+			Expect(mapped().Insert(&schema.EntityWithIndexes{
+				Id:                  `aaa`,
+				ExternalId:          `aaa_aaa`,
+				RequiredExternalIds: []string{`aaa_req1`},
+				Value:               1,
+			})).NotTo(HaveOccurred())
+		})
+	})
+
+	It(`Allow to patch field without index`, func() {
+		cc.Tx(func() {
+			patched, err := mapped().Patch(&schema.EntityWithIndexes{Id: `aaa`, ExternalId: `ignored`, Value: 2},
+				&fieldmaskpb.FieldMask{Paths: []string{`value`}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(patched.(*schema.EntityWithIndexes).ExternalId).To(Equal(`aaa_aaa`))
+			Expect(patched.(*schema.EntityWithIndexes).Value).To(Equal(int32(2)))
+		})
+
+		cc.Tx(func() {
+			e, err := mapped().Get(&schema.EntityWithIndexes{Id: `aaa`})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(e.(*schema.EntityWithIndexes).Value).To(Equal(int32(2)))
+			Expect(e.(*schema.EntityWithIndexes).RequiredExternalIds).To(Equal([]string{`aaa_req1`}))
+			Expect(keyRefExists(`aaa_aaa`)).To(BeTrue())
+		})
+	})
+
+	It(`Allow to patch indexed field and emit event`, func() {
+		var (
+			mask  = &fieldmaskpb.FieldMask{Paths: []string{`external_id`}}
+			event *peer.ChaincodeEvent
+		)
+
+		cc.Tx(func() {
+			_, err := mapped().Patch(&schema.EntityWithIndexes{Id: `aaa`, ExternalId: `aaa_bbb`}, mask,
+				m.PatchEvent(ctx.Event()))
+			Expect(err).NotTo(HaveOccurred())
+			event = cc.TxEvent()
+		})
+
+		cc.Tx(func() {
+			Expect(keyRefExists(`aaa_aaa`)).To(BeFalse())
+			Expect(keyRefExists(`aaa_bbb`)).To(BeTrue())
+
+			e, err := mapped().GetByKey(&schema.EntityWithIndexes{}, `ExternalId`, []string{`aaa_bbb`},
+				&schema.EntityWithIndexes{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(e.(*schema.EntityWithIndexes).Value).To(Equal(int32(2)))
+		})
+
+		Expect(event.EventName).To(Equal(`EntityWithIndexesPatched`))
+
+		patched := &stateschema.EntryPatched{}
+		Expect(proto.Unmarshal(event.Payload, patched)).NotTo(HaveOccurred())
+		Expect(patched.Key).To(Equal([]string{`EntityWithIndexes`, `aaa`}))
+		Expect(patched.Mask.Paths).To(Equal(mask.Paths))
+
+		entry := &schema.EntityWithIndexes{}
+		Expect(ptypes.UnmarshalAny(patched.Entry, entry)).NotTo(HaveOccurred())
+		Expect(entry.ExternalId).To(Equal(`aaa_bbb`))
+
+		resolved, err := m.EventMappings{}.AddPatched(&schema.EntityWithIndexes{}).Resolve(event.EventName, event.Payload)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolved.(*stateschema.EntryPatched).Key).To(Equal(patched.Key))
+	})
+
+	It(`Disallow to patch with invalid mask`, func() {
+		cc.Tx(func() {
+			_, err := mapped().Patch(&schema.EntityWithIndexes{Id: `aaa`},
+				&fieldmaskpb.FieldMask{Paths: []string{`unknown`}})
+			Expect(err).To(MatchError(ContainSubstring(m.ErrFieldMaskInvalid.Error())))
+		})
+	})
+})
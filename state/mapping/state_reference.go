@@ -0,0 +1,277 @@
+package mapping
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/s7techlab/cckit/state"
+)
+
+type (
+	// ReferenceDeletePolicy defines what happens with referring entries when referred entry is deleted
+	ReferenceDeletePolicy int
+
+	// StateReference foreign reference from mapped entry to another mapped entry
+	StateReference struct {
+		Name string
+		// To referred schema
+		To       interface{}
+		OnDelete ReferenceDeletePolicy
+		Fields   []string
+		// Keyer returns primary key of referred entry without namespace
+		Keyer InstanceKeyer
+	}
+
+	// StateReferenceDef foreign reference definition
+	StateReferenceDef struct {
+		// Name of reference, by default - joined field names
+		Name string
+		// Fields of referring entry, contain primary key attrs of referred entry
+		Fields []string
+		// To referred schema
+		To       interface{}
+		OnDelete ReferenceDeletePolicy
+	}
+
+	// ReferenceError occurs when referential integrity is violated
+	ReferenceError struct {
+		// Schema of referring entry
+		Schema string
+		// Reference name
+		Reference string
+		// Key of referred entry
+		Key state.Key
+		Err error
+	}
+)
+
+const (
+	// RefRestrict disallows deleting of referred entry if referring entries exist
+	RefRestrict ReferenceDeletePolicy = iota
+	// RefCascade deletes referring entries with referred entry
+	RefCascade
+	// RefNullify clears reference fields of referring entries
+	RefNullify
+
+	// ReferenceIndexPrefix prefix for non-uniq index, allows to find referring entries
+	ReferenceIndexPrefix = `ref:`
+)
+
+func (e *ReferenceError) Error() string {
+	return fmt.Sprintf(`%s: {%s}.%s: %s`, e.Err, e.Schema, e.Reference, e.Key)
+}
+
+func (e *ReferenceError) Unwrap() error {
+	return e.Err
+}
+
+// WithReference defines foreign reference from mapped entry to another mapped entry.
+// Also adds non-uniq index for finding referring entries by referred entry primary key
+func WithReference(ref *StateReferenceDef) StateMappingOpt {
+	return func(sm *StateMapping, smm StateMappings) {
+		name := ref.Name
+		if name == `` {
+			name = strings.Join(ref.Fields, `-`)
+		}
+
+		reference := &StateReference{
+			Name:     name,
+			To:       ref.To,
+			OnDelete: ref.OnDelete,
+			Fields:   ref.Fields,
+			Keyer:    attrsKeyer(ref.Fields),
+		}
+		sm.references = append(sm.references, reference)
+
+		_ = sm.AddIndex(&StateIndex{
+			Name:   reference.IndexName(),
+			Uniq:   false,
+			Keyer:  referenceKeyer(reference.Keyer),
+			Fields: ref.Fields,
+		})
+	}
+}
+
+// RefTo defines foreign reference from fields of mapped entry to another mapped entry
+func RefTo(to interface{}, onDelete ReferenceDeletePolicy, fields ...string) StateMappingOpt {
+	return WithReference(&StateReferenceDef{
+		Fields:   fields,
+		To:       to,
+		OnDelete: onDelete,
+	})
+}
+
+// IndexName returns name of index for finding referring entries
+func (r *StateReference) IndexName() string {
+	return ReferenceIndexPrefix + r.Name
+}
+
+// referenceKeyer returns no keys for empty (null) reference
+func referenceKeyer(keyer InstanceKeyer) InstanceMultiKeyer {
+	return func(instance interface{}) ([]state.Key, error) {
+		key, err := keyer(instance)
+		if err != nil || isNullReference(key) {
+			return nil, err
+		}
+
+		return []state.Key{key}, nil
+	}
+}
+
+func isNullReference(key state.Key) bool {
+	for _, part := range key {
+		if part != `` {
+			return false
+		}
+	}
+	return true
+}
+
+// nullifyReference clears reference fields of entry
+func nullifyReference(entry interface{}, ref *StateReference) {
+	inst := reflect.Indirect(reflect.ValueOf(entry))
+	for _, field := range ref.Fields {
+		v := inst.FieldByName(field)
+		v.Set(reflect.Zero(v.Type()))
+	}
+}
+
+// checkReferences checks that entries, referred by entry, exist
+func (s *Impl) checkReferences(mapper StateMapper, entry interface{}) error {
+	for _, ref := range mapper.References() {
+		key, err := ref.Keyer(entry)
+		if err != nil {
+			return fmt.Errorf(`reference %s: %w`, ref.Name, err)
+		}
+
+		if isNullReference(key) {
+			continue
+		}
+
+		referredMapper, err := s.mappings.Get(ref.To)
+		if err != nil {
+			return fmt.Errorf(`reference %s: %w`, ref.Name, err)
+		}
+
+		pKey := append(append(state.Key{}, referredMapper.Namespace()...), key...)
+		exists, err := s.exists(referredMapper, pKey)
+		if err != nil {
+			return err
+		}
+
+		if !exists {
+			return &ReferenceError{
+				Schema:    mapKey(mapper.Schema()),
+				Reference: ref.Name,
+				Key:       pKey,
+				Err:       ErrReferredEntryNotFound,
+			}
+		}
+	}
+
+	return nil
+}
+
+// applyReferencesOnDelete applies delete policy to entries, referring to deleted entry
+func (s *Impl) applyReferencesOnDelete(mapper StateMapper, entry interface{}) error {
+	pKey, err := mapper.PrimaryKey(entry)
+	if err != nil {
+		return err
+	}
+	key := pKey[len(mapper.Namespace()):]
+
+	for _, m := range s.mappings.sorted() {
+		for _, ref := range m.References() {
+			if mapKey(ref.To) != mapKey(mapper.Schema()) {
+				continue
+			}
+
+			referring, err := s.referringEntries(m, ref, key)
+			if err != nil {
+				return err
+			}
+
+			if len(referring) == 0 {
+				continue
+			}
+
+			switch ref.OnDelete {
+			case RefCascade:
+				for _, e := range referring {
+					if err = s.Delete(e); err != nil {
+						return fmt.Errorf(`cascade delete %s: %w`, ref.Name, err)
+					}
+				}
+
+			case RefNullify:
+				for _, e := range referring {
+					nullifyReference(e, ref)
+					if err = s.Put(e); err != nil {
+						return fmt.Errorf(`nullify %s: %w`, ref.Name, err)
+					}
+				}
+
+			default:
+				return &ReferenceError{
+					Schema:    mapKey(m.Schema()),
+					Reference: ref.Name,
+					Key:       pKey,
+					Err:       ErrReferringEntriesExist,
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// referringEntries returns entries of mapping m, referring with ref to key: committed entries, found with
+// reference index, and entries written with this state wrapper. Entries, deleted with this state wrapper
+// or being deleted, are skipped
+func (s *Impl) referringEntries(m StateMapper, ref *StateReference, key state.Key) ([]interface{}, error) {
+	committed, err := s.listByIndex(m, ref.IndexName(), key)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := s.txState()
+	var referring []interface{}
+	for _, entry := range committed {
+		pKey, err := m.PrimaryKey(entry)
+		if err != nil {
+			return nil, err
+		}
+
+		// entry, written or deleted in transaction, is checked below
+		if _, ok := tx.entries[pKey.String()]; ok || tx.deleting[pKey.String()] {
+			continue
+		}
+		referring = append(referring, entry)
+	}
+
+	txKeys := make([]string, 0, len(tx.entries))
+	for txKey := range tx.entries {
+		txKeys = append(txKeys, txKey)
+	}
+	sort.Strings(txKeys)
+
+	for _, txKey := range txKeys {
+		entry := tx.entries[txKey]
+		if entry == nil || tx.deleting[txKey] || mapKey(entry) != mapKey(m.Schema()) {
+			continue
+		}
+
+		refKey, err := ref.Keyer(entry)
+		if err != nil {
+			return nil, fmt.Errorf(`reference %s: %w`, ref.Name, err)
+		}
+
+		if refKey.String() == key.String() {
+			referring = append(referring, cloneEntry(entry))
+		}
+	}
+
+	return referring, nil
+}
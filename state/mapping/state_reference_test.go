@@ -0,0 +1,179 @@
+package mapping_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/s7techlab/cckit/state"
+	m "github.com/s7techlab/cckit/state/mapping"
+	"github.com/s7techlab/cckit/state/mapping/testdata"
+	"github.com/s7techlab/cckit/state/mapping/testdata/schema"
+	stateschema "github.com/s7techlab/cckit/state/schema"
+	testcc "github.com/s7techlab/cckit/testing"
+)
+
+var _ = Describe(`State references`, func() {
+
+	var (
+		cc, ctx = testcc.NewTxHandler(`references`)
+
+		mapped = func() m.MappedState {
+			return m.WrapState(ctx.State(), testdata.DepartmentStateMapping)
+		}
+	)
+
+	It(`Disallow to insert entry, referring to non-existent entry`, func() {
+		cc.Tx(func() {
+			err := mapped().Insert(&schema.Employee{Id: `e1`, DepartmentId: `d1`})
+			Expect(errors.Is(err, m.ErrReferredEntryNotFound)).To(BeTrue())
+
+			refErr := &m.ReferenceError{}
+			Expect(errors.As(err, &refErr)).To(BeTrue())
+			Expect(refErr.Reference).To(Equal(`DepartmentId`))
+			Expect(refErr.Key).To(Equal(state.Key{`Department`, `d1`}))
+		})
+	})
+
+	It(`Allow to insert entry with empty reference`, func() {
+		cc.Tx(func() {
+			Expect(mapped().Insert(&schema.Employee{Id: `e0`})).NotTo(HaveOccurred())
+		})
+	})
+
+	It(`Allow to insert referred and referring entries in one tx`, func() {
+		cc.Tx(func() {
+			s := mapped()
+			Expect(s.Insert(&schema.Department{Id: `d1`})).NotTo(HaveOccurred())
+			Expect(s.Insert(&schema.Employee{Id: `e1`, DepartmentId: `d1`})).NotTo(HaveOccurred())
+			Expect(s.Insert(&schema.Project{Id: `p1`, DepartmentId: `d1`})).NotTo(HaveOccurred())
+		})
+
+		cc.Tx(func() {
+			employees, err := mapped().ListByIndex(&schema.Employee{}, `ref:DepartmentId`, []string{`d1`})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(employees.(*stateschema.List).Items).To(HaveLen(1))
+		})
+	})
+
+	It(`Allow to insert referred and referring entries in one tx with different state wrappers`, func() {
+		cc.Tx(func() {
+			Expect(mapped().Insert(&schema.Department{Id: `d3`})).NotTo(HaveOccurred())
+			Expect(mapped().Insert(&schema.Employee{Id: `e3`, DepartmentId: `d3`})).NotTo(HaveOccurred())
+			Expect(mapped().Delete(&schema.Department{Id: `d3`})).NotTo(HaveOccurred())
+		})
+
+		// entries of previous tx are not remembered by state, used across txs
+		cc.Tx(func() {
+			employee, err := mapped().Get(&schema.Employee{Id: `e3`})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(employee.(*schema.Employee).DepartmentId).To(BeEmpty())
+
+			err = mapped().Insert(&schema.Employee{Id: `e4`, DepartmentId: `d3`})
+			Expect(errors.Is(err, m.ErrReferredEntryNotFound)).To(BeTrue())
+		})
+	})
+
+	It(`Disallow to put entry, referring to non-existent entry`, func() {
+		cc.Tx(func() {
+			err := mapped().Put(&schema.Project{Id: `p1`, DepartmentId: `d2`})
+			Expect(errors.Is(err, m.ErrReferredEntryNotFound)).To(BeTrue())
+		})
+	})
+
+	It(`Disallow to delete referred entry with restrict policy`, func() {
+		cc.Tx(func() {
+			restricted := m.WrapState(ctx.State(), m.StateMappings{}.
+				Add(&schema.Department{}, m.PKeyId()).
+				Add(&schema.Employee{}, m.PKeyId(),
+					m.RefTo(&schema.Department{}, m.RefRestrict, `DepartmentId`)))
+
+			err := restricted.Delete(&schema.Department{Id: `d1`})
+			Expect(errors.Is(err, m.ErrReferringEntriesExist)).To(BeTrue())
+		})
+	})
+
+	It(`Allow to delete referred entry with cascade and nullify policies`, func() {
+		cc.Tx(func() {
+			Expect(mapped().Delete(&schema.Department{Id: `d1`})).NotTo(HaveOccurred())
+		})
+
+		cc.Tx(func() {
+			s := mapped()
+			exists, err := s.Exists(&schema.Project{Id: `p1`})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeFalse())
+
+			employee, err := s.Get(&schema.Employee{Id: `e1`})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(employee.(*schema.Employee).DepartmentId).To(BeEmpty())
+		})
+	})
+
+	It(`Disallow to delete referred entry with restrict policy, if referring entry inserted in same tx`, func() {
+		cc.Tx(func() {
+			restricted := m.WrapState(ctx.State(), m.StateMappings{}.
+				Add(&schema.Department{}, m.PKeyId()).
+				Add(&schema.Employee{}, m.PKeyId(),
+					m.RefTo(&schema.Department{}, m.RefRestrict, `DepartmentId`)))
+
+			Expect(restricted.Insert(&schema.Department{Id: `d2`})).NotTo(HaveOccurred())
+			Expect(restricted.Insert(&schema.Employee{Id: `e2`, DepartmentId: `d2`})).NotTo(HaveOccurred())
+
+			err := restricted.Delete(&schema.Department{Id: `d2`})
+			Expect(errors.Is(err, m.ErrReferringEntriesExist)).To(BeTrue())
+		})
+	})
+
+	It(`Allow to cascade delete referring entry, inserted in same tx`, func() {
+		cc.Tx(func() {
+			s := mapped()
+			Expect(s.Insert(&schema.Department{Id: `d3`})).NotTo(HaveOccurred())
+			Expect(s.Insert(&schema.Project{Id: `p3`, DepartmentId: `d3`})).NotTo(HaveOccurred())
+			Expect(s.Delete(&schema.Department{Id: `d3`})).NotTo(HaveOccurred())
+
+			exists, err := s.Exists(&schema.Project{Id: `p3`})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeFalse())
+		})
+
+		cc.Tx(func() {
+			projects, err := mapped().ListByIndex(&schema.Project{}, `ref:DepartmentId`, []string{`d3`})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(projects.(*stateschema.List).Items).To(HaveLen(0))
+
+			exists, err := mapped().Exists(&schema.Project{Id: `p3`})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeFalse())
+		})
+	})
+
+	It(`Allow to cascade delete entries with reference cycle`, func() {
+		// project refers to another project with DepartmentId field
+		cycled := func() m.MappedState {
+			return m.WrapState(ctx.State(), m.StateMappings{}.
+				Add(&schema.Project{}, m.PKeyId(),
+					m.RefTo(&schema.Project{}, m.RefCascade, `DepartmentId`)))
+		}
+
+		cc.Tx(func() {
+			s := cycled()
+			Expect(s.Insert(&schema.Project{Id: `c1`})).NotTo(HaveOccurred())
+			Expect(s.Insert(&schema.Project{Id: `c2`, DepartmentId: `c1`})).NotTo(HaveOccurred())
+			Expect(s.Put(&schema.Project{Id: `c1`, DepartmentId: `c2`})).NotTo(HaveOccurred())
+		})
+
+		cc.Tx(func() {
+			Expect(cycled().Delete(&schema.Project{Id: `c1`})).NotTo(HaveOccurred())
+		})
+
+		cc.Tx(func() {
+			for _, id := range []string{`c1`, `c2`} {
+				exists, err := cycled().Exists(&schema.Project{Id: id})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(exists).To(BeFalse())
+			}
+		})
+	})
+})
@@ -0,0 +1,73 @@
+package mapping
+
+import (
+	"strings"
+
+	validator "github.com/mwitkow/go-proto-validators"
+)
+
+type (
+	// EntryValidationError occurs when mapped entry, written to state, is invalid
+	EntryValidationError struct {
+		// Schema of mapped entry
+		Schema string
+		// FieldPath path to invalid field, for example `Owners.FirstName`, empty if path is unknown
+		FieldPath string
+		// Err error, returned by Validate method of entry
+		Err error
+	}
+)
+
+const validatorFieldErrorPrefix = `invalid field `
+
+// WithoutValidation disables validation of mapped entries on Put, Insert and Patch
+func WithoutValidation() StateMappingOpt {
+	return func(sm *StateMapping, smm StateMappings) {
+		sm.skipValidation = true
+	}
+}
+
+func (e *EntryValidationError) Error() string {
+	return ErrEntryInvalid.Error() + `: ` + e.Schema + `: ` + e.Err.Error()
+}
+
+// Is allows to use errors.Is(err, ErrEntryInvalid)
+func (e *EntryValidationError) Is(target error) bool {
+	return target == ErrEntryInvalid
+}
+
+func (e *EntryValidationError) Unwrap() error {
+	return e.Err
+}
+
+// validate runs Validate method of entry (go-proto-validators) if it exists and validation is not disabled for mapping
+func validate(m StateMapper, entry interface{}) error {
+	if m.SkipValidation() {
+		return nil
+	}
+
+	err := validator.CallValidatorIfExists(entry)
+	if err == nil {
+		return nil
+	}
+
+	return &EntryValidationError{
+		Schema:    mapKey(m.Schema()),
+		FieldPath: validatorFieldPath(err),
+		Err:       err,
+	}
+}
+
+// validatorFieldPath extracts field path from go-proto-validators error `invalid field {path}: {error}`
+func validatorFieldPath(err error) string {
+	msg := err.Error()
+	if !strings.HasPrefix(msg, validatorFieldErrorPrefix) {
+		return ``
+	}
+
+	msg = strings.TrimPrefix(msg, validatorFieldErrorPrefix)
+	if i := strings.Index(msg, `: `); i > 0 {
+		return msg[:i]
+	}
+	return ``
+}
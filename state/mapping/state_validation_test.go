@@ -0,0 +1,69 @@
+package mapping_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	m "github.com/s7techlab/cckit/state/mapping"
+	"github.com/s7techlab/cckit/state/mapping/testdata"
+	"github.com/s7techlab/cckit/state/mapping/testdata/schema"
+	testcc "github.com/s7techlab/cckit/testing"
+)
+
+var _ = Describe(`State validation`, func() {
+
+	var (
+		cc, ctx = testcc.NewTxHandler(`validation`)
+
+		mapped = func() m.MappedState {
+			return m.WrapState(ctx.State(), testdata.ValidatedEntityStateMapping)
+		}
+
+		expectValidationError = func(err error, fieldPath string) {
+			Expect(errors.Is(err, m.ErrEntryInvalid)).To(BeTrue())
+
+			var validationErr *m.EntryValidationError
+			Expect(errors.As(err, &validationErr)).To(BeTrue())
+			Expect(validationErr.FieldPath).To(Equal(fieldPath))
+		}
+
+		valid = &schema.EntityWithValidation{Id: `aaa`, Value: 1, Owner: &schema.EntityWithValidationOwner{Name: `John`}}
+	)
+
+	It(`Allow to insert valid entry`, func() {
+		cc.Tx(func() {
+			Expect(mapped().Insert(valid)).NotTo(HaveOccurred())
+		})
+	})
+
+	It(`Disallow to insert invalid entry`, func() {
+		cc.Tx(func() {
+			expectValidationError(mapped().Insert(&schema.EntityWithValidation{Id: `bbb`, Value: 1}), `Owner`)
+		})
+	})
+
+	It(`Disallow to put entry with invalid nested entry`, func() {
+		cc.Tx(func() {
+			expectValidationError(mapped().Put(&schema.EntityWithValidation{
+				Id: `aaa`, Value: 1, Owner: &schema.EntityWithValidationOwner{}}), `Owner.Name`)
+		})
+	})
+
+	It(`Disallow to patch entry with invalid value`, func() {
+		cc.Tx(func() {
+			_, err := mapped().Patch(&schema.EntityWithValidation{Id: `aaa`},
+				&fieldmaskpb.FieldMask{Paths: []string{`value`}})
+			expectValidationError(err, `Value`)
+		})
+	})
+
+	It(`Allow to put invalid entry if validation disabled`, func() {
+		cc.Tx(func() {
+			Expect(m.WrapState(ctx.State(), testdata.NotValidatedEntityStateMapping).Put(
+				&schema.EntityWithValidation{Id: `bbb`})).NotTo(HaveOccurred())
+		})
+	})
+})
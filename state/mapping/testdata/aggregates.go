@@ -0,0 +1,19 @@
+package testdata
+
+import (
+	"github.com/s7techlab/cckit/state/mapping"
+	"github.com/s7techlab/cckit/state/mapping/testdata/schema"
+)
+
+var (
+	// AggregatedEntityStateMapping entries count and count with sums of values and amounts, grouped by external id
+	AggregatedEntityStateMapping = mapping.StateMappings{}.
+		Add(&schema.EntityWithIndexes{},
+			mapping.PKeyId(),
+			mapping.List(&schema.EntityWithIndexesList{}),
+			mapping.CountBy(),
+			mapping.WithAggregate(&mapping.StateAggregateDef{
+				GroupBy: []string{`ExternalId`},
+				Sum:     []string{`Value`, `Amount`},
+			}))
+)
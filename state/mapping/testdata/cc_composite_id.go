@@ -4,6 +4,7 @@ import (
 	"github.com/s7techlab/cckit/extensions/debug"
 	"github.com/s7techlab/cckit/extensions/owner"
 	"github.com/s7techlab/cckit/router"
+	p "github.com/s7techlab/cckit/router/param"
 	"github.com/s7techlab/cckit/router/param/defparam"
 	"github.com/s7techlab/cckit/state"
 	"github.com/s7techlab/cckit/state/mapping"
@@ -17,7 +18,9 @@ var (
 		mapping.WithNamespace(EntityCompositeIdNamespace),
 		//  schema for Primary Key
 		mapping.PKeySchema(&schema.EntityCompositeId{}),
-		mapping.List(&schema.EntityWithCompositeIdList{}))
+		mapping.List(&schema.EntityWithCompositeIdList{}),
+		// many entries can have same second part of composite id
+		mapping.NonUniqKey(`IdSecondPart`))
 )
 
 func NewCompositeIdCC() *router.Chaincode {
@@ -34,6 +37,9 @@ func NewCompositeIdCC() *router.Chaincode {
 
 	r.
 		Query("list", queryListComposite).
+		Query("listBySecondPart", queryListBySecondPartComposite, p.String(`secondPart`)).
+		Query("listBySecondPartPaginated", queryListBySecondPartPaginatedComposite,
+			p.String(`secondPart`), p.Int(`pageSize`), p.String(`bookmark`)).
 		Query("get", queryByIdComposite, defparam.Proto(&schema.EntityCompositeId{})).
 		Invoke("create", invokeCreateComposite, defparam.Proto(&schema.CreateEntityWithCompositeId{})).
 		Invoke("update", invokeUpdateComposite, defparam.Proto(&schema.UpdateEntityWithCompositeId{})).
@@ -50,6 +56,18 @@ func queryListComposite(c router.Context) (interface{}, error) {
 	return c.State().List(&schema.EntityWithCompositeId{})
 }
 
+func queryListBySecondPartComposite(c router.Context) (interface{}, error) {
+	return c.State().(mapping.MappedState).ListByIndex(
+		&schema.EntityWithCompositeId{}, `IdSecondPart`, []string{c.ParamString(`secondPart`)})
+}
+
+func queryListBySecondPartPaginatedComposite(c router.Context) (interface{}, error) {
+	list, _, err := c.State().(mapping.MappedState).ListPaginatedByIndex(
+		&schema.EntityWithCompositeId{}, `IdSecondPart`, []string{c.ParamString(`secondPart`)},
+		int32(c.ParamInt(`pageSize`)), c.ParamString(`bookmark`))
+	return list, err
+}
+
 func invokeCreateComposite(c router.Context) (interface{}, error) {
 	create := c.Param().(*schema.CreateEntityWithCompositeId)
 	entity := &schema.EntityWithCompositeId{
@@ -0,0 +1,18 @@
+package testdata
+
+import (
+	"github.com/s7techlab/cckit/state/mapping"
+	"github.com/s7techlab/cckit/state/mapping/testdata/schema"
+)
+
+const PrivateCollection = `private`
+
+var (
+	// PrivateEntityStateMapping entries and key refs are stored in private collection, hash - in public state
+	PrivateEntityStateMapping = mapping.StateMappings{}.
+		Add(&schema.EntityWithIndexes{},
+			mapping.PKeyId(),
+			mapping.List(&schema.EntityWithIndexesList{}),
+			mapping.UniqKey(`ExternalId`),
+			mapping.WithCollection(PrivateCollection, mapping.CollectionPublicHashEntry()))
+)
@@ -0,0 +1,17 @@
+package testdata
+
+import (
+	"github.com/s7techlab/cckit/state/mapping"
+	"github.com/s7techlab/cckit/state/mapping/testdata/schema"
+)
+
+var (
+	DepartmentStateMapping = mapping.StateMappings{}.
+		Add(&schema.Department{}, mapping.PKeyId()).
+		// employee reference is cleared when department deleted
+		Add(&schema.Employee{}, mapping.PKeyId(),
+			mapping.RefTo(&schema.Department{}, mapping.RefNullify, `DepartmentId`)).
+		// project is deleted with department
+		Add(&schema.Project{}, mapping.PKeyId(),
+			mapping.RefTo(&schema.Department{}, mapping.RefCascade, `DepartmentId`))
+)
@@ -0,0 +1,240 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        (unknown)
+// source: mapping/testdata/schema/with_validation.proto
+
+package schema
+
+import (
+	_ "github.com/mwitkow/go-proto-validators"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EntityWithValidation entity with validation rules
+type EntityWithValidation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string                     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Value int32                      `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	Owner *EntityWithValidationOwner `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (x *EntityWithValidation) Reset() {
+	*x = EntityWithValidation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mapping_testdata_schema_with_validation_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntityWithValidation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityWithValidation) ProtoMessage() {}
+
+func (x *EntityWithValidation) ProtoReflect() protoreflect.Message {
+	mi := &file_mapping_testdata_schema_with_validation_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityWithValidation.ProtoReflect.Descriptor instead.
+func (*EntityWithValidation) Descriptor() ([]byte, []int) {
+	return file_mapping_testdata_schema_with_validation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EntityWithValidation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EntityWithValidation) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *EntityWithValidation) GetOwner() *EntityWithValidationOwner {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+// EntityWithValidationOwner nested entity with validation rules
+type EntityWithValidationOwner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *EntityWithValidationOwner) Reset() {
+	*x = EntityWithValidationOwner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mapping_testdata_schema_with_validation_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntityWithValidationOwner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityWithValidationOwner) ProtoMessage() {}
+
+func (x *EntityWithValidationOwner) ProtoReflect() protoreflect.Message {
+	mi := &file_mapping_testdata_schema_with_validation_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityWithValidationOwner.ProtoReflect.Descriptor instead.
+func (*EntityWithValidationOwner) Descriptor() ([]byte, []int) {
+	return file_mapping_testdata_schema_with_validation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EntityWithValidationOwner) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_mapping_testdata_schema_with_validation_proto protoreflect.FileDescriptor
+
+var file_mapping_testdata_schema_with_validation_proto_rawDesc = []byte{
+	0x0a, 0x2d, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x64, 0x61,
+	0x74, 0x61, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x1a, 0x2b, 0x6d, 0x77, 0x69, 0x74, 0x6b, 0x6f, 0x77,
+	0x2f, 0x67, 0x6f, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x73, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8d, 0x01, 0x0a, 0x14, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x57,
+	0x69, 0x74, 0x68, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58,
+	0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x10, 0x00, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x3f, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x57, 0x69, 0x74, 0x68, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x22, 0x37, 0x0a, 0x19, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x57, 0x69,
+	0x74, 0x68, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x12, 0x1a, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x3a, 0x5a,
+	0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x37, 0x74, 0x65,
+	0x63, 0x68, 0x6c, 0x61, 0x62, 0x2f, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x2f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x64, 0x61,
+	0x74, 0x61, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_mapping_testdata_schema_with_validation_proto_rawDescOnce sync.Once
+	file_mapping_testdata_schema_with_validation_proto_rawDescData = file_mapping_testdata_schema_with_validation_proto_rawDesc
+)
+
+func file_mapping_testdata_schema_with_validation_proto_rawDescGZIP() []byte {
+	file_mapping_testdata_schema_with_validation_proto_rawDescOnce.Do(func() {
+		file_mapping_testdata_schema_with_validation_proto_rawDescData = protoimpl.X.CompressGZIP(file_mapping_testdata_schema_with_validation_proto_rawDescData)
+	})
+	return file_mapping_testdata_schema_with_validation_proto_rawDescData
+}
+
+var file_mapping_testdata_schema_with_validation_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_mapping_testdata_schema_with_validation_proto_goTypes = []interface{}{
+	(*EntityWithValidation)(nil),      // 0: schema.EntityWithValidation
+	(*EntityWithValidationOwner)(nil), // 1: schema.EntityWithValidationOwner
+}
+var file_mapping_testdata_schema_with_validation_proto_depIdxs = []int32{
+	1, // 0: schema.EntityWithValidation.owner:type_name -> schema.EntityWithValidationOwner
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_mapping_testdata_schema_with_validation_proto_init() }
+func file_mapping_testdata_schema_with_validation_proto_init() {
+	if File_mapping_testdata_schema_with_validation_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_mapping_testdata_schema_with_validation_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EntityWithValidation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mapping_testdata_schema_with_validation_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EntityWithValidationOwner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mapping_testdata_schema_with_validation_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mapping_testdata_schema_with_validation_proto_goTypes,
+		DependencyIndexes: file_mapping_testdata_schema_with_validation_proto_depIdxs,
+		MessageInfos:      file_mapping_testdata_schema_with_validation_proto_msgTypes,
+	}.Build()
+	File_mapping_testdata_schema_with_validation_proto = out.File
+	file_mapping_testdata_schema_with_validation_proto_rawDesc = nil
+	file_mapping_testdata_schema_with_validation_proto_goTypes = nil
+	file_mapping_testdata_schema_with_validation_proto_depIdxs = nil
+}
@@ -0,0 +1,41 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: mapping/testdata/schema/with_validation.proto
+
+package schema
+
+import (
+	fmt "fmt"
+	math "math"
+	proto "github.com/golang/protobuf/proto"
+	_ "github.com/mwitkow/go-proto-validators"
+	github_com_mwitkow_go_proto_validators "github.com/mwitkow/go-proto-validators"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+func (this *EntityWithValidation) Validate() error {
+	if this.Id == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Id", fmt.Errorf(`value '%v' must not be an empty string`, this.Id))
+	}
+	if !(this.Value > 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Value", fmt.Errorf(`value '%v' must be greater than '0'`, this.Value))
+	}
+	if nil == this.Owner {
+		return github_com_mwitkow_go_proto_validators.FieldError("Owner", fmt.Errorf("message must exist"))
+	}
+	if this.Owner != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Owner); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Owner", err)
+		}
+	}
+	return nil
+}
+func (this *EntityWithValidationOwner) Validate() error {
+	if this.Name == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Name", fmt.Errorf(`value '%v' must not be an empty string`, this.Name))
+	}
+	return nil
+}
@@ -0,0 +1,331 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        (unknown)
+// source: mapping/testdata/schema/with_versions.proto
+
+package schema
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EntityRegisteredV1 first version of event, emitted before versioning
+type EntityRegisteredV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *EntityRegisteredV1) Reset() {
+	*x = EntityRegisteredV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mapping_testdata_schema_with_versions_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntityRegisteredV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityRegisteredV1) ProtoMessage() {}
+
+func (x *EntityRegisteredV1) ProtoReflect() protoreflect.Message {
+	mi := &file_mapping_testdata_schema_with_versions_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityRegisteredV1.ProtoReflect.Descriptor instead.
+func (*EntityRegisteredV1) Descriptor() ([]byte, []int) {
+	return file_mapping_testdata_schema_with_versions_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EntityRegisteredV1) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EntityRegisteredV1) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// EntityRegisteredV2 second version of event, with title
+type EntityRegisteredV2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+}
+
+func (x *EntityRegisteredV2) Reset() {
+	*x = EntityRegisteredV2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mapping_testdata_schema_with_versions_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntityRegisteredV2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityRegisteredV2) ProtoMessage() {}
+
+func (x *EntityRegisteredV2) ProtoReflect() protoreflect.Message {
+	mi := &file_mapping_testdata_schema_with_versions_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityRegisteredV2.ProtoReflect.Descriptor instead.
+func (*EntityRegisteredV2) Descriptor() ([]byte, []int) {
+	return file_mapping_testdata_schema_with_versions_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EntityRegisteredV2) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EntityRegisteredV2) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EntityRegisteredV2) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+// EntityRegistered latest version of event, with name split to first and last name
+type EntityRegistered struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FirstName string `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName  string `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Title     string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+}
+
+func (x *EntityRegistered) Reset() {
+	*x = EntityRegistered{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mapping_testdata_schema_with_versions_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntityRegistered) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityRegistered) ProtoMessage() {}
+
+func (x *EntityRegistered) ProtoReflect() protoreflect.Message {
+	mi := &file_mapping_testdata_schema_with_versions_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityRegistered.ProtoReflect.Descriptor instead.
+func (*EntityRegistered) Descriptor() ([]byte, []int) {
+	return file_mapping_testdata_schema_with_versions_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EntityRegistered) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EntityRegistered) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *EntityRegistered) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *EntityRegistered) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+var File_mapping_testdata_schema_with_versions_proto protoreflect.FileDescriptor
+
+var file_mapping_testdata_schema_with_versions_proto_rawDesc = []byte{
+	0x0a, 0x2b, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x64, 0x61,
+	0x74, 0x61, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0x38, 0x0a, 0x12, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x56, 0x31, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x4e, 0x0a, 0x12, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x65, 0x64, 0x56, 0x32, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22,
+	0x74, 0x0a, 0x10, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x37, 0x74, 0x65, 0x63, 0x68, 0x6c, 0x61, 0x62, 0x2f, 0x63, 0x63,
+	0x6b, 0x69, 0x74, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mapping_testdata_schema_with_versions_proto_rawDescOnce sync.Once
+	file_mapping_testdata_schema_with_versions_proto_rawDescData = file_mapping_testdata_schema_with_versions_proto_rawDesc
+)
+
+func file_mapping_testdata_schema_with_versions_proto_rawDescGZIP() []byte {
+	file_mapping_testdata_schema_with_versions_proto_rawDescOnce.Do(func() {
+		file_mapping_testdata_schema_with_versions_proto_rawDescData = protoimpl.X.CompressGZIP(file_mapping_testdata_schema_with_versions_proto_rawDescData)
+	})
+	return file_mapping_testdata_schema_with_versions_proto_rawDescData
+}
+
+var file_mapping_testdata_schema_with_versions_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_mapping_testdata_schema_with_versions_proto_goTypes = []interface{}{
+	(*EntityRegisteredV1)(nil), // 0: schema.EntityRegisteredV1
+	(*EntityRegisteredV2)(nil), // 1: schema.EntityRegisteredV2
+	(*EntityRegistered)(nil),   // 2: schema.EntityRegistered
+}
+var file_mapping_testdata_schema_with_versions_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_mapping_testdata_schema_with_versions_proto_init() }
+func file_mapping_testdata_schema_with_versions_proto_init() {
+	if File_mapping_testdata_schema_with_versions_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_mapping_testdata_schema_with_versions_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EntityRegisteredV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mapping_testdata_schema_with_versions_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EntityRegisteredV2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mapping_testdata_schema_with_versions_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EntityRegistered); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mapping_testdata_schema_with_versions_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mapping_testdata_schema_with_versions_proto_goTypes,
+		DependencyIndexes: file_mapping_testdata_schema_with_versions_proto_depIdxs,
+		MessageInfos:      file_mapping_testdata_schema_with_versions_proto_msgTypes,
+	}.Build()
+	File_mapping_testdata_schema_with_versions_proto = out.File
+	file_mapping_testdata_schema_with_versions_proto_rawDesc = nil
+	file_mapping_testdata_schema_with_versions_proto_goTypes = nil
+	file_mapping_testdata_schema_with_versions_proto_depIdxs = nil
+}
@@ -0,0 +1,25 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: mapping/testdata/schema/with_versions.proto
+
+package schema
+
+import (
+	fmt "fmt"
+	math "math"
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+func (this *EntityRegisteredV1) Validate() error {
+	return nil
+}
+func (this *EntityRegisteredV2) Validate() error {
+	return nil
+}
+func (this *EntityRegistered) Validate() error {
+	return nil
+}
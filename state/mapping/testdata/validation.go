@@ -0,0 +1,16 @@
+package testdata
+
+import (
+	"github.com/s7techlab/cckit/state/mapping"
+	"github.com/s7techlab/cckit/state/mapping/testdata/schema"
+)
+
+var (
+	// ValidatedEntityStateMapping entries are validated before writing to state
+	ValidatedEntityStateMapping = mapping.StateMappings{}.
+		Add(&schema.EntityWithValidation{}, mapping.PKeyId())
+
+	// NotValidatedEntityStateMapping entries validation is disabled
+	NotValidatedEntityStateMapping = mapping.StateMappings{}.
+		Add(&schema.EntityWithValidation{}, mapping.PKeyId(), mapping.WithoutValidation())
+)
@@ -0,0 +1,36 @@
+package testdata
+
+import (
+	"strings"
+
+	"github.com/s7techlab/cckit/state/mapping"
+	"github.com/s7techlab/cckit/state/mapping/testdata/schema"
+)
+
+const EntityRegisteredEvent = `EntityRegistered`
+
+var (
+	// VersionedEventMappings three versions of EntityRegistered event, previous versions upcasted to next version
+	VersionedEventMappings = mapping.EventMappings{}.
+		Add(&schema.EntityRegisteredV1{},
+			mapping.EventName(EntityRegisteredEvent),
+			mapping.EventVersion(1),
+			mapping.EventUpcast(func(payload interface{}) (interface{}, error) {
+				v1 := payload.(*schema.EntityRegisteredV1)
+				return &schema.EntityRegisteredV2{Id: v1.Id, Name: v1.Name}, nil
+			})).
+		Add(&schema.EntityRegisteredV2{},
+			mapping.EventName(EntityRegisteredEvent),
+			mapping.EventVersion(2),
+			mapping.EventUpcast(func(payload interface{}) (interface{}, error) {
+				v2 := payload.(*schema.EntityRegisteredV2)
+				latest := &schema.EntityRegistered{Id: v2.Id, FirstName: v2.Name, Title: v2.Title}
+				if names := strings.SplitN(v2.Name, ` `, 2); len(names) == 2 {
+					latest.FirstName, latest.LastName = names[0], names[1]
+				}
+				return latest, nil
+			})).
+		Add(&schema.EntityRegistered{},
+			mapping.EventName(EntityRegisteredEvent),
+			mapping.EventVersion(3))
+)
@@ -0,0 +1,76 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: schema/catalog.proto
+
+package schema
+
+import (
+	fmt "fmt"
+	math "math"
+	proto "github.com/golang/protobuf/proto"
+	_ "google.golang.org/protobuf/types/descriptorpb"
+	github_com_mwitkow_go_proto_validators "github.com/mwitkow/go-proto-validators"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+func (this *Catalog) Validate() error {
+	for _, item := range this.States {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("States", err)
+			}
+		}
+	}
+	for _, item := range this.Events {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Events", err)
+			}
+		}
+	}
+	if this.Files != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Files); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Files", err)
+		}
+	}
+	return nil
+}
+func (this *StateDescription) Validate() error {
+	for _, item := range this.Indexes {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Indexes", err)
+			}
+		}
+	}
+	for _, item := range this.References {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("References", err)
+			}
+		}
+	}
+	for _, item := range this.Aggregates {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Aggregates", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *AggregateDescription) Validate() error {
+	return nil
+}
+func (this *IndexDescription) Validate() error {
+	return nil
+}
+func (this *ReferenceDescription) Validate() error {
+	return nil
+}
+func (this *EventDescription) Validate() error {
+	return nil
+}
@@ -0,0 +1,525 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        (unknown)
+// source: schema/mapping.proto
+
+package schema
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ReferenceDeletePolicy defines what happens with referring entries when referred entry is deleted
+type ReferenceDeletePolicy int32
+
+const (
+	// deleting of referred entry disallowed if referring entries exist
+	ReferenceDeletePolicy_REFERENCE_DELETE_POLICY_RESTRICT ReferenceDeletePolicy = 0
+	// referring entries are deleted with referred entry
+	ReferenceDeletePolicy_REFERENCE_DELETE_POLICY_CASCADE ReferenceDeletePolicy = 1
+	// reference fields of referring entries are cleared
+	ReferenceDeletePolicy_REFERENCE_DELETE_POLICY_NULLIFY ReferenceDeletePolicy = 2
+)
+
+// Enum value maps for ReferenceDeletePolicy.
+var (
+	ReferenceDeletePolicy_name = map[int32]string{
+		0: "REFERENCE_DELETE_POLICY_RESTRICT",
+		1: "REFERENCE_DELETE_POLICY_CASCADE",
+		2: "REFERENCE_DELETE_POLICY_NULLIFY",
+	}
+	ReferenceDeletePolicy_value = map[string]int32{
+		"REFERENCE_DELETE_POLICY_RESTRICT": 0,
+		"REFERENCE_DELETE_POLICY_CASCADE":  1,
+		"REFERENCE_DELETE_POLICY_NULLIFY":  2,
+	}
+)
+
+func (x ReferenceDeletePolicy) Enum() *ReferenceDeletePolicy {
+	p := new(ReferenceDeletePolicy)
+	*p = x
+	return p
+}
+
+func (x ReferenceDeletePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReferenceDeletePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_schema_mapping_proto_enumTypes[0].Descriptor()
+}
+
+func (ReferenceDeletePolicy) Type() protoreflect.EnumType {
+	return &file_schema_mapping_proto_enumTypes[0]
+}
+
+func (x ReferenceDeletePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReferenceDeletePolicy.Descriptor instead.
+func (ReferenceDeletePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_schema_mapping_proto_rawDescGZIP(), []int{0}
+}
+
+// StateMapping mapping of message to chaincode state,
+// used by protoc-gen-cc-gateway (with `mappings` option) for generating StateMappings var
+type StateMapping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// namespace (key prefix) of state entries, by default - message name
+	Namespace []string `protobuf:"bytes,1,rep,name=namespace,proto3" json:"namespace,omitempty"`
+	// fields of primary key, by default - field `id`
+	PkeyFields []string `protobuf:"bytes,2,rep,name=pkey_fields,json=pkeyFields,proto3" json:"pkey_fields,omitempty"`
+	// message, contains primary key fields, in same package. Cannot be used with pkey_fields
+	PkeySchema string `protobuf:"bytes,3,opt,name=pkey_schema,json=pkeySchema,proto3" json:"pkey_schema,omitempty"`
+	// list message with `items` field, in same package
+	List string `protobuf:"bytes,4,opt,name=list,proto3" json:"list,omitempty"`
+	// additional indexes
+	Indexes []*StateIndex `protobuf:"bytes,5,rep,name=indexes,proto3" json:"indexes,omitempty"`
+	// foreign references to another mapped messages
+	References []*StateReference `protobuf:"bytes,6,rep,name=references,proto3" json:"references,omitempty"`
+}
+
+func (x *StateMapping) Reset() {
+	*x = StateMapping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_mapping_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateMapping) ProtoMessage() {}
+
+func (x *StateMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_mapping_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateMapping.ProtoReflect.Descriptor instead.
+func (*StateMapping) Descriptor() ([]byte, []int) {
+	return file_schema_mapping_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StateMapping) GetNamespace() []string {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+func (x *StateMapping) GetPkeyFields() []string {
+	if x != nil {
+		return x.PkeyFields
+	}
+	return nil
+}
+
+func (x *StateMapping) GetPkeySchema() string {
+	if x != nil {
+		return x.PkeySchema
+	}
+	return ""
+}
+
+func (x *StateMapping) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *StateMapping) GetIndexes() []*StateIndex {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
+func (x *StateMapping) GetReferences() []*StateReference {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+// StateIndex additional index of mapped message
+type StateIndex struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// index name, by default - joined field names
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// fields of index value
+	Fields []string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	// index value is required
+	Required bool `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	// multiple index values from one repeated field
+	Multi bool `protobuf:"varint,4,opt,name=multi,proto3" json:"multi,omitempty"`
+	// index value can refer to multiple entries
+	NonUniq bool `protobuf:"varint,5,opt,name=non_uniq,json=nonUniq,proto3" json:"non_uniq,omitempty"`
+}
+
+func (x *StateIndex) Reset() {
+	*x = StateIndex{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_mapping_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateIndex) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateIndex) ProtoMessage() {}
+
+func (x *StateIndex) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_mapping_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateIndex.ProtoReflect.Descriptor instead.
+func (*StateIndex) Descriptor() ([]byte, []int) {
+	return file_schema_mapping_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StateIndex) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StateIndex) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *StateIndex) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *StateIndex) GetMulti() bool {
+	if x != nil {
+		return x.Multi
+	}
+	return false
+}
+
+func (x *StateIndex) GetNonUniq() bool {
+	if x != nil {
+		return x.NonUniq
+	}
+	return false
+}
+
+// StateReference foreign reference from mapped message to another mapped message
+type StateReference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// reference name, by default - joined field names
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// fields of referring message, contain primary key of referred message
+	Fields []string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	// referred message, in same package
+	To       string                `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	OnDelete ReferenceDeletePolicy `protobuf:"varint,4,opt,name=on_delete,json=onDelete,proto3,enum=state.schema.ReferenceDeletePolicy" json:"on_delete,omitempty"`
+}
+
+func (x *StateReference) Reset() {
+	*x = StateReference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_schema_mapping_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateReference) ProtoMessage() {}
+
+func (x *StateReference) ProtoReflect() protoreflect.Message {
+	mi := &file_schema_mapping_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateReference.ProtoReflect.Descriptor instead.
+func (*StateReference) Descriptor() ([]byte, []int) {
+	return file_schema_mapping_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StateReference) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StateReference) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *StateReference) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *StateReference) GetOnDelete() ReferenceDeletePolicy {
+	if x != nil {
+		return x.OnDelete
+	}
+	return ReferenceDeletePolicy_REFERENCE_DELETE_POLICY_RESTRICT
+}
+
+var file_schema_mapping_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*StateMapping)(nil),
+		Field:         50701,
+		Name:          "state.schema.state",
+		Tag:           "bytes,50701,opt,name=state",
+		Filename:      "schema/mapping.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         50702,
+		Name:          "state.schema.event",
+		Tag:           "varint,50702,opt,name=event",
+		Filename:      "schema/mapping.proto",
+	},
+}
+
+// Extension fields to descriptorpb.MessageOptions.
+var (
+	// message is mapped to chaincode state
+	//
+	// optional state.schema.StateMapping state = 50701;
+	E_State = &file_schema_mapping_proto_extTypes[0]
+	// message is mapped to chaincode event
+	//
+	// optional bool event = 50702;
+	E_Event = &file_schema_mapping_proto_extTypes[1]
+)
+
+var File_schema_mapping_proto protoreflect.FileDescriptor
+
+var file_schema_mapping_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf4, 0x01, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6b, 0x65, 0x79,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6b, 0x65, 0x79, 0x5f, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6b, 0x65,
+	0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x12,
+	0x3c, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x85, 0x01,
+	0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f,
+	0x6e, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6e, 0x6f,
+	0x6e, 0x55, 0x6e, 0x69, 0x71, 0x22, 0x8e, 0x01, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x74, 0x6f, 0x12, 0x40, 0x0a, 0x09, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x08, 0x6f, 0x6e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x2a, 0x87, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x24, 0x0a, 0x20, 0x52, 0x45, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x44, 0x45,
+	0x4c, 0x45, 0x54, 0x45, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x52, 0x45, 0x53, 0x54,
+	0x52, 0x49, 0x43, 0x54, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x52, 0x45, 0x46, 0x45, 0x52, 0x45,
+	0x4e, 0x43, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43,
+	0x59, 0x5f, 0x43, 0x41, 0x53, 0x43, 0x41, 0x44, 0x45, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x52,
+	0x45, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f,
+	0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x49, 0x46, 0x59, 0x10, 0x02,
+	0x3a, 0x53, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x8d, 0x8c, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x3a, 0x37, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1f,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x8e, 0x8c, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x29,
+	0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x37, 0x74,
+	0x65, 0x63, 0x68, 0x6c, 0x61, 0x62, 0x2f, 0x63, 0x63, 0x6b, 0x69, 0x74, 0x2f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_schema_mapping_proto_rawDescOnce sync.Once
+	file_schema_mapping_proto_rawDescData = file_schema_mapping_proto_rawDesc
+)
+
+func file_schema_mapping_proto_rawDescGZIP() []byte {
+	file_schema_mapping_proto_rawDescOnce.Do(func() {
+		file_schema_mapping_proto_rawDescData = protoimpl.X.CompressGZIP(file_schema_mapping_proto_rawDescData)
+	})
+	return file_schema_mapping_proto_rawDescData
+}
+
+var file_schema_mapping_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_schema_mapping_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_schema_mapping_proto_goTypes = []interface{}{
+	(ReferenceDeletePolicy)(0),          // 0: state.schema.ReferenceDeletePolicy
+	(*StateMapping)(nil),                // 1: state.schema.StateMapping
+	(*StateIndex)(nil),                  // 2: state.schema.StateIndex
+	(*StateReference)(nil),              // 3: state.schema.StateReference
+	(*descriptorpb.MessageOptions)(nil), // 4: google.protobuf.MessageOptions
+}
+var file_schema_mapping_proto_depIdxs = []int32{
+	2, // 0: state.schema.StateMapping.indexes:type_name -> state.schema.StateIndex
+	3, // 1: state.schema.StateMapping.references:type_name -> state.schema.StateReference
+	0, // 2: state.schema.StateReference.on_delete:type_name -> state.schema.ReferenceDeletePolicy
+	4, // 3: state.schema.state:extendee -> google.protobuf.MessageOptions
+	4, // 4: state.schema.event:extendee -> google.protobuf.MessageOptions
+	1, // 5: state.schema.state:type_name -> state.schema.StateMapping
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	5, // [5:6] is the sub-list for extension type_name
+	3, // [3:5] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_schema_mapping_proto_init() }
+func file_schema_mapping_proto_init() {
+	if File_schema_mapping_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_schema_mapping_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateMapping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_schema_mapping_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateIndex); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_schema_mapping_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateReference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_schema_mapping_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 2,
+			NumServices:   0,
+		},
+		GoTypes:           file_schema_mapping_proto_goTypes,
+		DependencyIndexes: file_schema_mapping_proto_depIdxs,
+		EnumInfos:         file_schema_mapping_proto_enumTypes,
+		MessageInfos:      file_schema_mapping_proto_msgTypes,
+		ExtensionInfos:    file_schema_mapping_proto_extTypes,
+	}.Build()
+	File_schema_mapping_proto = out.File
+	file_schema_mapping_proto_rawDesc = nil
+	file_schema_mapping_proto_goTypes = nil
+	file_schema_mapping_proto_depIdxs = nil
+}
@@ -0,0 +1,41 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: schema/mapping.proto
+
+package schema
+
+import (
+	fmt "fmt"
+	math "math"
+	proto "github.com/golang/protobuf/proto"
+	_ "google.golang.org/protobuf/types/descriptorpb"
+	github_com_mwitkow_go_proto_validators "github.com/mwitkow/go-proto-validators"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+func (this *StateMapping) Validate() error {
+	for _, item := range this.Indexes {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Indexes", err)
+			}
+		}
+	}
+	for _, item := range this.References {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("References", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *StateIndex) Validate() error {
+	return nil
+}
+func (this *StateReference) Validate() error {
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	math "math"
 	proto "github.com/golang/protobuf/proto"
 	_ "google.golang.org/protobuf/types/known/anypb"
+	_ "google.golang.org/protobuf/types/known/fieldmaskpb"
 	github_com_mwitkow_go_proto_validators "github.com/mwitkow/go-proto-validators"
 )
 
@@ -22,6 +23,16 @@ func (this *KeyRefId) Validate() error {
 func (this *KeyRef) Validate() error {
 	return nil
 }
+func (this *KeyRefList) Validate() error {
+	for _, item := range this.Items {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Items", err)
+			}
+		}
+	}
+	return nil
+}
 func (this *List) Validate() error {
 	for _, item := range this.Items {
 		if item != nil {
@@ -32,3 +43,32 @@ func (this *List) Validate() error {
 	}
 	return nil
 }
+func (this *EntryPatched) Validate() error {
+	if this.Mask != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Mask); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Mask", err)
+		}
+	}
+	if this.Entry != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Entry); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Entry", err)
+		}
+	}
+	return nil
+}
+func (this *Aggregate) Validate() error {
+	// Validation of proto3 map<> fields is unsupported.
+	// Validation of proto3 map<> fields is unsupported.
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+func (this *AggregateList) Validate() error {
+	for _, item := range this.Items {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Items", err)
+			}
+		}
+	}
+	return nil
+}
@@ -22,6 +22,15 @@ type HistoryEntry struct {
 // HistoryEntryList list of history entries
 type HistoryEntryList []HistoryEntry
 
+// TxStore values, stored during transaction
+type TxStore map[string]interface{}
+
+// txStore holds values of transaction, reset on next transaction
+type txStore struct {
+	txID   string
+	values TxStore
+}
+
 type Impl struct {
 	stub   shim.ChaincodeStubInterface
 	logger *zap.Logger
@@ -37,8 +46,16 @@ type Impl struct {
 	StateKeyReverseTransformer KeyTransformer
 	StateGetTransformer        FromBytesTransformer
 	StatePutTransformer        ToBytesTransformer
+
+	txStore *txStore
 }
 
+var (
+	_ KeysPaginator     = &Impl{}
+	_ PrivateKeysLister = &Impl{}
+	_ TxStorer          = &Impl{}
+)
+
 // NewState creates wrapper on shim.ChaincodeStubInterface for working with state
 func NewState(stub shim.ChaincodeStubInterface, logger *zap.Logger) *Impl {
 	i := &Impl{
@@ -48,6 +65,7 @@ func NewState(stub shim.ChaincodeStubInterface, logger *zap.Logger) *Impl {
 		StateKeyReverseTransformer: KeyAsIs,
 		StateGetTransformer:        ConvertFromBytes,
 		StatePutTransformer:        ConvertToBytes,
+		txStore:                    &txStore{},
 	}
 
 	// Get data by key from state, direct from stub
@@ -95,7 +113,22 @@ func (s *Impl) Clone() State {
 		StateKeyReverseTransformer:                  s.StateKeyReverseTransformer,
 		StateGetTransformer:                         s.StateGetTransformer,
 		StatePutTransformer:                         s.StatePutTransformer,
+		txStore:                                     s.txStore,
+	}
+}
+
+// TxStore returns values, stored during current transaction. Store is shared with state clones
+// and reset on next transaction, if state is used across transactions
+func (s *Impl) TxStore() TxStore {
+	if s.txStore == nil {
+		s.txStore = &txStore{}
+	}
+
+	if txID := s.stub.GetTxID(); s.txStore.values == nil || s.txStore.txID != txID {
+		s.txStore.txID = txID
+		s.txStore.values = make(TxStore)
 	}
+	return s.txStore.values
 }
 
 func (s *Impl) Logger() *zap.Logger {
@@ -308,6 +341,25 @@ func (s *Impl) Keys(namespace interface{}) ([]string, error) {
 
 	defer func() { _ = iter.Close() }()
 
+	return s.keys(iter)
+}
+
+// KeysPaginated returns keys with namespace, starting from bookmark key, and bookmark for next page.
+// Fabric supports paginated queries in read-only transactions only
+func (s *Impl) KeysPaginated(namespace interface{}, pageSize int32, bookmark string) (
+	[]string, *pb.QueryResponseMetadata, error) {
+	iter, md, err := s.createStateQueryPagedIterator(namespace, pageSize, bookmark)
+	if err != nil {
+		return nil, nil, fmt.Errorf(`state iterator: %w`, err)
+	}
+
+	defer func() { _ = iter.Close() }()
+
+	keys, err := s.keys(iter)
+	return keys, md, err
+}
+
+func (s *Impl) keys(iter shim.StateQueryIteratorInterface) ([]string, error) {
 	var keys []string
 	for iter.HasNext() {
 		v, err := iter.Next()
@@ -558,3 +610,67 @@ func (s *Impl) DeletePrivate(collection string, entry interface{}) error {
 	s.logger.Debug(`private state DELETE`, zap.String(`key`, key.String))
 	return s.stub.DelPrivateData(collection, key.String)
 }
+
+// KeysPrivate returns keys with namespace from private state
+func (s *Impl) KeysPrivate(collection string, namespace interface{}) ([]string, error) {
+	keys, _, err := s.KeysPrivatePaginated(collection, namespace, 0, ``)
+	return keys, err
+}
+
+// KeysPrivatePaginated returns keys with namespace from private state, starting from bookmark key,
+// and bookmark for next page. Fabric has no paginated queries for private data,
+// so namespace is iterated from the beginning until page is filled
+func (s *Impl) KeysPrivatePaginated(collection string, namespace interface{}, pageSize int32, bookmark string) (
+	[]string, *pb.QueryResponseMetadata, error) {
+	_, key, err := s.normalizeAndTransformKey(namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+	s.logger.Debug(`private state KEYS`, zap.String(`namespace`, key.String()),
+		zap.Int32("pageSize", pageSize), zap.String("bookmark", bookmark))
+
+	iter, err := s.stub.GetPrivateDataByPartialCompositeKey(collection, key[0], key[1:])
+	if err != nil {
+		return nil, nil, fmt.Errorf(`create keys iterator: %w`, err)
+	}
+	defer func() { _ = iter.Close() }()
+
+	var (
+		keys []string
+		md   = &pb.QueryResponseMetadata{}
+	)
+	for iter.HasNext() {
+		kv, err := iter.Next()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if kv.Key < bookmark {
+			continue
+		}
+
+		if pageSize > 0 && int32(len(keys)) == pageSize {
+			md.Bookmark = kv.Key
+			break
+		}
+
+		k, err := KeyFromComposite(s.stub, kv.Key)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if k, err = s.StateKeyReverseTransformer(k); err != nil {
+			return nil, nil, fmt.Errorf(`reverse transform key: %w`, err)
+		}
+
+		keyStr, err := KeyToString(s.stub, k)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		keys = append(keys, keyStr)
+	}
+
+	md.FetchedRecordsCount = int32(len(keys))
+	return keys, md, nil
+}
@@ -26,6 +26,11 @@ type (
 	}
 )
 
+// TxStore returns values, stored during current transaction
+func (c *Cached) TxStore() TxStore {
+	return c.State.(*Impl).TxStore()
+}
+
 // WithCache returns state with tx level state cache
 func WithCache(ss State) *Cached {
 	s := ss.(*Impl)
@@ -6,20 +6,47 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/hyperledger/fabric-chaincode-go/shim"
 	"github.com/hyperledger/fabric-protos-go/common"
+	pmsp "github.com/hyperledger/fabric-protos-go/msp"
 	"github.com/hyperledger/fabric-protos-go/peer"
 	"github.com/hyperledger/fabric/msp"
+	"github.com/hyperledger/fabric/protoutil"
+
+	"github.com/s7techlab/cckit/identity"
+	"github.com/s7techlab/cckit/sdk"
 )
 
 type (
 	MockedPeer struct {
 		// channel name -> chaincode name
 		ChannelCC ChannelsMockStubs
+		// endorsed proposals, waiting for submit: tx id -> proposal
+		endorsed map[string]*endorsedProposal
+		// tx ids of endorsed proposals in order of endorsement, oldest proposals are dropped
+		// when MaxEndorsedProposals is exceeded
+		endorsedOrder []string
+		// committed transactions: tx id -> status
+		committed map[string]*sdk.TxStatus
 		m         sync.Mutex
 	}
 
+	endorsedProposal struct {
+		channel   string
+		chaincode string
+		mspID     string
+		certPEM   []byte
+		args      [][]byte
+		transient map[string][]byte
+		// simulation result, state changes and event are committed on Submit
+		simulation *TxSimulation
+	}
+
+	// mockedEndorser signs proposal responses of MockedPeer, endorsement signature is empty
+	mockedEndorser struct{}
+
 	ChannelMockStubs map[string]*MockStub
 
 	ChannelsMockStubs map[string]ChannelMockStubs
@@ -31,10 +58,26 @@ type (
 	}
 )
 
+var (
+	_ sdk.OfflineInvoker = &MockedPeer{}
+	_ sdk.TxStatusReader = &MockedPeer{}
+)
+
+const (
+	// MockedEventsBlock block number of all chaincode events, delivered by MockedPeer
+	MockedEventsBlock = 55
+	// MaxEndorsedProposals is max number of endorsed proposals, waiting for submit
+	MaxEndorsedProposals = 1000
+	// MockedOrgs number of organizations (M), imitated by MockedPeer for N of M tx waiter
+	MockedOrgs = 1
+)
+
 // NewPeer implements Peer interface
 func NewPeer() *MockedPeer {
 	return &MockedPeer{
 		ChannelCC: make(ChannelsMockStubs),
+		endorsed:  make(map[string]*endorsedProposal),
+		committed: make(map[string]*sdk.TxStatus),
 	}
 }
 
@@ -71,7 +114,11 @@ func (mp *MockedPeer) Invoke(
 	args [][]byte,
 	identity msp.SigningIdentity,
 	transArgs map[string][]byte,
-	_ string) (res *peer.Response, chaincodeTx string, err error) {
+	txWaiterType string) (res *peer.Response, chaincodeTx string, err error) {
+
+	if err = checkTxWaiter(txWaiterType); err != nil {
+		return nil, ``, err
+	}
 
 	mp.m.Lock()
 	defer mp.m.Unlock()
@@ -83,9 +130,11 @@ func (mp *MockedPeer) Invoke(
 	response := mockStub.From(identity).WithTransient(transArgs).InvokeBytes(args...)
 	if response.Status == shim.ERROR {
 		err = errors.New(response.Message)
+	} else {
+		mp.commit(channel, mockStub)
 	}
 
-	return &response, mockStub.TxID, err
+	return &response, mockStub.LastTxID, err
 }
 
 func (mp *MockedPeer) Query(
@@ -112,6 +161,177 @@ func (mp *MockedPeer) Query(
 
 }
 
+// Endorse verifies creator signature of proposal and simulates chaincode invocation,
+// simulated state changes and chaincode event are committed on Submit
+func (mp *MockedPeer) Endorse(
+	_ context.Context,
+	channel string,
+	chaincode string,
+	signedProposal *peer.SignedProposal) ([]*peer.ProposalResponse, error) {
+
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	mockStub, err := mp.Chaincode(channel, chaincode)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, err := protoutil.UnmarshalProposal(signedProposal.ProposalBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	txID, endorsed, err := endorsedProposalFrom(proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	if endorsed.channel != channel || endorsed.chaincode != chaincode {
+		return nil, fmt.Errorf(`%s: channel=%s, chaincode=%s`, ErrProposalMismatch, endorsed.channel, endorsed.chaincode)
+	}
+
+	if err = verifySignature(endorsed.certPEM, signedProposal.ProposalBytes, signedProposal.Signature); err != nil {
+		return nil, fmt.Errorf(`proposal: %w`, err)
+	}
+
+	response, simulation := mockStub.From(endorsed.mspID, endorsed.certPEM).WithTransient(endorsed.transient).
+		MockSimulateTx(txID, endorsed.args)
+	if response.Status == shim.ERROR {
+		return []*peer.ProposalResponse{{Response: &response}}, errors.New(response.Message)
+	}
+	endorsed.simulation = simulation
+
+	var eventBytes []byte
+	if simulation.Event != nil {
+		if eventBytes, err = proto.Marshal(simulation.Event); err != nil {
+			return nil, err
+		}
+	}
+
+	proposalResponse, err := protoutil.CreateProposalResponse(proposal.Header, proposal.Payload,
+		&response, nil, eventBytes, &peer.ChaincodeID{Name: chaincode}, mockedEndorser{})
+	if err != nil {
+		return nil, err
+	}
+	proposalResponse.Response = &response
+
+	mp.addEndorsed(txID, endorsed)
+
+	return []*peer.ProposalResponse{proposalResponse}, nil
+}
+
+// Submit verifies creator signature of transaction and commits state changes and event of endorsed proposal,
+// if chaincode state is changed after endorsement, transaction is rejected with MVCC_READ_CONFLICT validation code
+func (mp *MockedPeer) Submit(
+	_ context.Context,
+	channel string,
+	envelope *common.Envelope,
+	txWaiterType string) (chaincodeTx string, err error) {
+
+	if err = checkTxWaiter(txWaiterType); err != nil {
+		return ``, err
+	}
+
+	mp.m.Lock()
+	defer mp.m.Unlock()
+
+	payload, err := protoutil.UnmarshalPayload(envelope.Payload)
+	if err != nil {
+		return ``, err
+	}
+	if payload.Header == nil {
+		return ``, ErrTxNotEndorsed
+	}
+
+	channelHeader, err := protoutil.UnmarshalChannelHeader(payload.Header.ChannelHeader)
+	if err != nil {
+		return ``, err
+	}
+
+	endorsed, ok := mp.endorsed[channelHeader.TxId]
+	if !ok || endorsed.channel != channel {
+		return ``, fmt.Errorf(`%s: tx_id=%s`, ErrTxNotEndorsed, channelHeader.TxId)
+	}
+
+	if err = verifySignature(endorsed.certPEM, envelope.Payload, envelope.Signature); err != nil {
+		return ``, fmt.Errorf(`transaction: %w`, err)
+	}
+	mp.removeEndorsed(channelHeader.TxId)
+
+	mockStub, err := mp.Chaincode(endorsed.channel, endorsed.chaincode)
+	if err != nil {
+		return ``, err
+	}
+
+	if err = mockStub.MockCommit(endorsed.simulation); err != nil {
+		return ``, err
+	}
+	mp.commit(endorsed.channel, mockStub)
+
+	return channelHeader.TxId, nil
+}
+
+// TransactionStatus returns status of transaction, committed with Invoke or Submit,
+// all transactions are committed as valid in MockedEventsBlock
+func (mp *MockedPeer) TransactionStatus(
+	_ context.Context,
+	channel string,
+	txID string,
+	_ msp.SigningIdentity) (*sdk.TxStatus, error) {
+
+	mp.m.Lock()
+	defer mp.m.Unlock()
+	if _, exists := mp.ChannelCC[channel]; !exists {
+		return nil, fmt.Errorf(`%s: channel=%s`, ErrChaincodeNotExists, channel)
+	}
+
+	status, ok := mp.committed[channel+`/`+txID]
+	if !ok {
+		return nil, fmt.Errorf(`%s: tx_id=%s`, ErrTxNotFound, txID)
+	}
+
+	return status, nil
+}
+
+// addEndorsed adds endorsed proposal, waiting for submit, and drops oldest proposals over MaxEndorsedProposals
+func (mp *MockedPeer) addEndorsed(txID string, endorsed *endorsedProposal) {
+	if mp.endorsed == nil {
+		mp.endorsed = make(map[string]*endorsedProposal)
+	}
+	if _, exists := mp.endorsed[txID]; !exists {
+		mp.endorsedOrder = append(mp.endorsedOrder, txID)
+	}
+	mp.endorsed[txID] = endorsed
+
+	for len(mp.endorsedOrder) > MaxEndorsedProposals {
+		delete(mp.endorsed, mp.endorsedOrder[0])
+		mp.endorsedOrder = mp.endorsedOrder[1:]
+	}
+}
+
+func (mp *MockedPeer) removeEndorsed(txID string) {
+	delete(mp.endorsed, txID)
+	for i, id := range mp.endorsedOrder {
+		if id == txID {
+			mp.endorsedOrder = append(mp.endorsedOrder[:i], mp.endorsedOrder[i+1:]...)
+			break
+		}
+	}
+}
+
+// commit stores status of last transaction of mockStub
+func (mp *MockedPeer) commit(channel string, mockStub *MockStub) {
+	if mp.committed == nil {
+		mp.committed = make(map[string]*sdk.TxStatus)
+	}
+	mp.committed[channel+`/`+mockStub.LastTxID] = &sdk.TxStatus{
+		TxID:           mockStub.LastTxID,
+		BlockNumber:    MockedEventsBlock,
+		ValidationCode: peer.TxValidationCode_VALID,
+		Timestamp:      mockStub.TxTimestamp,
+	}
+}
+
 func (mp *MockedPeer) Chaincode(channel string, chaincode string) (*MockStub, error) {
 	ms, exists := mp.ChannelCC[channel][chaincode]
 	if !exists {
@@ -121,6 +341,8 @@ func (mp *MockedPeer) Chaincode(channel string, chaincode string) (*MockStub, er
 	return ms, nil
 }
 
+// Events returns chaincode events. All mocked events are in MockedEventsBlock, so events history is delivered
+// if from block (first of blockRange) is not newer than MockedEventsBlock, otherwise only new events are delivered
 func (mp *MockedPeer) Events(
 	ctx context.Context,
 	channel string,
@@ -140,8 +362,8 @@ func (mp *MockedPeer) Events(
 	var (
 		eventsRaw chan *peer.ChaincodeEvent
 	)
-	// from the oldest block to current channel height
-	if len(blockRange) > 0 && blockRange[0] == 0 {
+	// from the oldest block (or from block, not newer than mocked events block) to current channel height
+	if len(blockRange) > 0 && blockRange[0] >= 0 && uint64(blockRange[0]) <= MockedEventsBlock {
 		// create copy of mockStub events chan
 		eventsRaw, closer = mockStub.EventSubscription(0)
 
@@ -178,7 +400,7 @@ func (mp *MockedPeer) Events(
 				event: e,
 
 				// todo: store information about block and timestamp in MockStub
-				block:       55,
+				block:       MockedEventsBlock,
 				txTimestamp: nil,
 			}
 		}
@@ -212,3 +434,70 @@ func (es *EventSubscription) Close() error {
 	})
 	return nil
 }
+
+// checkTxWaiter checks that N of M tx waiter can be satisfied by MockedOrgs organizations
+func checkTxWaiter(txWaiterType string) error {
+	if n, ok := sdk.ParseTxWaiterNOfM(txWaiterType); ok && n > MockedOrgs {
+		return fmt.Errorf(`%w: %d of %d`, ErrTxWaiterNotSatisfiable, n, MockedOrgs)
+	}
+	return nil
+}
+
+// endorsedProposalFrom extracts tx id, channel, chaincode, creator and args from chaincode proposal
+func endorsedProposalFrom(proposal *peer.Proposal) (string, *endorsedProposal, error) {
+	header, err := protoutil.UnmarshalHeader(proposal.Header)
+	if err != nil {
+		return ``, nil, err
+	}
+
+	channelHeader, err := protoutil.UnmarshalChannelHeader(header.ChannelHeader)
+	if err != nil {
+		return ``, nil, err
+	}
+
+	signatureHeader, err := protoutil.UnmarshalSignatureHeader(header.SignatureHeader)
+	if err != nil {
+		return ``, nil, err
+	}
+
+	creator := &pmsp.SerializedIdentity{}
+	if err = proto.Unmarshal(signatureHeader.Creator, creator); err != nil {
+		return ``, nil, err
+	}
+
+	payload, err := protoutil.UnmarshalChaincodeProposalPayload(proposal.Payload)
+	if err != nil {
+		return ``, nil, err
+	}
+
+	spec, err := protoutil.UnmarshalChaincodeInvocationSpec(payload.Input)
+	if err != nil {
+		return ``, nil, err
+	}
+
+	return channelHeader.TxId, &endorsedProposal{
+		channel:   channelHeader.ChannelId,
+		chaincode: spec.GetChaincodeSpec().GetChaincodeId().GetName(),
+		mspID:     creator.Mspid,
+		certPEM:   creator.IdBytes,
+		args:      spec.GetChaincodeSpec().GetInput().GetArgs(),
+		transient: payload.TransientMap,
+	}, nil
+}
+
+func verifySignature(certPEM, msg, signature []byte) error {
+	creator, err := identity.New(``, certPEM)
+	if err != nil {
+		return err
+	}
+
+	return identity.VerifySignature(creator.Cert, msg, signature)
+}
+
+func (mockedEndorser) Serialize() ([]byte, error) {
+	return nil, nil
+}
+
+func (mockedEndorser) Sign([]byte) ([]byte, error) {
+	return nil, nil
+}
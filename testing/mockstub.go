@@ -16,6 +16,7 @@ import (
 	"github.com/hyperledger/fabric/msp"
 
 	"github.com/s7techlab/cckit/convert"
+	"github.com/s7techlab/cckit/sdk"
 )
 
 const EventChannelBufferSize = 100
@@ -27,12 +28,35 @@ var (
 	ErrUnknownFromArgsType = errors.New(`unknown args type to cckit.MockStub.From func`)
 	// ErrKeyAlreadyExistsInTransientMap occurs when attempting to set existing key in transient map
 	ErrKeyAlreadyExistsInTransientMap = errors.New(`key already exists in transient map`)
+	// ErrProposalMismatch occurs when signed proposal is endorsed for another channel or chaincode
+	ErrProposalMismatch = errors.New(`proposal channel or chaincode mismatch`)
+	// ErrTxNotEndorsed occurs when attempting to submit transaction without endorsed proposal
+	ErrTxNotEndorsed = errors.New(`transaction not endorsed`)
+	// ErrTxWaiterNotSatisfiable occurs when N of M tx waiter requires more organizations, than MockedPeer imitates
+	ErrTxWaiterNotSatisfiable = errors.New(`tx waiter not satisfiable`)
+	// ErrTxNotFound occurs when transaction is not committed by MockedPeer
+	ErrTxNotFound = errors.New(`transaction not found`)
 )
 
 type StateItem struct {
-	Key    string
-	Value  []byte
-	Delete bool
+	// Collection of private data item, empty for public state item
+	Collection string
+	Key        string
+	Value      []byte
+	Delete     bool
+}
+
+// TxSimulation is result of transaction simulation: response, state changes (write set) and chaincode event,
+// which are applied to state with MockCommit
+type TxSimulation struct {
+	TxID     string
+	Response peer.Response
+	Event    *peer.ChaincodeEvent
+
+	// write sets of stub and invokable stubs
+	writeSets map[*MockStub][]*StateItem
+	// read sets of stub and invokable stubs: versions of keys, read at simulation
+	readSets map[*MockStub]map[string]uint64
 }
 
 // MockStub replacement of shim.MockStub with creator mocking facilities
@@ -40,7 +64,16 @@ type MockStub struct {
 	shimtest.MockStub
 	cc shim.Chaincode
 
-	StateBuffer []*StateItem // buffer for state changes during transaction
+	StateBuffer        []*StateItem // buffer for state changes during transaction
+	PrivateStateBuffer []*StateItem // buffer for private data changes during transaction
+
+	// stateVersion is incremented on each state change commit
+	stateVersion uint64
+	// keyVersions state versions of last key changes, by collection and key
+	keyVersions map[string]uint64
+	// readSet versions of keys, read with GetState and GetPrivateData during transaction.
+	// Keys, iterated with range queries of shimtest.MockStub, are not tracked
+	readSet map[string]uint64
 
 	m sync.Mutex
 
@@ -78,6 +111,36 @@ func NewMockStub(name string, cc shim.Chaincode) *MockStub {
 	}
 }
 
+// GetState mocked, key version is added to transaction read set
+func (stub *MockStub) GetState(key string) ([]byte, error) {
+	stub.addRead(``, key)
+	return stub.MockStub.GetState(key)
+}
+
+// GetPrivateData mocked, key version is added to transaction read set
+func (stub *MockStub) GetPrivateData(collection string, key string) ([]byte, error) {
+	stub.addRead(collection, key)
+	return stub.MockStub.GetPrivateData(collection, key)
+}
+
+func (stub *MockStub) addRead(collection, key string) {
+	if stub.TxID == `` {
+		return
+	}
+	if stub.readSet == nil {
+		stub.readSet = make(map[string]uint64)
+	}
+
+	versionKey := stateVersionKey(collection, key)
+	if _, ok := stub.readSet[versionKey]; !ok {
+		stub.readSet[versionKey] = stub.keyVersions[versionKey]
+	}
+}
+
+func stateVersionKey(collection, key string) string {
+	return collection + "\x00" + key
+}
+
 // PutState wrapped functions puts state items in queue and dumps
 // to state after invocation
 func (stub *MockStub) PutState(key string, value []byte) error {
@@ -294,6 +357,13 @@ func (stub *MockStub) MockInit(uuid string, args [][]byte) peer.Response {
 func (stub *MockStub) DumpStateBuffer() {
 	// dump state buffer to state
 	if stub.TxResult.Status == shim.OK {
+		if len(stub.StateBuffer) > 0 || len(stub.PrivateStateBuffer) > 0 {
+			stub.stateVersion++
+		}
+		if stub.keyVersions == nil {
+			stub.keyVersions = make(map[string]uint64)
+		}
+
 		for i := range stub.StateBuffer {
 			s := stub.StateBuffer[i]
 			if s.Delete {
@@ -301,11 +371,23 @@ func (stub *MockStub) DumpStateBuffer() {
 			} else {
 				_ = stub.MockStub.PutState(s.Key, s.Value)
 			}
+			stub.keyVersions[stateVersionKey(``, s.Key)] = stub.stateVersion
+		}
+
+		for _, s := range stub.PrivateStateBuffer {
+			if s.Delete {
+				stub.delPrivateData(s.Collection, s.Key)
+			} else {
+				stub.putPrivateData(s.Collection, s.Key, s.Value)
+			}
+			stub.keyVersions[stateVersionKey(s.Collection, s.Key)] = stub.stateVersion
 		}
 	} else {
 		stub.ChaincodeEvent = nil
 	}
 	stub.StateBuffer = nil
+	stub.PrivateStateBuffer = nil
+	stub.readSet = nil
 }
 
 func (stub *MockStub) dumpEvents() {
@@ -328,8 +410,10 @@ func (stub *MockStub) MockQuery(uuid string, args [][]byte) peer.Response {
 func (stub *MockStub) MockTransactionStart(uuid string) {
 	//empty event
 	stub.ChaincodeEvent = nil
-	// empty state buffer
+	// empty state buffers
 	stub.StateBuffer = nil
+	stub.PrivateStateBuffer = nil
+	stub.readSet = nil
 	stub.TxResult = peer.Response{}
 
 	stub.MockStub.MockTransactionStart(uuid)
@@ -370,6 +454,102 @@ func (stub *MockStub) MockInvoke(uuid string, args [][]byte) peer.Response {
 	return stub.TxResult
 }
 
+// MockSimulate executes chaincode as endorsing peer: state changes are not written to state
+// and chaincode event is not emitted, event stays available in ChaincodeEvent
+func (stub *MockStub) MockSimulate(uuid string, args [][]byte) peer.Response {
+	response, _ := stub.MockSimulateTx(uuid, args)
+	return response
+}
+
+// MockSimulateTx executes chaincode as endorsing peer and returns simulation with state changes
+// and chaincode event, state changes are written to state only with MockCommit
+func (stub *MockStub) MockSimulateTx(uuid string, args [][]byte) (peer.Response, *TxSimulation) {
+	stub.m.Lock()
+	defer stub.m.Unlock()
+
+	stub.SetArgs(args)
+
+	stub.MockTransactionStart(uuid)
+	stub.TxResult = stub.cc.Invoke(stub)
+
+	simulation := &TxSimulation{
+		TxID:          uuid,
+		Response:      stub.TxResult,
+		writeSets: make(map[*MockStub][]*StateItem),
+		readSets:  make(map[*MockStub]map[string]uint64),
+	}
+
+	// take away state changes and event of simulated tx
+	for _, s := range append([]*MockStub{stub}, stub.invokables()...) {
+		if s.TxResult.Status == shim.OK {
+			simulation.writeSets[s] = append(s.StateBuffer, s.PrivateStateBuffer...)
+		}
+		simulation.readSets[s] = s.readSet
+		s.StateBuffer = nil
+		s.PrivateStateBuffer = nil
+	}
+	event := stub.ChaincodeEvent
+	stub.ChaincodeEvent = nil
+	stub.MockTransactionEnd(uuid)
+
+	if stub.TxResult.Status == shim.OK {
+		stub.ChaincodeEvent = event
+		simulation.Event = event
+	}
+
+	return stub.TxResult, simulation
+}
+
+// MockCommit writes state changes of simulated transaction to state and emits chaincode event as committing peer.
+// If keys, read at simulation by stub or invokable stubs, are changed after simulation, transaction is not committed
+// and TxValidationError with MVCC_READ_CONFLICT code is returned
+func (stub *MockStub) MockCommit(simulation *TxSimulation) error {
+	stub.m.Lock()
+	defer stub.m.Unlock()
+
+	if simulation.Response.Status != shim.OK {
+		return &sdk.TxValidationError{TxID: simulation.TxID, Code: peer.TxValidationCode_ENDORSEMENT_POLICY_FAILURE}
+	}
+
+	for s, readSet := range simulation.readSets {
+		for key, version := range readSet {
+			if s.keyVersions[key] != version {
+				return &sdk.TxValidationError{TxID: simulation.TxID, Code: peer.TxValidationCode_MVCC_READ_CONFLICT}
+			}
+		}
+	}
+
+	stub.MockTransactionStart(simulation.TxID)
+	stub.TxResult = simulation.Response
+	stub.ChaincodeEvent = simulation.Event
+	for s, writeSet := range simulation.writeSets {
+		for _, item := range writeSet {
+			if item.Collection == `` {
+				s.StateBuffer = append(s.StateBuffer, item)
+			} else {
+				s.PrivateStateBuffer = append(s.PrivateStateBuffer, item)
+			}
+		}
+		if s != stub {
+			s.TxResult = peer.Response{Status: shim.OK}
+		}
+	}
+	stub.MockTransactionEnd(simulation.TxID)
+
+	return nil
+}
+
+// invokables returns invokable stubs, excluding stub itself
+func (stub *MockStub) invokables() []*MockStub {
+	var stubs []*MockStub
+	for _, s := range stub.Invokables {
+		if s != stub {
+			stubs = append(stubs, s)
+		}
+	}
+	return stubs
+}
+
 // Invoke sugared invoke function with autogenerated tx uuid
 func (stub *MockStub) Invoke(funcName string, iArgs ...interface{}) peer.Response {
 	fArgs, err := convert.ArgsToBytes(iArgs...)
@@ -449,8 +629,12 @@ func (stub *MockStub) AddTransient(transient map[string][]byte) *MockStub {
 //	return stub
 //}
 
-// DelPrivateData mocked
+// DelPrivateData mocked, private data changes are buffered and written on tx end
 func (stub *MockStub) DelPrivateData(collection string, key string) error {
+	if stub.TxID == "" {
+		return errors.New("cannot DelPrivateData without a transactions - call stub.MockTransactionStart()")
+	}
+
 	m, in := stub.PvtState[collection]
 	if !in {
 		return fmt.Errorf("collection %s not found", collection)
@@ -459,14 +643,27 @@ func (stub *MockStub) DelPrivateData(collection string, key string) error {
 	if _, ok := m[key]; !ok {
 		return fmt.Errorf("key %s not found", key)
 	}
-	delete(m, key)
 
+	stub.PrivateStateBuffer = append(stub.PrivateStateBuffer, &StateItem{
+		Collection: collection,
+		Key:        key,
+		Delete:     true,
+	})
+	return nil
+}
+
+func (stub *MockStub) delPrivateData(collection string, key string) {
+	delete(stub.PvtState[collection], key)
+
+	if _, ok := stub.PrivateKeys[collection]; !ok {
+		return
+	}
 	for elem := stub.PrivateKeys[collection].Front(); elem != nil; elem = elem.Next() {
 		if strings.Compare(key, elem.Value.(string)) == 0 {
 			stub.PrivateKeys[collection].Remove(elem)
+			break
 		}
 	}
-	return nil
 }
 
 type PrivateMockStateRangeQueryIterator struct {
@@ -568,8 +765,21 @@ func NewPrivateMockStateRangeQueryIterator(stub *MockStub, collection string, st
 	return iter
 }
 
-// PutPrivateData mocked
+// PutPrivateData mocked, private data changes are buffered and written on tx end
 func (stub *MockStub) PutPrivateData(collection string, key string, value []byte) error {
+	if stub.TxID == "" {
+		return errors.New("cannot PutPrivateData without a transactions - call stub.MockTransactionStart()")
+	}
+
+	stub.PrivateStateBuffer = append(stub.PrivateStateBuffer, &StateItem{
+		Collection: collection,
+		Key:        key,
+		Value:      value,
+	})
+	return nil
+}
+
+func (stub *MockStub) putPrivateData(collection string, key string, value []byte) {
 	if _, in := stub.PvtState[collection]; !in {
 		stub.PvtState[collection] = make(map[string][]byte)
 	}
@@ -602,8 +812,6 @@ func (stub *MockStub) PutPrivateData(collection string, key string, value []byte
 	if stub.PrivateKeys[collection].Len() == 0 {
 		stub.PrivateKeys[collection].PushFront(key)
 	}
-
-	return nil
 }
 
 const maxUnicodeRuneValue = utf8.MaxRune
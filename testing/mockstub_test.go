@@ -2,7 +2,9 @@ package testing_test
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/hyperledger/fabric-chaincode-go/shim"
 	"github.com/hyperledger/fabric-protos-go/peer"
@@ -11,6 +13,7 @@ import (
 
 	"github.com/s7techlab/cckit/examples/cars"
 	idtestdata "github.com/s7techlab/cckit/identity/testdata"
+	"github.com/s7techlab/cckit/sdk"
 	testcc "github.com/s7techlab/cckit/testing"
 	expectcc "github.com/s7techlab/cckit/testing/expect"
 	"github.com/s7techlab/cckit/testing/testdata"
@@ -164,6 +167,23 @@ var _ = Describe(`Testing`, func() {
 
 		}, 0.3)
 
+		It("Allow to get events history from block, not newer than mocked events block", func() {
+			ctx := context.Background()
+
+			// all mocked events are in MockedEventsBlock, so any block up to it replays events history
+			for _, from := range []int64{0, 1, testcc.MockedEventsBlock} {
+				events, closer, err := mockedPeer.Events(ctx, Channel, CarsChaincode, Authority, from)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(events).Should(Receive())
+				_ = closer()
+			}
+
+			events, closer, err := mockedPeer.Events(ctx, Channel, CarsChaincode, Authority, testcc.MockedEventsBlock+1)
+			Expect(err).NotTo(HaveOccurred())
+			Consistently(events, 20*time.Millisecond).ShouldNot(Receive())
+			_ = closer()
+		})
+
 		It("Allow to query mocked chaincode ", func() {
 			resp, err := mockedPeer.Query(
 				context.Background(),
@@ -225,5 +245,50 @@ var _ = Describe(`Testing`, func() {
 			Expect(res.Payload).To(Equal(testdata.Value1))
 		})
 
+		It("Private read after write returns empty", func() {
+			res := txIsolationCC.Invoke(testdata.TxIsolationPrivateReadAfterWrite)
+			Expect(int(res.Status)).To(Equal(shim.OK))
+			Expect(res.Payload).To(Equal([]byte{}))
+			Expect(txIsolationCC.PvtState[testdata.Collection1][testdata.Key1]).To(Equal(testdata.Value1))
+		})
+	})
+
+	Describe(`Tx simulation`, func() {
+		It("Allow to commit simulated state and private data changes", func() {
+			delete(txIsolationCC.PvtState, testdata.Collection1)
+
+			res, simulation := txIsolationCC.MockSimulateTx(`tx1`,
+				[][]byte{[]byte(testdata.TxIsolationPrivateReadAfterWrite)})
+			Expect(int(res.Status)).To(Equal(shim.OK))
+
+			// simulation doesn't change private data
+			Expect(txIsolationCC.PvtState[testdata.Collection1]).To(BeEmpty())
+
+			Expect(txIsolationCC.MockCommit(simulation)).NotTo(HaveOccurred())
+			Expect(txIsolationCC.PvtState[testdata.Collection1][testdata.Key1]).To(Equal(testdata.Value1))
+		})
+
+		It("Allow to commit simulation after change of state, not read at simulation", func() {
+			res, simulation := txIsolationCC.MockSimulateTx(`tx2`,
+				[][]byte{[]byte(testdata.TxIsolationPrivateReadAfterWrite)})
+			Expect(int(res.Status)).To(Equal(shim.OK))
+
+			// public state key is not in read set of private data simulation
+			Expect(int(txIsolationCC.Invoke(testdata.TxIsolationReadAfterWrite).Status)).To(Equal(shim.OK))
+
+			Expect(txIsolationCC.MockCommit(simulation)).NotTo(HaveOccurred())
+		})
+
+		It("Disallow to commit simulation after change of state, read at simulation", func() {
+			res, simulation := txIsolationCC.MockSimulateTx(`tx3`,
+				[][]byte{[]byte(testdata.TxIsolationPrivateReadAfterWrite)})
+			Expect(int(res.Status)).To(Equal(shim.OK))
+
+			Expect(int(txIsolationCC.Invoke(testdata.TxIsolationPrivateReadAfterWrite).Status)).To(Equal(shim.OK))
+
+			txErr := &sdk.TxValidationError{}
+			Expect(errors.As(txIsolationCC.MockCommit(simulation), &txErr)).To(BeTrue())
+			Expect(txErr.Code).To(Equal(peer.TxValidationCode_MVCC_READ_CONFLICT))
+		})
 	})
 })
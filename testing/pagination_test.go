@@ -254,6 +254,31 @@ var _ = Describe("MockStub", func() {
 			Expect(iter.HasNext()).To(Equal(false))
 		})
 
+		It("should return items after bookmark when bookmark key is deleted after previous page", func() {
+			_, md, err := mockStub.GetStateByRangeWithPagination("aa", "ba", 3, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(md.Bookmark).To(Equal("ad"))
+
+			mockStub.MockTransactionStart("delete")
+			Expect(mockStub.DelState("ad")).NotTo(HaveOccurred())
+			mockStub.TxResult = peer.Response{Status: shim.OK}
+			mockStub.MockTransactionEnd("delete")
+
+			iter, md, err := mockStub.GetStateByRangeWithPagination("aa", "ba", 3, md.Bookmark)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(md.Bookmark).To(Equal(""))
+			Expect(md.FetchedRecordsCount).To(Equal(int32(3)))
+
+			for _, expect := range state[4:7] {
+				kv, err := iter.Next()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(kv).To(Equal(expect))
+			}
+
+			Expect(iter.HasNext()).To(Equal(false))
+		})
+
 		It("should returns items in range when bookmark is less than startKey", func() {
 			iter, md, err := mockStub.GetStateByRangeWithPagination("af", "ba", 3, "ab")
 
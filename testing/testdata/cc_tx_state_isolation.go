@@ -5,10 +5,13 @@ import (
 )
 
 const (
-	Key1 = `abc`
+	Key1        = `abc`
+	Collection1 = `private`
 
 	TxIsolationReadAfterWrite  = `ReadAfterWrite`
 	TxIsolationReadAfterDelete = `ReadAfterDelete`
+
+	TxIsolationPrivateReadAfterWrite = `PrivateReadAfterWrite`
 )
 
 var (
@@ -19,7 +22,8 @@ func NewTxIsolationCC() *router.Chaincode {
 	r := router.New(`tx_isolation`)
 
 	r.Query(TxIsolationReadAfterWrite, ReadAfterWrite).
-		Query(TxIsolationReadAfterDelete, ReadAfterDelete)
+		Query(TxIsolationReadAfterDelete, ReadAfterDelete).
+		Invoke(TxIsolationPrivateReadAfterWrite, PrivateReadAfterWrite)
 
 	return router.NewChaincode(r)
 }
@@ -42,3 +46,13 @@ func ReadAfterDelete(c router.Context) (interface{}, error) {
 	// return non-empty, cause state changes, include deletion, cannot be read
 	return c.State().Get(Key1)
 }
+
+func PrivateReadAfterWrite(c router.Context) (interface{}, error) {
+	if err := c.State().PutPrivate(Collection1, Key1, Value1); err != nil {
+		return nil, err
+	}
+
+	// return empty, cause private data changes cannot be read
+	res, _ := c.State().GetPrivate(Collection1, Key1)
+	return res, nil
+}